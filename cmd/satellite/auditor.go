@@ -65,8 +65,10 @@ func cmdAuditorRun(cmd *cobra.Command, args []string) (err error) {
 		revocationDB,
 		db.VerifyQueue(),
 		db.ReverifyQueue(),
+		db.AuditCampaigns(),
 		db.OverlayCache(),
 		db.NodeEvents(),
+		db.PlacementConfigs(),
 		db.Reputation(),
 		db.Containment(),
 		version.Build,
@@ -83,6 +83,7 @@ func cmdFetchPieces(cmd *cobra.Command, args []string) (err error) {
 		db.Buckets(),
 		db.OverlayCache(),
 		db.NodeEvents(),
+		db.PlacementConfigs(),
 		db.Reputation(),
 		db.Containment(),
 		version.Build,
@@ -361,6 +361,13 @@ var (
 		RunE:  cmdFixLastNets,
 	}
 
+	storePlacementConfigCmd = &cobra.Command{
+		Use:   "store-placement-config [placement-yaml-file]",
+		Short: "Validate a placement configuration file and store it in the database, to be picked up by the placement reloaders",
+		Args:  cobra.ExactArgs(1),
+		RunE:  cmdStorePlacementConfig,
+	}
+
 	deleteDataCmd = &cobra.Command{
 		Use:   "delete-data",
 		Short: "Delete accounts and their objects",
@@ -471,6 +478,7 @@ func init() {
 	rootCmd.AddCommand(fetchPiecesCmd)
 	rootCmd.AddCommand(repairSegmentCmd)
 	rootCmd.AddCommand(fixLastNetsCmd)
+	rootCmd.AddCommand(storePlacementConfigCmd)
 	rootCmd.AddCommand(deleteDataCmd)
 	reportsCmd.AddCommand(nodeUsageCmd)
 	reportsCmd.AddCommand(partnerAttributionCmd)
@@ -544,6 +552,7 @@ func init() {
 	process.Bind(stripeCustomerCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(consistencyGECleanupCmd, &consistencyGECleanupCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(fixLastNetsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
+	process.Bind(storePlacementConfigCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(deleteObjectsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 	process.Bind(deleteAccountsCmd, &runCfg, defaults, cfgstruct.ConfDir(confDir), cfgstruct.IdentityDir(identityDir))
 
@@ -1211,6 +1220,33 @@ func cmdFixLastNets(cmd *cobra.Command, _ []string) (err error) {
 	return db.OverlayCache().OneTimeFixLastNets(ctx)
 }
 
+func cmdStorePlacementConfig(cmd *cobra.Command, args []string) (err error) {
+	ctx, _ := process.Ctx(cmd)
+	log := zap.L()
+
+	config, err := os.ReadFile(args[0])
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	db, err := satellitedb.Open(ctx, log.Named("db"), runCfg.Database, satellitedb.Options{
+		ApplicationName: "satellite-store-placement-config",
+	})
+	if err != nil {
+		return fmt.Errorf("error opening master database: %w", err)
+	}
+	defer func() {
+		err = errs.Combine(err, db.Close())
+	}()
+
+	version, err := nodeselection.StorePlacementConfig(ctx, db.PlacementConfigs(), config)
+	if err != nil {
+		return err
+	}
+	log.Info("placement configuration stored", zap.String("version", version))
+	return nil
+}
+
 func main() {
 	logger, _, _ := process.NewLogger("satellite")
 	zap.ReplaceGlobals(logger)
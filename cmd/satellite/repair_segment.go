@@ -132,6 +132,7 @@ func cmdRepairSegment(cmd *cobra.Command, args []string) (err error) {
 		placement,
 		config.Checker.RepairThresholdOverrides,
 		config.Checker.RepairTargetOverrides,
+		config.Metainfo.RS,
 		config.Repairer,
 	)
 
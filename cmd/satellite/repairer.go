@@ -63,6 +63,7 @@ func cmdRepairerRun(cmd *cobra.Command, args []string) (err error) {
 		db.Buckets(),
 		db.OverlayCache(),
 		db.NodeEvents(),
+		db.PlacementConfigs(),
 		db.Reputation(),
 		db.Containment(),
 		version.Build,
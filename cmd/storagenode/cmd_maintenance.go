@@ -0,0 +1,136 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/cfgstruct"
+	"storj.io/common/identity"
+	"storj.io/common/peertls/tlsopts"
+	"storj.io/common/process"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/storj/private/nodemaintenancepb"
+	"storj.io/storj/private/server"
+)
+
+// maintenanceCfg defines configuration for the declare-maintenance command.
+type maintenanceCfg struct {
+	Identity identity.Config
+	Server   server.Config
+
+	Start    string        `help:"start of the maintenance window in RFC3339 format, the window starts immediately when empty" default:""`
+	Duration time.Duration `help:"duration of the maintenance window, the satellites reject windows longer than their maximum" default:"4h"`
+	Cancel   bool          `help:"cancel the declared maintenance window instead of declaring a new one" default:"false"`
+}
+
+func newDeclareMaintenanceCmd(f *Factory) *cobra.Command {
+	var cfg maintenanceCfg
+	cmd := &cobra.Command{
+		Use:   "declare-maintenance [satellite-url...]",
+		Short: "Declare a planned maintenance window on satellites",
+		Long: "Declare a planned maintenance window (e.g. for a hardware replacement) on the given satellites.\n" +
+			"During the window the node isn't penalized for being offline, and its pieces don't trigger repair on their own.",
+		Example: `
+# Declare a 4 hour maintenance window starting now
+$ storagenode declare-maintenance --identity-dir /path/to/identityDir --config-dir /path/to/configDir satellite_ID@address:port
+
+# Declare a maintenance window at a later time
+$ storagenode declare-maintenance --start 2025-06-01T08:00:00Z --duration 2h satellite_ID@address:port
+
+# Cancel the declared maintenance window
+$ storagenode declare-maintenance --cancel satellite_ID@address:port
+`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, _ := process.Ctx(cmd)
+			return cmdDeclareMaintenance(ctx, zap.L(), &cfg, args)
+		},
+		Annotations: map[string]string{"type": "helper"},
+	}
+
+	process.Bind(cmd, &cfg, f.Defaults, cfgstruct.ConfDir(f.ConfDir), cfgstruct.IdentityDir(f.IdentityDir))
+
+	return cmd
+}
+
+func cmdDeclareMaintenance(ctx context.Context, log *zap.Logger, cfg *maintenanceCfg, args []string) (err error) {
+	var start time.Time
+	if cfg.Start != "" {
+		start, err = time.Parse(time.RFC3339, cfg.Start)
+		if err != nil {
+			return errs.New("invalid start: %v", err)
+		}
+	}
+	end := start
+	if end.IsZero() {
+		end = time.Now()
+	}
+	end = end.Add(cfg.Duration)
+
+	satellites := make([]storj.NodeURL, 0, len(args))
+	for _, arg := range args {
+		satellite, err := storj.ParseNodeURL(arg)
+		if err != nil {
+			return errs.New("invalid satellite URL %q: %v", arg, err)
+		}
+		satellites = append(satellites, satellite)
+	}
+
+	ident, err := cfg.Identity.Load()
+	if err != nil {
+		return errs.New("failed to load identity: %v", err)
+	}
+
+	tlsOptions, err := tlsopts.NewOptions(ident, cfg.Server.Config, nil)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	dialer := rpc.NewDefaultDialer(tlsOptions)
+
+	var group errs.Group
+	for _, satellite := range satellites {
+		err := declareMaintenance(ctx, dialer, satellite, start, end, cfg.Cancel)
+		if err != nil {
+			log.Error("failed to declare maintenance window", zap.Stringer("Satellite ID", satellite.ID), zap.Error(err))
+			group.Add(err)
+			continue
+		}
+		if cfg.Cancel {
+			log.Info("maintenance window cancelled", zap.Stringer("Satellite ID", satellite.ID))
+		} else {
+			log.Info("maintenance window declared", zap.Stringer("Satellite ID", satellite.ID), zap.Time("end", end))
+		}
+	}
+	return group.Err()
+}
+
+func declareMaintenance(ctx context.Context, dialer rpc.Dialer, satellite storj.NodeURL, start, end time.Time, cancel bool) (err error) {
+	conn, err := dialer.DialNodeURL(ctx, satellite)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	client := nodemaintenancepb.NewDRPCNodeMaintenanceClient(conn)
+	if cancel {
+		_, err = client.CancelMaintenance(ctx, &nodemaintenancepb.CancelMaintenanceRequest{})
+		return errs.Wrap(err)
+	}
+
+	request := &nodemaintenancepb.DeclareMaintenanceRequest{
+		EndUnixNano: end.UnixNano(),
+	}
+	if !start.IsZero() {
+		request.StartUnixNano = start.UnixNano()
+	}
+	_, err = client.DeclareMaintenance(ctx, request)
+	return errs.Wrap(err)
+}
@@ -62,6 +62,7 @@ func newRootCmd(setDefaults bool) (*cobra.Command, *Factory) {
 		newGracefulExitStatusCmd(factory),
 		newForgetSatelliteCmd(factory),
 		newForgetSatelliteStatusCmd(factory),
+		newDeclareMaintenanceCmd(factory),
 		// internal hidden commands
 		internalcmd.NewUsedSpaceFilewalkerCmd().Command,
 		internalcmd.NewGCFilewalkerCmd().Command,
@@ -0,0 +1,347 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"cmp"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/process"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+// simulateCmd evaluates all placements of a placement configuration against a node snapshot.
+var simulateCmd = &cobra.Command{
+	Use:   "simulate <placement.yaml> <nodes.csv>",
+	Short: "Simulate uploads with real placement definitions and a node snapshot",
+	Long: `Loads the placement definitions from a YAML file (same format as --placement of the satellite)
+and a snapshot of the nodes from a CSV file, and reports for each placement:
+
+  * the eligible nodes per country, subnet and tag
+  * the distribution of the pieces after simulated node selections
+  * the selections violating the invariant of the placement
+  * the fraction of selections which would fail because of the lack of nodes
+
+The first line of the CSV file is the header. Supported columns (only id is required):
+  id, last_net, last_ip_port, country, free_disk, piece_count, vetted, tags
+
+Tags are separated by ';', each in the form of signer/name/value.
+
+EXAMPLE:
+
+placement-test simulate --selections 100000 --total 110 placement.yaml nodes.csv
+`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		placements, err := nodeselection.LoadConfig(args[0], nodeselection.NewPlacementConfigEnvironment(nil, nil, nil))
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		file, err := os.Open(args[1])
+		if err != nil {
+			return errs.Wrap(err)
+		}
+		nodes, err := loadNodes(file)
+		err = errs.Combine(err, file.Close())
+		if err != nil {
+			return errs.Wrap(err)
+		}
+
+		ids := make([]storj.PlacementConstraint, 0, len(placements))
+		for id := range placements {
+			ids = append(ids, id)
+		}
+		slices.Sort(ids)
+
+		reports := make([]PlacementReport, 0, len(ids))
+		for _, id := range ids {
+			reports = append(reports, simulatePlacement(placements[id], nodes, simulateConfig.Selections, simulateConfig.Total))
+		}
+		return errs.Wrap(writeReports(cmd.OutOrStdout(), reports, simulateConfig.Top))
+	},
+}
+
+// SimulateConfig contains the parameters of the simulation.
+type SimulateConfig struct {
+	Selections int `help:"number of simulated node selections per placement" default:"100000"`
+	Total      int `help:"number of nodes requested by a selection, when the placement doesn't override the total shares" default:"110"`
+	Top        int `help:"number of countries, subnets and tags listed per placement" default:"10"`
+}
+
+var simulateConfig SimulateConfig
+
+func init() {
+	rootCmd.AddCommand(simulateCmd)
+	process.Bind(simulateCmd, &simulateConfig)
+}
+
+// PlacementReport contains the results of the simulation of a placement.
+type PlacementReport struct {
+	ID   storj.PlacementConstraint
+	Name string
+
+	// Eligible is the number of nodes matching the filter of the placement.
+	Eligible          int
+	EligibleByCountry map[string]int
+	EligibleBySubnet  map[string]int
+	EligibleByTag     map[string]int
+
+	Selections    int
+	SelectionSize int
+	// Failed is the number of selections which returned fewer nodes than requested.
+	Failed int
+	// SelectedByCountry counts the pieces of the successful selections per country.
+	SelectedByCountry map[string]int
+	// SelectedNodes is the number of distinct nodes which got any piece.
+	SelectedNodes int
+	// MaxNodePieces is the highest number of pieces selected for a single node.
+	MaxNodePieces int
+	// Violations is the number of successful selections, where the invariant
+	// of the placement would move pieces.
+	Violations int
+	// ViolatingPieces is the number of pieces the invariant would move.
+	ViolatingPieces int
+}
+
+// FailureRate returns the fraction of the selections which failed.
+func (report PlacementReport) FailureRate() float64 {
+	if report.Selections == 0 {
+		return 0
+	}
+	return float64(report.Failed) / float64(report.Selections)
+}
+
+// simulatePlacement selects nodes with the selector of the placement for the given
+// number of times, and checks the results with the invariant of the placement.
+func simulatePlacement(placement nodeselection.Placement, nodes []*nodeselection.SelectedNode, selections, total int) PlacementReport {
+	report := PlacementReport{
+		ID:                placement.ID,
+		Name:              placement.Name,
+		EligibleByCountry: map[string]int{},
+		EligibleBySubnet:  map[string]int{},
+		EligibleByTag:     map[string]int{},
+		Selections:        selections,
+		SelectionSize:     total,
+		SelectedByCountry: map[string]int{},
+	}
+	if placement.EC.Total > 0 {
+		report.SelectionSize = placement.EC.Total
+	}
+
+	for _, node := range nodes {
+		if placement.NodeFilter != nil && !placement.NodeFilter.Match(node) {
+			continue
+		}
+		report.Eligible++
+		report.EligibleByCountry[node.CountryCode.String()]++
+		report.EligibleBySubnet[node.LastNet]++
+		for _, tag := range node.Tags {
+			report.EligibleByTag[tag.Name+"="+string(tag.Value)]++
+		}
+	}
+
+	selectorInit := placement.Selector
+	if selectorInit == nil {
+		selectorInit = nodeselection.RandomSelector()
+	}
+	selector := selectorInit(nodes, placement.NodeFilter)
+
+	invariant := placement.Invariant
+	if invariant == nil {
+		invariant = nodeselection.AllGood()
+	}
+
+	perNode := map[storj.NodeID]int{}
+	pieces := make(metabase.Pieces, 0, report.SelectionSize)
+	selectedNodes := make([]nodeselection.SelectedNode, 0, report.SelectionSize)
+	for i := 0; i < selections; i++ {
+		selected, err := selector(storj.NodeID{}, report.SelectionSize, nil, nil)
+		if err != nil || len(selected) < report.SelectionSize {
+			report.Failed++
+			continue
+		}
+
+		pieces, selectedNodes = pieces[:0], selectedNodes[:0]
+		for number, node := range selected {
+			report.SelectedByCountry[node.CountryCode.String()]++
+			perNode[node.ID]++
+			pieces = append(pieces, metabase.Piece{Number: uint16(number), StorageNode: node.ID})
+			selectedNodes = append(selectedNodes, *node)
+		}
+
+		if violating := invariant(pieces, selectedNodes).Count(); violating > 0 {
+			report.Violations++
+			report.ViolatingPieces += violating
+		}
+	}
+
+	report.SelectedNodes = len(perNode)
+	for _, count := range perNode {
+		report.MaxNodePieces = max(report.MaxNodePieces, count)
+	}
+	return report
+}
+
+// writeReports prints the reports in a human readable format.
+func writeReports(w io.Writer, reports []PlacementReport, top int) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	for _, report := range reports {
+		_, _ = fmt.Fprintf(tw, "--------- Placement %d (%s) ---------\n", report.ID, report.Name)
+		_, _ = fmt.Fprintf(tw, "eligible nodes:\t%d\n", report.Eligible)
+		_, _ = fmt.Fprintf(tw, "eligible subnets:\t%d\n", len(report.EligibleBySubnet))
+		_, _ = fmt.Fprintf(tw, "selections:\t%d of %d nodes\n", report.Selections, report.SelectionSize)
+		_, _ = fmt.Fprintf(tw, "failed selections:\t%d (%.4f%%)\n", report.Failed, 100*report.FailureRate())
+		_, _ = fmt.Fprintf(tw, "invariant violations:\t%d selections, %d pieces\n", report.Violations, report.ViolatingPieces)
+		_, _ = fmt.Fprintf(tw, "selected nodes:\t%d, max %d pieces per node\n", report.SelectedNodes, report.MaxNodePieces)
+
+		writeTop(tw, "eligible nodes per country", report.EligibleByCountry, report.Eligible, top)
+		writeTop(tw, "eligible nodes per subnet", report.EligibleBySubnet, report.Eligible, top)
+		writeTop(tw, "eligible nodes per tag", report.EligibleByTag, report.Eligible, top)
+
+		var selectedPieces int
+		for _, count := range report.SelectedByCountry {
+			selectedPieces += count
+		}
+		writeTop(tw, "selected pieces per country", report.SelectedByCountry, selectedPieces, top)
+		_, _ = fmt.Fprintln(tw)
+	}
+
+	return tw.Flush()
+}
+
+// writeTop prints the largest groups with their share of the total.
+func writeTop(w io.Writer, title string, groups map[string]int, total int, top int) {
+	if len(groups) == 0 {
+		return
+	}
+
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b string) int {
+		return cmp.Or(cmp.Compare(groups[b], groups[a]), cmp.Compare(a, b))
+	})
+
+	_, _ = fmt.Fprintf(w, "%s:\n", title)
+	for i, key := range keys {
+		if top > 0 && i >= top {
+			_, _ = fmt.Fprintf(w, "  ...\t%d more\n", len(keys)-top)
+			break
+		}
+		share := 0.0
+		if total > 0 {
+			share = 100 * float64(groups[key]) / float64(total)
+		}
+		_, _ = fmt.Fprintf(w, "  %s\t%d\t%.2f%%\n", key, groups[key], share)
+	}
+}
+
+// loadNodes reads the node snapshot in CSV format.
+func loadNodes(r io.Reader) (nodes []*nodeselection.SelectedNode, err error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errs.New("couldn't read header: %v", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["id"]; !ok {
+		return nil, errs.New("id column is missing")
+	}
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errs.Is(err, io.EOF) {
+			return nodes, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		node, err := parseNode(columns, record)
+		if err != nil {
+			return nil, errs.New("invalid node in line %d: %v", line, err)
+		}
+		nodes = append(nodes, node)
+	}
+}
+
+// parseNode creates a node from a CSV record.
+func parseNode(columns map[string]int, record []string) (_ *nodeselection.SelectedNode, err error) {
+	value := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	node := &nodeselection.SelectedNode{
+		LastNet:     value("last_net"),
+		LastIPPort:  value("last_ip_port"),
+		CountryCode: location.ToCountryCode(value("country")),
+		Online:      true,
+		Vetted:      true,
+	}
+
+	node.ID, err = storj.NodeIDFromString(value("id"))
+	if err != nil {
+		return nil, err
+	}
+	if v := value("free_disk"); v != "" {
+		if node.FreeDisk, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if v := value("piece_count"); v != "" {
+		if node.PieceCount, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	if v := value("vetted"); v != "" {
+		if node.Vetted, err = strconv.ParseBool(v); err != nil {
+			return nil, err
+		}
+	}
+	if v := value("tags"); v != "" {
+		for _, tag := range strings.Split(v, ";") {
+			parts := strings.SplitN(tag, "/", 3)
+			if len(parts) != 3 {
+				return nil, errs.New("tag should be in the form of signer/name/value: %q", tag)
+			}
+			signer, err := storj.NodeIDFromString(parts[0])
+			if err != nil {
+				return nil, err
+			}
+			node.Tags = append(node.Tags, nodeselection.NodeTag{
+				NodeID:   node.ID,
+				Signer:   signer,
+				SignedAt: time.Now(),
+				Name:     parts[1],
+				Value:    []byte(parts[2]),
+			})
+		}
+	}
+	return node, nil
+}
@@ -0,0 +1,119 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+func TestLoadNodes(t *testing.T) {
+	signer := testrand.NodeID()
+	id1, id2 := testrand.NodeID(), testrand.NodeID()
+
+	nodes, err := loadNodes(strings.NewReader(fmt.Sprintf(`id,last_net,country,free_disk,piece_count,tags
+%s,1.2.3.0,DE,1000,10,%s/soc2/true;%s/owner/alice
+%s,1.2.4.0,US,,,
+`, id1, signer, signer, id2)))
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	require.Equal(t, id1, nodes[0].ID)
+	require.Equal(t, "1.2.3.0", nodes[0].LastNet)
+	require.Equal(t, location.Germany, nodes[0].CountryCode)
+	require.EqualValues(t, 1000, nodes[0].FreeDisk)
+	require.EqualValues(t, 10, nodes[0].PieceCount)
+	require.True(t, nodes[0].Vetted)
+	require.Len(t, nodes[0].Tags, 2)
+	tag, err := nodes[0].Tags.FindBySignerAndName(signer, "owner")
+	require.NoError(t, err)
+	require.Equal(t, []byte("alice"), tag.Value)
+
+	require.Equal(t, id2, nodes[1].ID)
+	require.Equal(t, location.UnitedStates, nodes[1].CountryCode)
+	require.Empty(t, nodes[1].Tags)
+
+	_, err = loadNodes(strings.NewReader("last_net\n1.2.3.0\n"))
+	require.Error(t, err)
+
+	_, err = loadNodes(strings.NewReader("id,tags\n" + id1.String() + ",invalid\n"))
+	require.Error(t, err)
+}
+
+func TestSimulatePlacement(t *testing.T) {
+	// every subnet has two nodes, one in DE and one in US.
+	var nodes []*nodeselection.SelectedNode
+	for i := 0; i < 20; i++ {
+		country := location.Germany
+		if i%2 == 1 {
+			country = location.UnitedStates
+		}
+		nodes = append(nodes, &nodeselection.SelectedNode{
+			ID:          testrand.NodeID(),
+			LastNet:     fmt.Sprintf("10.0.%d.0", i/2),
+			CountryCode: country,
+			Online:      true,
+			Vetted:      true,
+		})
+	}
+
+	placements, err := nodeselection.LoadConfigFromString(`
+placements:
+  - id: 0
+    name: global
+    invariant: maxcontrol("last_net",1)
+  - id: 1
+    name: de
+    filter: country("DE")
+    selector: attribute("last_net")
+    invariant: maxcontrol("last_net",1)
+    ec:
+      total: 5
+  - id: 2
+    name: small
+    filter: country("DE")
+`, nil)
+	require.NoError(t, err)
+
+	t.Run("violations", func(t *testing.T) {
+		report := simulatePlacement(placements[0], nodes, 100, 10)
+		require.Equal(t, 20, report.Eligible)
+		require.Len(t, report.EligibleBySubnet, 10)
+		require.Zero(t, report.Failed)
+		// random selection of 10 out of 20 nodes almost always selects two nodes of a subnet.
+		require.Greater(t, report.Violations, 0)
+		require.Greater(t, report.ViolatingPieces, 0)
+		require.Equal(t, 20, report.SelectedNodes)
+	})
+
+	t.Run("filter and ec override", func(t *testing.T) {
+		report := simulatePlacement(placements[1], nodes, 100, 10)
+		require.Equal(t, 10, report.Eligible)
+		require.Equal(t, map[string]int{"DE": 10}, report.EligibleByCountry)
+		require.Equal(t, 5, report.SelectionSize)
+		require.Zero(t, report.Failed)
+		require.Zero(t, report.Violations)
+		require.Equal(t, map[string]int{"DE": 500}, report.SelectedByCountry)
+	})
+
+	t.Run("not enough nodes", func(t *testing.T) {
+		report := simulatePlacement(placements[2], nodes, 100, 11)
+		require.Equal(t, 100, report.Failed)
+		require.Equal(t, 1.0, report.FailureRate())
+		require.Zero(t, report.SelectedNodes)
+	})
+
+	var out bytes.Buffer
+	require.NoError(t, writeReports(&out, []PlacementReport{simulatePlacement(placements[1], nodes, 10, 10)}, 1))
+	require.Contains(t, out.String(), "Placement 1 (de)")
+	require.Contains(t, out.String(), "eligible nodes per country")
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+// Error is the default error class for the package.
+var Error = errs.Class("rangedloop-snapshot")
+
+func main() {
+	logger, _, _ := process.NewLogger("rangedloop-snapshot")
+	zap.ReplaceGlobals(logger)
+
+	root := &cobra.Command{
+		Use:   "rangedloop-snapshot",
+		Short: "Snapshot metabase segments for replaying them with the ranged loop",
+	}
+
+	root.AddCommand(CreateCommand(zap.L()))
+
+	process.Exec(root)
+}
+
+// CreateCommand creates command for writing a snapshot.
+func CreateCommand(log *zap.Logger) *cobra.Command {
+	var metabaseDB string
+	var output string
+	var batchSize int
+	var asOfSystemInterval time.Duration
+	var progressFrequency int64
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "write all segments of the metabase to a snapshot file",
+	}
+
+	flag := cmd.Flags()
+
+	flag.StringVar(&metabaseDB, "metabasedb", "", "connection URL for MetabaseDB")
+	_ = cmd.MarkFlagRequired("metabasedb")
+
+	flag.StringVar(&output, "output", "segments.snapshot", "path of the snapshot file")
+	flag.IntVar(&batchSize, "batch-size", 2500, "how many segments to query in a batch")
+	flag.DurationVar(&asOfSystemInterval, "as-of-system-interval", -5*time.Minute, "as of system interval")
+	flag.Int64Var(&progressFrequency, "progress-frequency", 1000000, "how often should we print progress (every segment)")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := process.Ctx(cmd)
+		defer cancel()
+
+		mdb, err := metabase.Open(ctx, log.Named("mdb"), metabaseDB, metabase.Config{ApplicationName: "rangedloop-snapshot"})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		defer func() { _ = mdb.Close() }()
+
+		return Error.Wrap(createSnapshot(ctx, log, mdb, output, metabase.IterateLoopSegments{
+			BatchSize:          batchSize,
+			AsOfSystemInterval: asOfSystemInterval,
+		}, progressFrequency))
+	}
+
+	return cmd
+}
+
+func createSnapshot(ctx context.Context, log *zap.Logger, mdb *metabase.DB, output string, opts metabase.IterateLoopSegments, progressFrequency int64) (err error) {
+	// write to a temporary file, so that an interrupted run doesn't leave a
+	// snapshot that looks complete.
+	file, err := os.Create(output + ".tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errs.Combine(err, file.Close())
+		if err != nil {
+			_ = os.Remove(output + ".tmp")
+		}
+	}()
+
+	writer, err := rangedloop.NewSnapshotWriter(file)
+	if err != nil {
+		return err
+	}
+
+	aliases, err := mdb.ListNodeAliases(ctx)
+	if err != nil {
+		return err
+	}
+	for _, alias := range aliases {
+		if err := writer.WriteNodeAlias(alias); err != nil {
+			return err
+		}
+	}
+
+	var count int64
+	err = mdb.IterateLoopSegments(ctx, opts, func(ctx context.Context, it metabase.LoopSegmentsIterator) error {
+		var segment metabase.LoopSegmentEntry
+		for it.Next(ctx, &segment) {
+			if err := writer.WriteSegment(&segment); err != nil {
+				return err
+			}
+			count++
+			if progressFrequency > 0 && count%progressFrequency == 0 {
+				log.Info("progress", zap.Int64("segments", count), zap.Stringer("stream id", segment.StreamID))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Close(); err != nil {
+		return err
+	}
+	if err := file.Sync(); err != nil {
+		return err
+	}
+
+	log.Info("snapshot written", zap.String("path", output), zap.Int64("segments", count), zap.Int("node aliases", len(aliases)))
+	return os.Rename(output+".tmp", output)
+}
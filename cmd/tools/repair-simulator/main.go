@@ -0,0 +1,164 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"context"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/repair/simulator"
+)
+
+// Error is the default error class for the package.
+var Error = errs.Class("repair-simulator")
+
+func main() {
+	logger, _, _ := process.NewLogger("repair-simulator")
+	zap.ReplaceGlobals(logger)
+
+	root := &cobra.Command{
+		Use:   "repair-simulator",
+		Short: "Predict repair cost and durability for different repair thresholds",
+	}
+
+	root.AddCommand(RunCommand(zap.L()))
+
+	process.Exec(root)
+}
+
+// RunCommand creates command for simulating repair on a segment snapshot.
+func RunCommand(log *zap.Logger) *cobra.Command {
+	var snapshot string
+	var sample float64
+	var scenarios []string
+	var churn simulator.AgeChurn
+	config := simulator.Config{}
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "simulate node churn and repair over the segments of a snapshot (see rangedloop-snapshot)",
+		Long: "Simulates node churn and repair over the segments of a snapshot, and reports the expected " +
+			"repair bandwidth, repair queue length and probability of loss for every scenario.\n\n" +
+			"Scenarios are given as name=threshold-overrides[/target-overrides], with the overrides " +
+			"in the format of checker.repair-threshold-overrides, e.g. aggressive=29-56/29-70. " +
+			"The current thresholds of the segments are always simulated as the first scenario.",
+	}
+
+	flag := cmd.Flags()
+
+	flag.StringVar(&snapshot, "snapshot", "segments.snapshot", "path of the segment snapshot file")
+	flag.Float64Var(&sample, "sample", 1, "fraction of the segments to simulate")
+	flag.StringArrayVar(&scenarios, "scenario", nil, "scenario to simulate, in the format name=threshold-overrides[/target-overrides]")
+
+	flag.DurationVar(&config.Duration, "duration", 90*24*time.Hour, "simulated time span")
+	flag.DurationVar(&config.Step, "step", 24*time.Hour, "time between two checker runs")
+	flag.IntVar(&config.Runs, "runs", 3, "number of simulations per scenario")
+	flag.IntVar(&config.RepairCapacity, "repair-capacity", 0, "maximum number of segments repaired per step (0 is unlimited)")
+	flag.IntVar(&config.TotalNodes, "total-nodes", 0, "number of nodes in the network (at least the number of nodes holding pieces)")
+	flag.DurationVar(&config.InitialNodeAge, "initial-node-age", 365*24*time.Hour, "age of the nodes at the beginning of the simulation")
+	flag.Float64Var(&config.NodeFailureRate, "node-failure-rate", 0.00005435, "node failure rate used for the segment health, like checker.node-failure-rate")
+	flag.Int64Var(&config.Seed, "seed", 1, "seed for the random number generator")
+
+	flag.Float64Var(&churn.DailyFailureRate, "daily-failure-rate", 0.00005435, "probability of a mature node to leave the network on a given day")
+	flag.Float64Var(&churn.NewNodeDailyFailureRate, "new-node-daily-failure-rate", 0.00005435, "probability of a new node to leave the network on a given day")
+	flag.DurationVar(&churn.Maturity, "maturity", 0, "age from which nodes use daily-failure-rate instead of new-node-daily-failure-rate")
+
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		ctx, cancel := process.Ctx(cmd)
+		defer cancel()
+
+		parsed := []simulator.Scenario{{Name: "current"}}
+		for _, s := range scenarios {
+			scenario, err := parseScenario(s)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			parsed = append(parsed, scenario)
+		}
+
+		sim := simulator.New(config, churn)
+		if err := load(ctx, log, sim, snapshot, sample, config.Seed); err != nil {
+			return Error.Wrap(err)
+		}
+
+		results := make([]simulator.Result, 0, len(parsed))
+		for _, scenario := range parsed {
+			log.Info("simulating scenario", zap.String("scenario", scenario.Name))
+			result, err := sim.Run(ctx, scenario)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+			results = append(results, result)
+		}
+
+		return Error.Wrap(simulator.WriteReport(os.Stdout, config.Duration, results))
+	}
+
+	return cmd
+}
+
+// load adds the segments of the snapshot to the simulator.
+func load(ctx context.Context, log *zap.Logger, sim *simulator.Simulator, path string, sample float64, seed int64) (err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	reader, err := rangedloop.NewSnapshotReader(file)
+	if err != nil {
+		return err
+	}
+
+	rng := rand.New(rand.NewSource(seed))
+
+	var segment rangedloop.Segment
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		ok, err := reader.Next(&segment)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+		if sample < 1 && rng.Float64() >= sample {
+			continue
+		}
+		sim.Add(&segment)
+	}
+
+	log.Info("loaded snapshot", zap.Int("segments", sim.Segments()))
+	return nil
+}
+
+// parseScenario parses a scenario in the format name=threshold-overrides[/target-overrides].
+func parseScenario(s string) (scenario simulator.Scenario, err error) {
+	name, overrides, ok := strings.Cut(s, "=")
+	if !ok || name == "" {
+		return scenario, errs.New("invalid scenario %q", s)
+	}
+	scenario.Name = name
+
+	thresholds, targets, _ := strings.Cut(overrides, "/")
+	if err := scenario.RepairThresholdOverrides.Set(thresholds); err != nil {
+		return scenario, errs.New("invalid scenario %q: %w", s, err)
+	}
+	if err := scenario.RepairTargetOverrides.Set(targets); err != nil {
+		return scenario, errs.New("invalid scenario %q: %w", s, err)
+	}
+	return scenario, nil
+}
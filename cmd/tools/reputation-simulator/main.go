@@ -0,0 +1,113 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/process"
+	"storj.io/storj/satellite/reputation"
+)
+
+var (
+	rootCmd = &cobra.Command{
+		Use:   "reputation-simulator <audits.csv>",
+		Short: "Replay audit history through different reputation models",
+		Long: `Replays the audit history of the nodes through the given reputation models, and reports
+for each model which nodes would be disqualified or suspended, and when.
+
+The first line of the CSV file is the header. Required columns:
+  node_id, time, outcome
+
+The node_id is in base58 or hex format, the time is in RFC3339 format, and the outcome
+is one of success, failure, unknown or offline.
+
+The thresholds and the parameters of the models are the same as the reputation.* options of the satellite.
+
+EXPORTING THE AUDITS:
+
+The reputations table of the satellite keeps only the aggregated alpha/beta values and the
+number of online audits per window (audit_history), so the individual outcomes can't be
+replayed from it. The outcomes of the audit campaigns are stored per piece, and they can be
+exported from the satellite database with:
+
+psql "$SATELLITE_DB" -c "COPY (
+  SELECT encode(node_id, 'hex') AS node_id,
+    to_char(audited_at AT TIME ZONE 'UTC', 'YYYY-MM-DD\"T\"HH24:MI:SS\"Z\"') AS time,
+    CASE outcome WHEN 2 THEN 'success' WHEN 3 THEN 'failure' WHEN 5 THEN 'offline' ELSE 'unknown' END AS outcome
+  FROM audit_campaign_pieces WHERE outcome IN (2, 3, 5, 6)
+) TO STDOUT WITH CSV HEADER" > audits.csv
+
+EXAMPLE:
+
+reputation-simulator --models beta,windowed --reputation.audit-lambda 0.99 audits.csv
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			file, err := os.Open(args[0])
+			if err != nil {
+				return errs.Wrap(err)
+			}
+			audits, err := loadAudits(file)
+			err = errs.Combine(err, file.Close())
+			if err != nil {
+				return errs.Wrap(err)
+			}
+
+			var reports []ModelReport
+			for _, name := range strings.Split(config.Models, ",") {
+				name = strings.TrimSpace(name)
+				model, ok := reputation.GetReputationModel(name)
+				if !ok {
+					return errs.New("unknown reputation model %q", name)
+				}
+				report, err := simulate(name, model, audits, config.Reputation)
+				if err != nil {
+					return errs.Wrap(err)
+				}
+				reports = append(reports, report)
+			}
+			return errs.Wrap(writeReports(cmd.OutOrStdout(), reports, config.All))
+		},
+	}
+
+	config Config
+)
+
+// Config contains the configuration of the simulation.
+type Config struct {
+	Models     string `help:"comma separated list of the simulated reputation models" default:"beta,windowed"`
+	All        bool   `help:"list all the nodes, not only the disqualified and suspended ones" default:"false"`
+	Reputation reputation.Config
+}
+
+func init() {
+	process.Bind(rootCmd, &config)
+}
+
+func main() {
+	logger, _, _ := process.NewLogger("reputation-simulator")
+	zap.ReplaceGlobals(logger)
+
+	process.ExecWithCustomOptions(rootCmd, process.ExecOptions{
+		LoadConfig: func(cmd *cobra.Command, vip *viper.Viper) error {
+			return nil
+		},
+		InitTracing: false,
+		LoggerFactory: func(logger *zap.Logger) *zap.Logger {
+			newLogger, level, err := process.NewLogger("reputation-simulator")
+			if err != nil {
+				panic(err)
+			}
+			level.SetLevel(zap.WarnLevel)
+			return newLogger
+		},
+	})
+}
@@ -0,0 +1,311 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"cmp"
+	"encoding/csv"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+// Audit is a single audit of a node.
+type Audit struct {
+	NodeID  storj.NodeID
+	Time    time.Time
+	Outcome reputation.AuditType
+}
+
+// NodeResult contains the outcome of the simulation for a single node.
+type NodeResult struct {
+	NodeID storj.NodeID
+	// Audits is the number of audits applied before the node was disqualified.
+	Audits int
+
+	AuditScore        float64
+	UnknownAuditScore float64
+	OnlineScore       float64
+
+	Disqualified           *time.Time
+	DisqualificationReason overlay.DisqualificationReason
+	// UnknownAuditSuspended is the first time the node was suspended for unknown audits.
+	UnknownAuditSuspended *time.Time
+	// OfflineSuspended is the first time the node was suspended for being offline.
+	OfflineSuspended *time.Time
+}
+
+// ModelReport contains the results of the simulation of a reputation model.
+type ModelReport struct {
+	Model string
+	Nodes []NodeResult
+}
+
+// nodeState is the simulated reputation state of a node.
+type nodeState struct {
+	result NodeResult
+	info   reputation.Info
+}
+
+// reputation returns the audit reputation values of the node.
+func (node *nodeState) reputation() reputation.Reputation {
+	return reputation.Reputation{
+		AuditAlpha:        node.info.AuditReputationAlpha,
+		AuditBeta:         node.info.AuditReputationBeta,
+		UnknownAuditAlpha: node.info.UnknownAuditReputationAlpha,
+		UnknownAuditBeta:  node.info.UnknownAuditReputationBeta,
+	}
+}
+
+// simulate applies the audits in chronological order with the given model,
+// using the same disqualification and suspension rules as the satellite.
+func simulate(name string, model reputation.ReputationModel, audits []Audit, config reputation.Config) (ModelReport, error) {
+	nodes := map[storj.NodeID]*nodeState{}
+	var order []storj.NodeID
+
+	for _, audit := range audits {
+		node, ok := nodes[audit.NodeID]
+		if !ok {
+			node = &nodeState{
+				result: NodeResult{NodeID: audit.NodeID},
+				info: reputation.Info{
+					AuditReputationAlpha:        config.InitialAlpha,
+					AuditReputationBeta:         config.InitialBeta,
+					UnknownAuditReputationAlpha: 1,
+					OnlineScore:                 1,
+					AuditHistory:                &pb.AuditHistory{Score: 1},
+				},
+			}
+			nodes[audit.NodeID] = node
+			order = append(order, audit.NodeID)
+		}
+		if node.result.Disqualified != nil {
+			// disqualified nodes are not audited anymore
+			continue
+		}
+		if err := node.apply(model, audit, config); err != nil {
+			return ModelReport{}, errs.New("invalid audit of %s at %s: %v", audit.NodeID, audit.Time.Format(time.RFC3339), err)
+		}
+	}
+
+	report := ModelReport{Model: name}
+	for _, id := range order {
+		node := nodes[id]
+		node.result.AuditScore = node.reputation().AuditScore()
+		node.result.UnknownAuditScore = node.reputation().UnknownAuditScore()
+		node.result.OnlineScore = node.info.OnlineScore
+		report.Nodes = append(report.Nodes, node.result)
+	}
+	return report, nil
+}
+
+// apply updates the node state with a single audit.
+func (node *nodeState) apply(model reputation.ReputationModel, audit Audit, config reputation.Config) error {
+	now := audit.Time
+	node.result.Audits++
+
+	var updates reputation.Mutations
+	switch audit.Outcome {
+	case reputation.AuditSuccess:
+		updates.PositiveResults = 1
+	case reputation.AuditFailure:
+		updates.FailureResults = 1
+	case reputation.AuditUnknown:
+		updates.UnknownResults = 1
+	case reputation.AuditOffline:
+		updates.OfflineResults = 1
+	}
+
+	if err := reputation.AddAuditToHistory(node.info.AuditHistory, audit.Outcome != reputation.AuditOffline, now, config.AuditHistory); err != nil {
+		return err
+	}
+	windowsPerTrackingPeriod := int(config.AuditHistory.TrackingPeriod.Seconds() / config.AuditHistory.WindowSize.Seconds())
+	trackingPeriodFull := len(node.info.AuditHistory.Windows)-1 >= windowsPerTrackingPeriod
+	node.info.OnlineScore = node.info.AuditHistory.Score
+
+	rep := model.Apply(node.reputation(), updates, config)
+	node.info.AuditReputationAlpha, node.info.AuditReputationBeta = rep.AuditAlpha, rep.AuditBeta
+	node.info.UnknownAuditReputationAlpha, node.info.UnknownAuditReputationBeta = rep.UnknownAuditAlpha, rep.UnknownAuditBeta
+
+	node.info.UpdateStatus(zap.NewNop(), config, trackingPeriodFull, now)
+
+	node.result.Disqualified = node.info.Disqualified
+	node.result.DisqualificationReason = node.info.DisqualificationReason
+	if node.result.UnknownAuditSuspended == nil {
+		node.result.UnknownAuditSuspended = node.info.UnknownAuditSuspended
+	}
+	if node.result.OfflineSuspended == nil {
+		node.result.OfflineSuspended = node.info.OfflineSuspended
+	}
+	return nil
+}
+
+// writeReports prints the reports in a human readable format. Only the
+// disqualified or suspended nodes are listed, unless all is true.
+func writeReports(w io.Writer, reports []ModelReport, all bool) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+
+	for _, report := range reports {
+		var disqualified, unknownSuspended, offlineSuspended int
+		for _, node := range report.Nodes {
+			if node.Disqualified != nil {
+				disqualified++
+			}
+			if node.UnknownAuditSuspended != nil {
+				unknownSuspended++
+			}
+			if node.OfflineSuspended != nil {
+				offlineSuspended++
+			}
+		}
+
+		_, _ = fmt.Fprintf(tw, "--------- Model %s ---------\n", report.Model)
+		_, _ = fmt.Fprintf(tw, "nodes:\t%d\n", len(report.Nodes))
+		_, _ = fmt.Fprintf(tw, "disqualified:\t%d\n", disqualified)
+		_, _ = fmt.Fprintf(tw, "suspended for unknown audits:\t%d\n", unknownSuspended)
+		_, _ = fmt.Fprintf(tw, "suspended for being offline:\t%d\n", offlineSuspended)
+
+		nodes := slices.Clone(report.Nodes)
+		slices.SortStableFunc(nodes, func(a, b NodeResult) int {
+			return cmp.Compare(firstEvent(a), firstEvent(b))
+		})
+
+		_, _ = fmt.Fprintln(tw, "node\taudits\taudit score\tunknown score\tonline score\tdisqualified\treason\tunknown suspended\toffline suspended")
+		for _, node := range nodes {
+			if !all && firstEvent(node) == math.MaxInt64 {
+				continue
+			}
+			reason := ""
+			if node.Disqualified != nil {
+				reason = node.DisqualificationReason.String()
+			}
+			_, _ = fmt.Fprintf(tw, "%s\t%d\t%.4f\t%.4f\t%.4f\t%s\t%s\t%s\t%s\n",
+				node.NodeID, node.Audits, node.AuditScore, node.UnknownAuditScore, node.OnlineScore,
+				formatTime(node.Disqualified), reason, formatTime(node.UnknownAuditSuspended), formatTime(node.OfflineSuspended))
+		}
+		_, _ = fmt.Fprintln(tw)
+	}
+
+	return tw.Flush()
+}
+
+// firstEvent returns the unix time of the first disqualification or
+// suspension of the node, or math.MaxInt64 if there was none.
+func firstEvent(node NodeResult) int64 {
+	first := int64(math.MaxInt64)
+	for _, t := range []*time.Time{node.Disqualified, node.UnknownAuditSuspended, node.OfflineSuspended} {
+		if t != nil {
+			first = min(first, t.Unix())
+		}
+	}
+	return first
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// loadAudits reads the audit history in CSV format, and returns the audits in
+// chronological order.
+func loadAudits(r io.Reader) (audits []Audit, err error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errs.New("couldn't read header: %v", err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"node_id", "time", "outcome"} {
+		if _, ok := columns[name]; !ok {
+			return nil, errs.New("%s column is missing", name)
+		}
+	}
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if errs.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		audit, err := parseAudit(columns, record)
+		if err != nil {
+			return nil, errs.New("invalid audit in line %d: %v", line, err)
+		}
+		audits = append(audits, audit)
+	}
+
+	slices.SortStableFunc(audits, func(a, b Audit) int {
+		return a.Time.Compare(b.Time)
+	})
+	return audits, nil
+}
+
+// parseAudit creates an audit from a CSV record.
+func parseAudit(columns map[string]int, record []string) (audit Audit, err error) {
+	value := func(name string) string {
+		if i, ok := columns[name]; ok && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	audit.NodeID, err = parseNodeID(value("node_id"))
+	if err != nil {
+		return Audit{}, err
+	}
+	audit.Time, err = time.Parse(time.RFC3339, value("time"))
+	if err != nil {
+		return Audit{}, err
+	}
+
+	switch outcome := strings.ToLower(value("outcome")); outcome {
+	case "success":
+		audit.Outcome = reputation.AuditSuccess
+	case "failure":
+		audit.Outcome = reputation.AuditFailure
+	case "unknown":
+		audit.Outcome = reputation.AuditUnknown
+	case "offline":
+		audit.Outcome = reputation.AuditOffline
+	default:
+		return Audit{}, errs.New("unknown outcome %q", outcome)
+	}
+	return audit, nil
+}
+
+// parseNodeID parses a node ID in base58 or (as exported from the database)
+// in hex format.
+func parseNodeID(s string) (storj.NodeID, error) {
+	if id, err := storj.NodeIDFromString(s); err == nil {
+		return id, nil
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return storj.NodeID{}, errs.New("invalid node ID %q", s)
+	}
+	return storj.NodeIDFromBytes(b)
+}
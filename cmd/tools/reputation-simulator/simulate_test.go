@@ -0,0 +1,123 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/reputation"
+)
+
+func TestLoadAudits(t *testing.T) {
+	id1, id2 := testrand.NodeID(), testrand.NodeID()
+
+	audits, err := loadAudits(strings.NewReader(fmt.Sprintf(`node_id,time,outcome
+%s,2025-01-02T00:00:00Z,failure
+%s,2025-01-01T00:00:00Z,success
+%s,2025-01-03T00:00:00Z,Offline
+`, id1, id2, hex.EncodeToString(id1.Bytes()))))
+	require.NoError(t, err)
+	require.Equal(t, []Audit{
+		{NodeID: id2, Time: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), Outcome: reputation.AuditSuccess},
+		{NodeID: id1, Time: time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC), Outcome: reputation.AuditFailure},
+		{NodeID: id1, Time: time.Date(2025, 1, 3, 0, 0, 0, 0, time.UTC), Outcome: reputation.AuditOffline},
+	}, audits)
+
+	_, err = loadAudits(strings.NewReader("node_id,time\n"))
+	require.Error(t, err)
+
+	_, err = loadAudits(strings.NewReader("node_id,time,outcome\n" + id1.String() + ",2025-01-01T00:00:00Z,lost\n"))
+	require.Error(t, err)
+}
+
+func TestSimulate(t *testing.T) {
+	config := reputation.Config{
+		AuditLambda:        0.95,
+		AuditWeight:        1,
+		AuditDQ:            0.6,
+		UnknownAuditLambda: 0.95,
+		UnknownAuditDQ:     0.6,
+		InitialAlpha:       20,
+		AuditHistory: reputation.AuditHistoryConfig{
+			WindowSize:               time.Hour,
+			TrackingPeriod:           4 * time.Hour,
+			GracePeriod:              time.Hour,
+			OfflineThreshold:         0.6,
+			OfflineSuspensionEnabled: true,
+		},
+	}
+
+	healthy, failing, flaky, offline := testrand.NodeID(), testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	var audits []Audit
+	for i := 0; i < 100; i++ {
+		now := start.Add(time.Duration(i) * 6 * time.Minute)
+		audits = append(audits,
+			Audit{NodeID: healthy, Time: now, Outcome: reputation.AuditSuccess},
+			Audit{NodeID: failing, Time: now, Outcome: reputation.AuditFailure},
+			Audit{NodeID: flaky, Time: now, Outcome: reputation.AuditUnknown},
+			Audit{NodeID: offline, Time: now, Outcome: reputation.AuditOffline},
+		)
+	}
+
+	results := map[string]map[string]NodeResult{}
+	for _, name := range []string{"beta", "windowed"} {
+		model, ok := reputation.GetReputationModel(name)
+		require.True(t, ok)
+
+		report, err := simulate(name, model, audits, config)
+		require.NoError(t, err)
+		require.Equal(t, name, report.Model)
+		require.Len(t, report.Nodes, 4)
+
+		results[name] = map[string]NodeResult{}
+		for _, node := range report.Nodes {
+			switch node.NodeID {
+			case healthy:
+				results[name]["healthy"] = node
+			case failing:
+				results[name]["failing"] = node
+			case flaky:
+				results[name]["flaky"] = node
+			case offline:
+				results[name]["offline"] = node
+			}
+		}
+
+		require.Nil(t, results[name]["healthy"].Disqualified, name)
+		require.Nil(t, results[name]["healthy"].UnknownAuditSuspended, name)
+		require.Nil(t, results[name]["healthy"].OfflineSuspended, name)
+		require.Equal(t, 100, results[name]["healthy"].Audits, name)
+
+		require.NotNil(t, results[name]["failing"].Disqualified, name)
+		require.Equal(t, overlay.DisqualificationReasonAuditFailure, results[name]["failing"].DisqualificationReason, name)
+		require.Less(t, results[name]["failing"].Audits, 100, name)
+
+		require.Nil(t, results[name]["flaky"].Disqualified, name)
+		require.NotNil(t, results[name]["flaky"].UnknownAuditSuspended, name)
+
+		require.Nil(t, results[name]["offline"].Disqualified, name)
+		require.NotNil(t, results[name]["offline"].OfflineSuspended, name)
+	}
+
+	// without forgetting the old successes, the windowed model disqualifies
+	// the failing node later.
+	require.Greater(t, results["windowed"]["failing"].Audits, results["beta"]["failing"].Audits)
+
+	var buf bytes.Buffer
+	require.NoError(t, writeReports(&buf, []ModelReport{{Model: "beta", Nodes: []NodeResult{results["beta"]["healthy"], results["beta"]["failing"]}}}, false))
+	require.Contains(t, buf.String(), failing.String())
+	require.NotContains(t, buf.String(), healthy.String())
+	require.Regexp(t, `disqualified:\s+1\n`, buf.String())
+}
@@ -0,0 +1,8 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package nodemaintenancepb contains protobuf definitions for the planned
+// maintenance windows, which the storage nodes declare on the satellites.
+package nodemaintenancepb
+
+//go:generate go run gen.go
@@ -0,0 +1,187 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nodemaintenance.proto
+
+package nodemaintenancepb
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type DeclareMaintenanceRequest struct {
+	// Start of the window, the window starts immediately when zero
+	StartUnixNano int64 `protobuf:"varint,1,opt,name=start_unix_nano,json=startUnixNano,proto3" json:"start_unix_nano,omitempty"`
+	// End of the window
+	EndUnixNano          int64    `protobuf:"varint,2,opt,name=end_unix_nano,json=endUnixNano,proto3" json:"end_unix_nano,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclareMaintenanceRequest) Reset()         { *m = DeclareMaintenanceRequest{} }
+func (m *DeclareMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeclareMaintenanceRequest) ProtoMessage()    {}
+func (*DeclareMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f4c41565e05016e, []int{0}
+}
+func (m *DeclareMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclareMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *DeclareMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclareMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeclareMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclareMaintenanceRequest.Merge(m, src)
+}
+func (m *DeclareMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeclareMaintenanceRequest.Size(m)
+}
+func (m *DeclareMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclareMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclareMaintenanceRequest proto.InternalMessageInfo
+
+func (m *DeclareMaintenanceRequest) GetStartUnixNano() int64 {
+	if m != nil {
+		return m.StartUnixNano
+	}
+	return 0
+}
+
+func (m *DeclareMaintenanceRequest) GetEndUnixNano() int64 {
+	if m != nil {
+		return m.EndUnixNano
+	}
+	return 0
+}
+
+type DeclareMaintenanceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeclareMaintenanceResponse) Reset()         { *m = DeclareMaintenanceResponse{} }
+func (m *DeclareMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeclareMaintenanceResponse) ProtoMessage()    {}
+func (*DeclareMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f4c41565e05016e, []int{1}
+}
+func (m *DeclareMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeclareMaintenanceResponse.Unmarshal(m, b)
+}
+func (m *DeclareMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeclareMaintenanceResponse.Marshal(b, m, deterministic)
+}
+func (m *DeclareMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeclareMaintenanceResponse.Merge(m, src)
+}
+func (m *DeclareMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_DeclareMaintenanceResponse.Size(m)
+}
+func (m *DeclareMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeclareMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeclareMaintenanceResponse proto.InternalMessageInfo
+
+type CancelMaintenanceRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMaintenanceRequest) Reset()         { *m = CancelMaintenanceRequest{} }
+func (m *CancelMaintenanceRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelMaintenanceRequest) ProtoMessage()    {}
+func (*CancelMaintenanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f4c41565e05016e, []int{2}
+}
+func (m *CancelMaintenanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMaintenanceRequest.Unmarshal(m, b)
+}
+func (m *CancelMaintenanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMaintenanceRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelMaintenanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMaintenanceRequest.Merge(m, src)
+}
+func (m *CancelMaintenanceRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelMaintenanceRequest.Size(m)
+}
+func (m *CancelMaintenanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMaintenanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMaintenanceRequest proto.InternalMessageInfo
+
+type CancelMaintenanceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelMaintenanceResponse) Reset()         { *m = CancelMaintenanceResponse{} }
+func (m *CancelMaintenanceResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelMaintenanceResponse) ProtoMessage()    {}
+func (*CancelMaintenanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0f4c41565e05016e, []int{3}
+}
+func (m *CancelMaintenanceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelMaintenanceResponse.Unmarshal(m, b)
+}
+func (m *CancelMaintenanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelMaintenanceResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelMaintenanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelMaintenanceResponse.Merge(m, src)
+}
+func (m *CancelMaintenanceResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelMaintenanceResponse.Size(m)
+}
+func (m *CancelMaintenanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelMaintenanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelMaintenanceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*DeclareMaintenanceRequest)(nil), "nodemaintenance.DeclareMaintenanceRequest")
+	proto.RegisterType((*DeclareMaintenanceResponse)(nil), "nodemaintenance.DeclareMaintenanceResponse")
+	proto.RegisterType((*CancelMaintenanceRequest)(nil), "nodemaintenance.CancelMaintenanceRequest")
+	proto.RegisterType((*CancelMaintenanceResponse)(nil), "nodemaintenance.CancelMaintenanceResponse")
+}
+
+func init() { proto.RegisterFile("nodemaintenance.proto", fileDescriptor_0f4c41565e05016e) }
+
+var fileDescriptor_0f4c41565e05016e = []byte{
+	// 240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0x03, 0x31,
+	0x10, 0x85, 0x5d, 0x05, 0x0f, 0x23, 0x65, 0x71, 0x40, 0x68, 0x57, 0x0f, 0x92, 0x83, 0xd4, 0x15,
+	0x76, 0x41, 0xff, 0x81, 0x7a, 0xb5, 0x87, 0x82, 0x17, 0x2f, 0x25, 0x6d, 0x06, 0x89, 0xac, 0x33,
+	0x31, 0x49, 0xa5, 0x3f, 0xdb, 0x9f, 0x20, 0x46, 0x8b, 0x65, 0xd3, 0x42, 0x6f, 0xe1, 0xe5, 0xcb,
+	0x7b, 0x6f, 0x32, 0x70, 0xc6, 0x62, 0xe8, 0x5d, 0x5b, 0x8e, 0xc4, 0x9a, 0x17, 0xd4, 0x38, 0x2f,
+	0x51, 0xb0, 0xec, 0xc9, 0xea, 0x15, 0x46, 0x8f, 0xb4, 0xe8, 0xb4, 0xa7, 0xa7, 0x7f, 0x75, 0x4a,
+	0x1f, 0x4b, 0x0a, 0x11, 0xaf, 0xa0, 0x0c, 0x51, 0xfb, 0x38, 0x5b, 0xb2, 0x5d, 0xcd, 0x58, 0xb3,
+	0x0c, 0x8b, 0xcb, 0x62, 0x7c, 0x34, 0x1d, 0x24, 0xf9, 0x99, 0xed, 0x6a, 0xa2, 0x59, 0x50, 0xc1,
+	0x80, 0xd8, 0x6c, 0x50, 0x87, 0x89, 0x3a, 0x21, 0x36, 0x6b, 0x46, 0x5d, 0x40, 0xb5, 0x2d, 0x28,
+	0x38, 0xe1, 0x40, 0xaa, 0x82, 0xe1, 0xc3, 0x8f, 0xd0, 0xe5, 0x2d, 0xd4, 0x39, 0x8c, 0xb6, 0xdc,
+	0xfd, 0x3e, 0xbc, 0xfd, 0x2a, 0xa0, 0x9c, 0x88, 0xd9, 0x34, 0x45, 0x01, 0xcc, 0xa3, 0xb0, 0x6e,
+	0xfa, 0x5f, 0xb2, 0x73, 0xf0, 0xea, 0x66, 0x2f, 0xf6, 0xaf, 0xfb, 0x01, 0x76, 0x70, 0x9a, 0x35,
+	0xc4, 0xeb, 0xcc, 0x63, 0xd7, 0x84, 0x55, 0xbd, 0x0f, 0xba, 0x4e, 0xbb, 0xaf, 0x5f, 0xc6, 0x21,
+	0x8a, 0x7f, 0x6b, 0xac, 0xb4, 0xe9, 0xd0, 0x3a, 0x6f, 0x3f, 0x75, 0xa4, 0xb6, 0xe7, 0xe2, 0xe6,
+	0xf3, 0xe3, 0xb4, 0xf6, 0xbb, 0xef, 0x01, 0x00, 0xcc, 0xfa, 0x26, 0xdb, 0x0f, 0x02, 0x00, 0x00,
+}
@@ -0,0 +1,161 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20240709171858-0075ac871661
+// source: nodemaintenance.proto
+
+package nodemaintenancepb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_nodemaintenance_proto struct{}
+
+func (drpcEncoding_File_nodemaintenance_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_nodemaintenance_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_nodemaintenance_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_nodemaintenance_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCNodeMaintenanceClient interface {
+	DRPCConn() drpc.Conn
+
+	DeclareMaintenance(ctx context.Context, in *DeclareMaintenanceRequest) (*DeclareMaintenanceResponse, error)
+	CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error)
+}
+
+type drpcNodeMaintenanceClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCNodeMaintenanceClient(cc drpc.Conn) DRPCNodeMaintenanceClient {
+	return &drpcNodeMaintenanceClient{cc}
+}
+
+func (c *drpcNodeMaintenanceClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcNodeMaintenanceClient) DeclareMaintenance(ctx context.Context, in *DeclareMaintenanceRequest) (*DeclareMaintenanceResponse, error) {
+	out := new(DeclareMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/nodemaintenance.NodeMaintenance/DeclareMaintenance", drpcEncoding_File_nodemaintenance_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcNodeMaintenanceClient) CancelMaintenance(ctx context.Context, in *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error) {
+	out := new(CancelMaintenanceResponse)
+	err := c.cc.Invoke(ctx, "/nodemaintenance.NodeMaintenance/CancelMaintenance", drpcEncoding_File_nodemaintenance_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCNodeMaintenanceServer interface {
+	DeclareMaintenance(context.Context, *DeclareMaintenanceRequest) (*DeclareMaintenanceResponse, error)
+	CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error)
+}
+
+type DRPCNodeMaintenanceUnimplementedServer struct{}
+
+func (s *DRPCNodeMaintenanceUnimplementedServer) DeclareMaintenance(context.Context, *DeclareMaintenanceRequest) (*DeclareMaintenanceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCNodeMaintenanceUnimplementedServer) CancelMaintenance(context.Context, *CancelMaintenanceRequest) (*CancelMaintenanceResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCNodeMaintenanceDescription struct{}
+
+func (DRPCNodeMaintenanceDescription) NumMethods() int { return 2 }
+
+func (DRPCNodeMaintenanceDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/nodemaintenance.NodeMaintenance/DeclareMaintenance", drpcEncoding_File_nodemaintenance_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeMaintenanceServer).
+					DeclareMaintenance(
+						ctx,
+						in1.(*DeclareMaintenanceRequest),
+					)
+			}, DRPCNodeMaintenanceServer.DeclareMaintenance, true
+	case 1:
+		return "/nodemaintenance.NodeMaintenance/CancelMaintenance", drpcEncoding_File_nodemaintenance_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCNodeMaintenanceServer).
+					CancelMaintenance(
+						ctx,
+						in1.(*CancelMaintenanceRequest),
+					)
+			}, DRPCNodeMaintenanceServer.CancelMaintenance, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterNodeMaintenance(mux drpc.Mux, impl DRPCNodeMaintenanceServer) error {
+	return mux.Register(impl, DRPCNodeMaintenanceDescription{})
+}
+
+type DRPCNodeMaintenance_DeclareMaintenanceStream interface {
+	drpc.Stream
+	SendAndClose(*DeclareMaintenanceResponse) error
+}
+
+type drpcNodeMaintenance_DeclareMaintenanceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeMaintenance_DeclareMaintenanceStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcNodeMaintenance_DeclareMaintenanceStream) SendAndClose(m *DeclareMaintenanceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_nodemaintenance_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCNodeMaintenance_CancelMaintenanceStream interface {
+	drpc.Stream
+	SendAndClose(*CancelMaintenanceResponse) error
+}
+
+type drpcNodeMaintenance_CancelMaintenanceStream struct {
+	drpc.Stream
+}
+
+func (x *drpcNodeMaintenance_CancelMaintenanceStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcNodeMaintenance_CancelMaintenanceStream) SendAndClose(m *CancelMaintenanceResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_nodemaintenance_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
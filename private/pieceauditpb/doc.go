@@ -0,0 +1,8 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package pieceauditpb contains protobuf definitions for the challenges,
+// which the satellite audits send to the storage nodes.
+package pieceauditpb
+
+//go:generate go run gen.go
@@ -0,0 +1,162 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pieceaudit.proto
+
+package pieceauditpb
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ProveRangesRequest struct {
+	// Serialized GET_AUDIT order limit of the piece, signed by the satellite
+	Limit []byte `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Seed chosen by the satellite, which selects the challenged ranges
+	Seed int64 `protobuf:"varint,2,opt,name=seed,proto3" json:"seed,omitempty"`
+	// Size of a single range, every range starts at a multiple of it
+	RangeSize int32 `protobuf:"varint,3,opt,name=range_size,json=rangeSize,proto3" json:"range_size,omitempty"`
+	// Number of ranges the piece is split into
+	NumRanges int32 `protobuf:"varint,4,opt,name=num_ranges,json=numRanges,proto3" json:"num_ranges,omitempty"`
+	// Number of challenged ranges
+	RangeCount           int32    `protobuf:"varint,5,opt,name=range_count,json=rangeCount,proto3" json:"range_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProveRangesRequest) Reset()         { *m = ProveRangesRequest{} }
+func (m *ProveRangesRequest) String() string { return proto.CompactTextString(m) }
+func (*ProveRangesRequest) ProtoMessage()    {}
+func (*ProveRangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe31a6e61ee74f, []int{0}
+}
+func (m *ProveRangesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProveRangesRequest.Unmarshal(m, b)
+}
+func (m *ProveRangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProveRangesRequest.Marshal(b, m, deterministic)
+}
+func (m *ProveRangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProveRangesRequest.Merge(m, src)
+}
+func (m *ProveRangesRequest) XXX_Size() int {
+	return xxx_messageInfo_ProveRangesRequest.Size(m)
+}
+func (m *ProveRangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProveRangesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProveRangesRequest proto.InternalMessageInfo
+
+func (m *ProveRangesRequest) GetLimit() []byte {
+	if m != nil {
+		return m.Limit
+	}
+	return nil
+}
+
+func (m *ProveRangesRequest) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
+func (m *ProveRangesRequest) GetRangeSize() int32 {
+	if m != nil {
+		return m.RangeSize
+	}
+	return 0
+}
+
+func (m *ProveRangesRequest) GetNumRanges() int32 {
+	if m != nil {
+		return m.NumRanges
+	}
+	return 0
+}
+
+func (m *ProveRangesRequest) GetRangeCount() int32 {
+	if m != nil {
+		return m.RangeCount
+	}
+	return 0
+}
+
+type ProveRangesResponse struct {
+	// XOR of the challenged ranges
+	Proof                []byte   `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProveRangesResponse) Reset()         { *m = ProveRangesResponse{} }
+func (m *ProveRangesResponse) String() string { return proto.CompactTextString(m) }
+func (*ProveRangesResponse) ProtoMessage()    {}
+func (*ProveRangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14fe31a6e61ee74f, []int{1}
+}
+func (m *ProveRangesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProveRangesResponse.Unmarshal(m, b)
+}
+func (m *ProveRangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProveRangesResponse.Marshal(b, m, deterministic)
+}
+func (m *ProveRangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProveRangesResponse.Merge(m, src)
+}
+func (m *ProveRangesResponse) XXX_Size() int {
+	return xxx_messageInfo_ProveRangesResponse.Size(m)
+}
+func (m *ProveRangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProveRangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProveRangesResponse proto.InternalMessageInfo
+
+func (m *ProveRangesResponse) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ProveRangesRequest)(nil), "pieceaudit.ProveRangesRequest")
+	proto.RegisterType((*ProveRangesResponse)(nil), "pieceaudit.ProveRangesResponse")
+}
+
+func init() { proto.RegisterFile("pieceaudit.proto", fileDescriptor_14fe31a6e61ee74f) }
+
+var fileDescriptor_14fe31a6e61ee74f = []byte{
+	// 246 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x50, 0x4d, 0x4b, 0x03, 0x31,
+	0x10, 0x35, 0xb6, 0x2b, 0x38, 0xf5, 0x20, 0xd1, 0x43, 0x10, 0xb4, 0xcb, 0x8a, 0xb0, 0x20, 0xec,
+	0x82, 0xfe, 0x02, 0xf5, 0x0f, 0x2c, 0xf1, 0xe6, 0xc1, 0xb2, 0x6d, 0x47, 0x89, 0xb8, 0x99, 0x98,
+	0x8f, 0x1e, 0xfa, 0x5f, 0xfc, 0xaf, 0x92, 0x04, 0xe9, 0x8a, 0xf4, 0x36, 0xf3, 0x1e, 0x6f, 0xe6,
+	0xbd, 0x07, 0xa7, 0x46, 0xe1, 0x0a, 0xfb, 0xb0, 0x56, 0xbe, 0x31, 0x96, 0x3c, 0x71, 0xd8, 0x21,
+	0xd5, 0x37, 0x03, 0xde, 0x59, 0xda, 0xa0, 0xec, 0xf5, 0x3b, 0x3a, 0x89, 0x5f, 0x01, 0x9d, 0xe7,
+	0xe7, 0x50, 0x7c, 0xaa, 0x41, 0x79, 0xc1, 0x4a, 0x56, 0x9f, 0xc8, 0xbc, 0x70, 0x0e, 0x53, 0x87,
+	0xb8, 0x16, 0x87, 0x25, 0xab, 0x27, 0x32, 0xcd, 0xfc, 0x12, 0xc0, 0x46, 0xe9, 0xc2, 0xa9, 0x2d,
+	0x8a, 0x49, 0xc9, 0xea, 0x42, 0x1e, 0x27, 0xe4, 0x59, 0x6d, 0x31, 0xd2, 0x3a, 0x0c, 0x8b, 0x04,
+	0x38, 0x31, 0xcd, 0xb4, 0x0e, 0x43, 0x7e, 0xc7, 0xe7, 0x30, 0xcb, 0xea, 0x15, 0x05, 0xed, 0x45,
+	0x91, 0xf8, 0x7c, 0xf0, 0x29, 0x22, 0xd5, 0x2d, 0x9c, 0xfd, 0xb1, 0xe7, 0x0c, 0x69, 0x87, 0xd1,
+	0x9f, 0xb1, 0x44, 0x6f, 0xbf, 0xfe, 0xd2, 0x72, 0xf7, 0x0a, 0xd0, 0xc5, 0x68, 0x0f, 0x31, 0x1a,
+	0xef, 0x60, 0x36, 0x92, 0xf2, 0xab, 0x66, 0x54, 0xc4, 0xff, 0xc8, 0x17, 0xf3, 0xbd, 0x7c, 0xfe,
+	0x59, 0x1d, 0x3c, 0xde, 0xbc, 0x5c, 0x3b, 0x4f, 0xf6, 0xa3, 0x51, 0xd4, 0xa6, 0xa1, 0x35, 0x56,
+	0x6d, 0x7a, 0x8f, 0xed, 0x4e, 0x6a, 0x96, 0xcb, 0xa3, 0x54, 0xf3, 0xfd, 0xcf, 0x00, 0x3b, 0x34,
+	0xd6, 0x59, 0x7a, 0x01, 0x00, 0x00,
+}
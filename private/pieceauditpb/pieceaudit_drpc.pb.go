@@ -0,0 +1,117 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20240709171858-0075ac871661
+// source: pieceaudit.proto
+
+package pieceauditpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_pieceaudit_proto struct{}
+
+func (drpcEncoding_File_pieceaudit_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_pieceaudit_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_pieceaudit_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_pieceaudit_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCPieceAuditClient interface {
+	DRPCConn() drpc.Conn
+
+	ProveRanges(ctx context.Context, in *ProveRangesRequest) (*ProveRangesResponse, error)
+}
+
+type drpcPieceAuditClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCPieceAuditClient(cc drpc.Conn) DRPCPieceAuditClient {
+	return &drpcPieceAuditClient{cc}
+}
+
+func (c *drpcPieceAuditClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcPieceAuditClient) ProveRanges(ctx context.Context, in *ProveRangesRequest) (*ProveRangesResponse, error) {
+	out := new(ProveRangesResponse)
+	err := c.cc.Invoke(ctx, "/pieceaudit.PieceAudit/ProveRanges", drpcEncoding_File_pieceaudit_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCPieceAuditServer interface {
+	ProveRanges(context.Context, *ProveRangesRequest) (*ProveRangesResponse, error)
+}
+
+type DRPCPieceAuditUnimplementedServer struct{}
+
+func (s *DRPCPieceAuditUnimplementedServer) ProveRanges(context.Context, *ProveRangesRequest) (*ProveRangesResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCPieceAuditDescription struct{}
+
+func (DRPCPieceAuditDescription) NumMethods() int { return 1 }
+
+func (DRPCPieceAuditDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/pieceaudit.PieceAudit/ProveRanges", drpcEncoding_File_pieceaudit_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCPieceAuditServer).
+					ProveRanges(
+						ctx,
+						in1.(*ProveRangesRequest),
+					)
+			}, DRPCPieceAuditServer.ProveRanges, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterPieceAudit(mux drpc.Mux, impl DRPCPieceAuditServer) error {
+	return mux.Register(impl, DRPCPieceAuditDescription{})
+}
+
+type DRPCPieceAudit_ProveRangesStream interface {
+	drpc.Stream
+	SendAndClose(*ProveRangesResponse) error
+}
+
+type drpcPieceAudit_ProveRangesStream struct {
+	drpc.Stream
+}
+
+func (x *drpcPieceAudit_ProveRangesStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcPieceAudit_ProveRangesStream) SendAndClose(m *ProveRangesResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_pieceaudit_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
@@ -0,0 +1,52 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package pieceauditpb
+
+import (
+	"math/rand"
+	"slices"
+)
+
+// Ranges returns count distinct range indexes out of numRanges, in ascending
+// order, chosen by the seed. When there are fewer ranges, all of them are
+// returned. The satellite and the storage node must pick the same ranges for
+// the same request.
+func Ranges(seed int64, numRanges int32, count int) []int32 {
+	if count <= 0 || numRanges <= 0 {
+		return nil
+	}
+	if int32(count) >= numRanges {
+		ranges := make([]int32, numRanges)
+		for i := range ranges {
+			ranges[i] = int32(i)
+		}
+		return ranges
+	}
+
+	rnd := rand.New(rand.NewSource(seed))
+	chosen := make(map[int32]struct{}, count)
+	ranges := make([]int32, 0, count)
+	for len(ranges) < count {
+		index := rnd.Int31n(numRanges)
+		if _, ok := chosen[index]; ok {
+			continue
+		}
+		chosen[index] = struct{}{}
+		ranges = append(ranges, index)
+	}
+	slices.Sort(ranges)
+	return ranges
+}
+
+// AddToProof adds a challenged range to the proof.
+//
+// The proof is the XOR of all the challenged ranges. XOR is the addition of
+// the Galois field used by the erasure code, so the proofs of all the pieces
+// of a segment form a valid erasure coded stripe, which can be checked like
+// a single stripe audit.
+func AddToProof(proof, data []byte) {
+	for i := range proof {
+		proof[i] ^= data[i]
+	}
+}
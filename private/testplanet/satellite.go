@@ -49,6 +49,7 @@ import (
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodehistory"
 	"storj.io/storj/satellite/nodestats"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -105,6 +106,11 @@ type Satellite struct {
 		Chore    *nodeevents.Chore
 	}
 
+	NodeHistory struct {
+		DB    nodehistory.DB
+		Chore *nodehistory.Chore
+	}
+
 	Metainfo struct {
 		// TODO remove when uplink will be adjusted to use Metabase.DB
 		Metabase *metabase.DB
@@ -666,6 +672,9 @@ func createNewSystem(name string, log *zap.Logger, config satellite.Config, peer
 	system.NodeEvents.Notifier = peer.NodeEvents.Notifier
 	system.NodeEvents.Chore = peer.NodeEvents.Chore
 
+	system.NodeHistory.DB = peer.NodeHistory.DB
+	system.NodeHistory.Chore = peer.NodeHistory.Chore
+
 	system.Reputation.Service = peer.Reputation.Service
 
 	// system.Metainfo.Metabase = api.Metainfo.Metabase
@@ -808,7 +817,7 @@ func (planet *Planet) newRepairer(ctx context.Context, index int, identity *iden
 	}
 	planet.databases = append(planet.databases, revocationDB)
 
-	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), versionInfo, &config, nil)
+	return satellite.NewRepairer(log, identity, metabaseDB, revocationDB, db.RepairQueue(), db.Buckets(), db.OverlayCache(), db.NodeEvents(), db.PlacementConfigs(), db.Reputation(), db.Containment(), versionInfo, &config, nil)
 }
 
 func (planet *Planet) newAuditor(ctx context.Context, index int, identity *identity.FullIdentity, db satellite.DB, metabaseDB *metabase.DB, config satellite.Config, versionInfo version.Info) (_ *satellite.Auditor, err error) {
@@ -823,7 +832,7 @@ func (planet *Planet) newAuditor(ctx context.Context, index int, identity *ident
 	}
 	planet.databases = append(planet.databases, revocationDB)
 
-	return satellite.NewAuditor(log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.OverlayCache(), db.NodeEvents(), db.Reputation(), db.Containment(), versionInfo, &config, nil)
+	return satellite.NewAuditor(log, identity, metabaseDB, revocationDB, db.VerifyQueue(), db.ReverifyQueue(), db.AuditCampaigns(), db.OverlayCache(), db.NodeEvents(), db.PlacementConfigs(), db.Reputation(), db.Containment(), versionInfo, &config, nil)
 }
 
 type rollupsWriteCacheCloser struct {
@@ -159,6 +159,17 @@ type BucketUsage struct {
 	Before time.Time `json:"before"`
 }
 
+// PrefixUsage consist of usage of objects stored under an encrypted prefix of a bucket.
+type PrefixUsage struct {
+	Prefix []byte `json:"prefix"`
+
+	Storage      int64 `json:"storage"`
+	ObjectCount  int64 `json:"objectCount"`
+	SegmentCount int64 `json:"segmentCount"`
+
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
 // BucketUsageCursor holds info for bucket usage
 // cursor pagination.
 type BucketUsageCursor struct {
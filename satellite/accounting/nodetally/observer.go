@@ -5,6 +5,7 @@ package nodetally
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -25,8 +26,8 @@ var (
 
 var (
 	// check if Observer and Partial interfaces are satisfied.
-	_ rangedloop.Observer = (*Observer)(nil)
-	_ rangedloop.Partial  = (*observerFork)(nil)
+	_ rangedloop.ResumableObserver = (*Observer)(nil)
+	_ rangedloop.Partial           = (*observerFork)(nil)
 )
 
 // Config contains configurable values for nodetally observer.
@@ -97,6 +98,30 @@ func (observer *Observer) Join(ctx context.Context, partial rangedloop.Partial)
 	return nil
 }
 
+// SavePartial serializes the per node usage of a node tally ranged loop partial.
+func (observer *Observer) SavePartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tallyPartial, ok := partial.(*observerFork)
+	if !ok {
+		return nil, Error.New("expected partial type %T but got %T", tallyPartial, partial)
+	}
+
+	data, err := json.Marshal(tallyPartial.Node)
+	return data, Error.Wrap(err)
+}
+
+// RestorePartial recreates a node tally ranged loop partial saved by SavePartial.
+func (observer *Observer) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork := newObserverFork(observer.log, observer.nowFn)
+	if err := json.Unmarshal(data, &fork.Node); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return fork, nil
+}
+
 // for backwards compatibility.
 var monRangedTally = monkit.ScopeNamed("storj.io/storj/satellite/accounting/tally")
 
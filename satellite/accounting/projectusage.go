@@ -231,6 +231,19 @@ func (usage *Service) GetProjectBandwidth(ctx context.Context, projectID uuid.UU
 	return total, ErrProjectUsage.Wrap(err)
 }
 
+// GetBucketPrefixTallies returns the bucket usage grouped by prefix as collected by the last tally.
+func (usage *Service) GetBucketPrefixTallies(ctx context.Context, projectID uuid.UUID, bucketName string) (_ []metabase.PrefixTally, err error) {
+	defer mon.Task()(&ctx, projectID)(&err)
+
+	tallies, err := usage.metabaseDB.GetPrefixTallies(ctx, metabase.GetPrefixTallies{
+		BucketLocation: metabase.BucketLocation{
+			ProjectID:  projectID,
+			BucketName: metabase.BucketName(bucketName),
+		},
+	})
+	return tallies, ErrProjectUsage.Wrap(err)
+}
+
 // GetProjectStorageLimit returns current project storage limit.
 func (usage *Service) GetProjectStorageLimit(ctx context.Context, projectID uuid.UUID) (_ memory.Size, err error) {
 	defer mon.Task()(&ctx, projectID)(&err)
@@ -35,6 +35,9 @@ type Config struct {
 
 	ListLimit          int           `help:"how many buckets to query in a batch" default:"2500"`
 	AsOfSystemInterval time.Duration `help:"as of system interval" releaseDefault:"-5m" devDefault:"-1us" testDefault:"-1us"`
+
+	PrefixTallyDepth       int `help:"how many leading path components to group per-prefix usage by, zero disables prefix tallies" default:"0"`
+	PrefixTallyMaxPrefixes int `help:"how many prefix tallies to store per bucket at most, buckets with more prefixes are grouped by fewer path components" default:"1000"`
 }
 
 // Service is the tally service for data stored on each storage node.
@@ -51,6 +54,8 @@ type Service struct {
 	storagenodeAccountingDB accounting.StoragenodeAccounting
 	projectAccountingDB     accounting.ProjectAccounting
 	nowFn                   func() time.Time
+
+	prefixTalliesDeleted bool
 }
 
 // New creates a new tally Service.
@@ -101,6 +106,11 @@ func (service *Service) SetNow(now func() time.Time) {
 	service.nowFn = now
 }
 
+// SetPrefixTallyDepth allows tests to change the depth of the prefix tallies.
+func (service *Service) SetPrefixTallyDepth(depth int) {
+	service.config.PrefixTallyDepth = depth
+}
+
 // Tally calculates data-at-rest usage once.
 //
 // How live accounting is calculated:
@@ -217,6 +227,8 @@ func (service *Service) Tally(ctx context.Context) (err error) {
 
 	errAtRest.Add(service.flushTallies(ctx, intervalStart, buffer))
 
+	errAtRest.Add(service.updatePrefixTallies(ctx, collector.Prefixes))
+
 	updateLiveAccountingTotals(projectTotalsFromBuckets(collector.Bucket))
 
 	var total accounting.BucketTally
@@ -268,11 +280,45 @@ func (service *Service) flushTallies(ctx context.Context, intervalStart time.Tim
 	return nil
 }
 
+// updatePrefixTallies replaces the stored prefix tallies of the buckets with
+// the ones collected in the same pass as the bucket tallies. When prefix
+// tallies are disabled, the previously stored ones are deleted once.
+func (service *Service) updatePrefixTallies(ctx context.Context, prefixes map[metabase.BucketLocation][]metabase.PrefixTally) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if service.config.PrefixTallyDepth <= 0 {
+		if service.prefixTalliesDeleted {
+			return nil
+		}
+		if err := service.metabase.DeleteAllPrefixTallies(ctx); err != nil {
+			return Error.New("deleting prefix tallies failed: %v", err)
+		}
+		service.prefixTalliesDeleted = true
+		return nil
+	}
+
+	var group errs.Group
+	for location, tallies := range prefixes {
+		group.Add(service.metabase.ReplacePrefixTallies(ctx, metabase.ReplacePrefixTallies{
+			BucketLocation: location,
+			Tallies:        tallies,
+		}))
+	}
+
+	if err := group.Err(); err != nil {
+		return Error.New("updating prefix tallies failed: %v", err)
+	}
+	return nil
+}
+
 // BucketTallyCollector collects and adds up tallies for buckets.
 type BucketTallyCollector struct {
 	Now    time.Time
 	Log    *zap.Logger
 	Bucket map[metabase.BucketLocation]*accounting.BucketTally
+	// Prefixes contains the prefix tallies of the buckets, when
+	// Config.PrefixTallyDepth is positive.
+	Prefixes map[metabase.BucketLocation][]metabase.PrefixTally
 
 	metabase            *metabase.DB
 	bucketsDB           buckets.DB
@@ -288,6 +334,8 @@ func NewBucketTallyCollector(log *zap.Logger, now time.Time, db *metabase.DB, bu
 		Log:    log,
 		Bucket: make(map[metabase.BucketLocation]*accounting.BucketTally),
 
+		Prefixes: make(map[metabase.BucketLocation][]metabase.PrefixTally),
+
 		metabase:            db,
 		bucketsDB:           bucketsDB,
 		projectAccountingDB: projectAccountingDB,
@@ -329,6 +377,10 @@ func (observer *BucketTallyCollector) fillBucketTallies(ctx context.Context, sta
 		}
 		for _, loc := range locs {
 			observer.Bucket[loc] = &accounting.BucketTally{BucketLocation: loc}
+			if observer.config.PrefixTallyDepth > 0 {
+				// clears the prefix tallies of the emptied buckets
+				observer.Prefixes[loc] = nil
+			}
 		}
 
 		tallies, err := observer.metabase.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
@@ -337,6 +389,8 @@ func (observer *BucketTallyCollector) fillBucketTallies(ctx context.Context, sta
 			AsOfSystemTime:     startingTime,
 			AsOfSystemInterval: observer.config.AsOfSystemInterval,
 			Now:                observer.Now,
+			PrefixDepth:        max(observer.config.PrefixTallyDepth, 0),
+			MaxPrefixes:        max(observer.config.PrefixTallyMaxPrefixes, 0),
 		})
 		if err != nil {
 			return err
@@ -349,6 +403,10 @@ func (observer *BucketTallyCollector) fillBucketTallies(ctx context.Context, sta
 			bucket.MetadataSize = tally.MetadataSize
 			bucket.ObjectCount = tally.ObjectCount
 			bucket.PendingObjectCount = tally.PendingObjectCount
+
+			if observer.config.PrefixTallyDepth > 0 {
+				observer.Prefixes[tally.BucketLocation] = tally.Prefixes
+			}
 		}
 
 		return nil
@@ -563,3 +563,82 @@ func TestTallyPurge(t *testing.T) {
 		require.ElementsMatch(t, []accounting.BucketTally{tallyAt, tallyAfter}, postPurge)
 	})
 }
+
+func TestTallyPrefixTallies(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: func(log *zap.Logger, index int, config *satellite.Config) {
+				config.Tally.PrefixTallyDepth = 1
+			},
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Accounting.Tally.Loop.Pause()
+
+		location := metabase.BucketLocation{
+			ProjectID:  planet.Uplinks[0].Projects[0].ID,
+			BucketName: "testbucket",
+		}
+
+		for _, key := range []string{"root", "a/1", "a/b/2", "c/3"} {
+			err := planet.Uplinks[0].Upload(ctx, satellite, location.BucketName.String(), key, testrand.Bytes(memory.KiB))
+			require.NoError(t, err)
+		}
+
+		satellite.Accounting.Tally.Loop.TriggerWait()
+
+		tallies, err := satellite.Metabase.DB.GetPrefixTallies(ctx, metabase.GetPrefixTallies{BucketLocation: location})
+		require.NoError(t, err)
+		require.Len(t, tallies, 3)
+
+		objectCounts := map[int64]int{}
+		for _, tally := range tallies {
+			objectCounts[tally.ObjectCount]++
+		}
+		require.Equal(t, map[int64]int{1: 2, 2: 1}, objectCounts)
+
+		// the prefix tallies are collected in every run
+		satellite.Accounting.Tally.Loop.TriggerWait()
+
+		recollected, err := satellite.Metabase.DB.GetPrefixTallies(ctx, metabase.GetPrefixTallies{BucketLocation: location})
+		require.NoError(t, err)
+		require.Len(t, recollected, len(tallies))
+		for i := range tallies {
+			require.Equal(t, tallies[i].Prefix, recollected[i].Prefix)
+			require.Equal(t, tallies[i].ObjectCount, recollected[i].ObjectCount)
+			require.False(t, recollected[i].UpdatedAt.Before(tallies[i].UpdatedAt))
+		}
+
+		err = planet.Uplinks[0].DeleteObject(ctx, satellite, location.BucketName.String(), "root")
+		require.NoError(t, err)
+
+		satellite.Accounting.Tally.Loop.TriggerWait()
+
+		tallies, err = satellite.Metabase.DB.GetPrefixTallies(ctx, metabase.GetPrefixTallies{BucketLocation: location})
+		require.NoError(t, err)
+		require.Len(t, tallies, 2)
+		for _, tally := range tallies {
+			require.NotEmpty(t, tally.Prefix)
+		}
+
+		// changing the depth replaces all the prefixes
+		satellite.Accounting.Tally.SetPrefixTallyDepth(2)
+		satellite.Accounting.Tally.Loop.TriggerWait()
+
+		tallies, err = satellite.Metabase.DB.GetPrefixTallies(ctx, metabase.GetPrefixTallies{BucketLocation: location})
+		require.NoError(t, err)
+		require.Len(t, tallies, 3)
+		for _, tally := range tallies {
+			require.Equal(t, int64(1), tally.ObjectCount)
+		}
+
+		// disabling prefix tallies removes them
+		satellite.Accounting.Tally.SetPrefixTallyDepth(0)
+		satellite.Accounting.Tally.Loop.TriggerWait()
+
+		tallies, err = satellite.Metabase.DB.GetPrefixTallies(ctx, metabase.GetPrefixTallies{BucketLocation: location})
+		require.NoError(t, err)
+		require.Empty(t, tallies)
+	})
+}
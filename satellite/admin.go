@@ -24,11 +24,13 @@ import (
 	"storj.io/storj/satellite/admin"
 	backoffice "storj.io/storj/satellite/admin/back-office"
 	"storj.io/storj/satellite/analytics"
+	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/restkeys"
 	"storj.io/storj/satellite/emission"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripe"
 )
@@ -72,6 +74,10 @@ type Admin struct {
 		Service  *backoffice.Service
 	}
 
+	Audit struct {
+		Campaigns *audit.Campaigns
+	}
+
 	Buckets struct {
 		Service *buckets.Service
 	}
@@ -91,6 +97,10 @@ type Admin struct {
 	Accounting struct {
 		Service *accounting.Service
 	}
+
+	Overlay struct {
+		Service *overlay.Service
+	}
 }
 
 // NewAdmin creates a new satellite admin peer.
@@ -114,6 +124,24 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		peer.REST.Keys = restkeys.NewService(db.OIDC().OAuthTokens(), config.RESTKeys)
 	}
 
+	placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	{ // setup overlay
+		var err error
+		peer.Overlay.Service, err = overlay.NewService(log.Named("overlay"), peer.DB.OverlayCache(), peer.DB.NodeEvents(), placement, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name:  "overlay",
+			Run:   peer.Overlay.Service.Run,
+			Close: peer.Overlay.Service.Close,
+		})
+	}
+
 	{ // setup debug
 		var err error
 		if config.Debug.Addr != "" {
@@ -248,6 +276,14 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 		)
 	}
 
+	{ // setup audit campaigns
+		peer.Audit.Campaigns = audit.NewCampaigns(
+			log.Named("audit:campaigns"),
+			peer.DB.AuditCampaigns(),
+			peer.DB.Reputation(),
+		)
+	}
+
 	{ // setup admin
 		var err error
 		peer.Admin.Listener, err = net.Listen("tcp", config.Admin.Address)
@@ -255,19 +291,19 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			return nil, err
 		}
 
-		placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
-		if err != nil {
-			return nil, err
-		}
-
 		peer.Admin.Service = backoffice.NewService(
 			log.Named("back-office:service"),
 			peer.DB.Console(),
 			peer.DB.ProjectAccounting(),
 			peer.Accounting.Service,
+			peer.DB.OverlayCache(),
+			peer.Audit.Campaigns,
+			peer.DB.NodeHistory(),
+			peer.DB.DurabilityRisks(),
 			placement,
 			config.Metainfo.ProjectLimits.MaxBuckets,
 			config.Metainfo.RateLimiter.Rate,
+			config.Metainfo.RateLimiter.BucketLimits,
 		)
 
 		adminConfig := config.Admin
@@ -283,6 +319,7 @@ func NewAdmin(log *zap.Logger, full *identity.FullIdentity, db DB, metabaseDB *m
 			peer.FreezeAccounts.Service,
 			peer.Analytics.Service,
 			peer.Payments.Accounts,
+			peer.Overlay.Service,
 			peer.Admin.Service,
 			config.Console,
 			adminConfig,
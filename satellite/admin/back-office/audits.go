@@ -0,0 +1,277 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+// AuditCampaignRequest contains the targeted nodes and the number of segments of
+// a new audit campaign. Exactly one of NodeID, Tag, Subnet and Country selects
+// the nodes.
+type AuditCampaignRequest struct {
+	Description string `json:"description"`
+	NodeID      string `json:"nodeID"`
+	// Tag selects the nodes with a tag, in the format name=value.
+	Tag string `json:"tag"`
+	// Subnet selects the nodes of a network, like the last_net of the nodes (e.g. 1.2.3.0).
+	Subnet string `json:"subnet"`
+	// Country selects the nodes of a country, by its ISO country code.
+	Country  string `json:"country"`
+	Segments int    `json:"segments"`
+}
+
+// AuditCampaign contains the information of an audit campaign.
+type AuditCampaign struct {
+	ID          uuid.UUID  `json:"id"`
+	Description string     `json:"description"`
+	Nodes       []string   `json:"nodes"`
+	Segments    int        `json:"segments"`
+	Queued      int        `json:"queued"`
+	Status      string     `json:"status"`
+	Error       string     `json:"error"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	QueuedAt    *time.Time `json:"queuedAt"`
+}
+
+// AuditCampaignNode contains the outcomes of the audits of the pieces of a node in
+// the segments queued by a campaign, and the current reputation of the node.
+type AuditCampaignNode struct {
+	NodeID                string     `json:"nodeID"`
+	Pieces                int64      `json:"pieces"`
+	Pending               int64      `json:"pending"`
+	Successes             int64      `json:"successes"`
+	Failures              int64      `json:"failures"`
+	Offline               int64      `json:"offline"`
+	Contained             int64      `json:"contained"`
+	Unknown               int64      `json:"unknown"`
+	Skipped               int64      `json:"skipped"`
+	AuditScore            float64    `json:"auditScore"`
+	UnknownAuditScore     float64    `json:"unknownAuditScore"`
+	Disqualified          *time.Time `json:"disqualified"`
+	UnknownAuditSuspended *time.Time `json:"unknownAuditSuspended"`
+	UnderReview           *time.Time `json:"underReview"`
+}
+
+// AuditCampaignReport contains the results of an audit campaign.
+type AuditCampaignReport struct {
+	Campaign AuditCampaign       `json:"campaign"`
+	Nodes    []AuditCampaignNode `json:"nodes"`
+}
+
+// StartAuditCampaign starts an audit campaign for the nodes selected by the request.
+func (s *Service) StartAuditCampaign(ctx context.Context, req AuditCampaignRequest) (*AuditCampaign, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if s.campaigns == nil {
+		return nil, api.HTTPError{
+			Status: http.StatusNotImplemented,
+			Err:    Error.New("audit campaigns are not available"),
+		}
+	}
+	if req.Segments <= 0 {
+		return nil, api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("segments must be positive"),
+		}
+	}
+
+	nodes, err := s.campaignNodes(ctx, req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errInvalidCampaignTarget.Has(err) {
+			status = http.StatusBadRequest
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, api.HTTPError{
+			Status: http.StatusNotFound,
+			Err:    Error.New("no nodes match the campaign target"),
+		}
+	}
+
+	campaign, err := s.campaigns.Start(ctx, req.Description, nodes, req.Segments)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	c := auditCampaignFromAudit(campaign)
+	return &c, api.HTTPError{}
+}
+
+// GetAuditCampaigns returns all audit campaigns, the most recent first.
+func (s *Service) GetAuditCampaigns(ctx context.Context) ([]AuditCampaign, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if s.campaigns == nil {
+		return []AuditCampaign{}, api.HTTPError{}
+	}
+
+	list, err := s.campaigns.List(ctx)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+	campaigns := make([]AuditCampaign, 0, len(list))
+	for _, campaign := range list {
+		campaigns = append(campaigns, auditCampaignFromAudit(campaign))
+	}
+	return campaigns, api.HTTPError{}
+}
+
+// GetAuditCampaignReport returns the results of an audit campaign.
+func (s *Service) GetAuditCampaignReport(ctx context.Context, id uuid.UUID) (*AuditCampaignReport, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if s.campaigns == nil {
+		return nil, api.HTTPError{
+			Status: http.StatusNotFound,
+			Err:    Error.New("audit campaign not found"),
+		}
+	}
+
+	report, err := s.campaigns.Report(ctx, id)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if audit.ErrCampaignNotFound.Has(err) {
+			status = http.StatusNotFound
+		}
+		return nil, api.HTTPError{
+			Status: status,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	result := &AuditCampaignReport{
+		Campaign: auditCampaignFromAudit(report.Campaign),
+		Nodes:    make([]AuditCampaignNode, 0, len(report.Nodes)),
+	}
+	for _, node := range report.Nodes {
+		result.Nodes = append(result.Nodes, AuditCampaignNode{
+			NodeID:                node.NodeID.String(),
+			Pieces:                node.Pieces,
+			Pending:               node.Pending,
+			Successes:             node.Successes,
+			Failures:              node.Failures,
+			Offline:               node.Offline,
+			Contained:             node.Contained,
+			Unknown:               node.Unknown,
+			Skipped:               node.Skipped,
+			AuditScore:            node.AuditScore,
+			UnknownAuditScore:     node.UnknownAuditScore,
+			Disqualified:          node.Disqualified,
+			UnknownAuditSuspended: node.UnknownAuditSuspended,
+			UnderReview:           node.UnderReview,
+		})
+	}
+	return result, api.HTTPError{}
+}
+
+// errInvalidCampaignTarget is returned when the target of a campaign is invalid.
+var errInvalidCampaignTarget = errs.Class("invalid campaign target")
+
+// campaignNodes returns the nodes selected by the target of the request.
+func (s *Service) campaignNodes(ctx context.Context, req AuditCampaignRequest) ([]storj.NodeID, error) {
+	targets := 0
+	for _, target := range []string{req.NodeID, req.Tag, req.Subnet, req.Country} {
+		if target != "" {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return nil, errInvalidCampaignTarget.New("exactly one of nodeID, tag, subnet or country is required")
+	}
+
+	if req.NodeID != "" {
+		id, err := storj.NodeIDFromString(req.NodeID)
+		if err != nil {
+			return nil, errInvalidCampaignTarget.Wrap(err)
+		}
+		return []storj.NodeID{id}, nil
+	}
+
+	var match func(node nodeselection.SelectedNode) bool
+	switch {
+	case req.Tag != "":
+		name, value, ok := strings.Cut(req.Tag, "=")
+		if !ok || name == "" {
+			return nil, errInvalidCampaignTarget.New("tag must be in the format name=value")
+		}
+		match = func(node nodeselection.SelectedNode) bool {
+			for _, tag := range node.Tags {
+				if tag.Name == name && string(tag.Value) == value {
+					return true
+				}
+			}
+			return false
+		}
+	case req.Subnet != "":
+		match = func(node nodeselection.SelectedNode) bool {
+			return node.LastNet == req.Subnet
+		}
+	case req.Country != "":
+		country := location.ToCountryCode(req.Country)
+		if country == location.None {
+			return nil, errInvalidCampaignTarget.New("invalid country code: %q", req.Country)
+		}
+		match = func(node nodeselection.SelectedNode) bool {
+			return node.CountryCode == country
+		}
+	}
+
+	participating, err := s.overlay.GetParticipatingNodes(ctx, time.Hour, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []storj.NodeID
+	for _, node := range participating {
+		if match(node) {
+			nodes = append(nodes, node.ID)
+		}
+	}
+	return nodes, nil
+}
+
+func auditCampaignFromAudit(campaign audit.Campaign) AuditCampaign {
+	nodes := make([]string, 0, len(campaign.Nodes))
+	for _, node := range campaign.Nodes {
+		nodes = append(nodes, node.String())
+	}
+	return AuditCampaign{
+		ID:          campaign.ID,
+		Description: campaign.Description,
+		Nodes:       nodes,
+		Segments:    campaign.Segments,
+		Queued:      campaign.Queued,
+		Status:      string(campaign.Status),
+		Error:       campaign.Error,
+		CreatedAt:   campaign.CreatedAt,
+		QueuedAt:    campaign.QueuedAt,
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	admin "storj.io/storj/satellite/admin/back-office"
+)
+
+func TestAuditCampaigns(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+
+		t.Run("invalid target", func(t *testing.T) {
+			for _, req := range []admin.AuditCampaignRequest{
+				{Segments: 10},
+				{NodeID: "invalid", Segments: 10},
+				{Tag: "no-value", Segments: 10},
+				{Country: "XX-invalid", Segments: 10},
+				{NodeID: planet.StorageNodes[0].ID().String(), Country: "DE", Segments: 10},
+				{NodeID: planet.StorageNodes[0].ID().String()},
+			} {
+				_, apiErr := service.StartAuditCampaign(ctx, req)
+				require.Error(t, apiErr.Err)
+				assert.Equal(t, http.StatusBadRequest, apiErr.Status)
+			}
+		})
+
+		t.Run("no matching nodes", func(t *testing.T) {
+			_, apiErr := service.StartAuditCampaign(ctx, admin.AuditCampaignRequest{Subnet: "10.255.255.0", Segments: 10})
+			require.Error(t, apiErr.Err)
+			assert.Equal(t, http.StatusNotFound, apiErr.Status)
+		})
+
+		t.Run("unknown campaign", func(t *testing.T) {
+			_, apiErr := service.GetAuditCampaignReport(ctx, testrand.UUID())
+			require.Error(t, apiErr.Err)
+			assert.Equal(t, http.StatusNotFound, apiErr.Status)
+		})
+
+		t.Run("campaign", func(t *testing.T) {
+			node := planet.StorageNodes[1].ID()
+
+			campaign, apiErr := service.StartAuditCampaign(ctx, admin.AuditCampaignRequest{
+				Description: "support ticket",
+				NodeID:      node.String(),
+				Segments:    10,
+			})
+			require.NoError(t, apiErr.Err)
+			require.Equal(t, []string{node.String()}, campaign.Nodes)
+			require.Equal(t, "support ticket", campaign.Description)
+
+			campaigns, apiErr := service.GetAuditCampaigns(ctx)
+			require.NoError(t, apiErr.Err)
+			require.Len(t, campaigns, 1)
+			require.Equal(t, campaign.ID, campaigns[0].ID)
+
+			report, apiErr := service.GetAuditCampaignReport(ctx, campaign.ID)
+			require.NoError(t, apiErr.Err)
+			require.Equal(t, campaign.ID, report.Campaign.ID)
+			require.Len(t, report.Nodes, 1)
+			require.Equal(t, node.String(), report.Nodes[0].NodeID)
+		})
+	})
+}
@@ -39,6 +39,9 @@ const (
 	PermBucketSetDataPlacement
 	PermBucketRemoveDataPlacement
 	PermBucketSetUserAgent
+	PermAuditCampaignView
+	PermAuditCampaignStart
+	PermNodeView
 )
 
 // These constants are the list of roles that users can have and the service uses to match
@@ -52,9 +55,11 @@ const (
 			PermProjectView | PermProjectSetLimits | PermProjectSetDataPlacement |
 			PermProjectRemoveDataPlacement | PermProjectSetUserAgent | PermProjectSendInvitation |
 			PermBucketView | PermBucketSetDataPlacement | PermBucketRemoveDataPlacement |
-			PermBucketSetUserAgent,
+			PermBucketSetUserAgent | PermAuditCampaignView | PermAuditCampaignStart | PermNodeView,
+	)
+	RoleViewer = Authorization(
+		PermAccountView | PermProjectView | PermBucketView | PermAuditCampaignView | PermNodeView,
 	)
-	RoleViewer          = Authorization(PermAccountView | PermProjectView | PermBucketView)
 	RoleCustomerSupport = Authorization(
 		PermAccountView | PermAccountChangeEmail | PermAccountDisableMFA | PermAccountChangeLimits |
 			PermAccountSetDataPlacement | PermAccountRemoveDataPlacement | PermAccountSetUserAgent |
@@ -0,0 +1,60 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"storj.io/storj/private/api"
+)
+
+// maxDurabilityRisks is the maximum number of risks returned by GetDurabilityRisks.
+const maxDurabilityRisks = 1000
+
+// DurabilityRisk shows how many segments would become unhealthy if all the
+// nodes with the same class value (for example the same operator email)
+// vanished.
+type DurabilityRisk struct {
+	Value               string    `json:"value"`
+	NodeCount           int       `json:"nodeCount"`
+	SegmentsBelowRepair int64     `json:"segmentsBelowRepair"`
+	SegmentsIrreparable int64     `json:"segmentsIrreparable"`
+	ReportedAt          time.Time `json:"reportedAt"`
+}
+
+// GetDurabilityRisks returns the latest durability risk report of a node
+// class (like email or last_net), the highest risks first.
+func (s *Service) GetDurabilityRisks(ctx context.Context, className string) ([]DurabilityRisk, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	if className == "" {
+		return nil, api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("class is required"),
+		}
+	}
+
+	risks, err := s.durabilityRisks.GetRisks(ctx, className, maxDurabilityRisks)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	result := make([]DurabilityRisk, 0, len(risks))
+	for _, risk := range risks {
+		result = append(result, DurabilityRisk{
+			Value:               risk.Value,
+			NodeCount:           risk.NodeCount,
+			SegmentsBelowRepair: risk.SegmentsBelowRepair,
+			SegmentsIrreparable: risk.SegmentsIrreparable,
+			ReportedAt:          risk.ReportedAt,
+		})
+	}
+	return result, api.HTTPError{}
+}
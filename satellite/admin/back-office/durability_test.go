@@ -0,0 +1,46 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/durability"
+)
+
+func TestGetDurabilityRisks(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+
+		_, apiErr := service.GetDurabilityRisks(ctx, "")
+		require.Error(t, apiErr.Err)
+		assert.Equal(t, http.StatusBadRequest, apiErr.Status)
+
+		risks, apiErr := service.GetDurabilityRisks(ctx, "email")
+		require.NoError(t, apiErr.Err)
+		require.Empty(t, risks)
+
+		require.NoError(t, sat.DB.DurabilityRisks().ReplaceRisks(ctx, "email", []durability.Risk{
+			{Class: "email", Value: "operator@mail.test", NodeCount: 2, SegmentsBelowRepair: 3, SegmentsIrreparable: 1, ReportedAt: time.Now()},
+		}))
+
+		risks, apiErr = service.GetDurabilityRisks(ctx, "email")
+		require.NoError(t, apiErr.Err)
+		require.Len(t, risks, 1)
+		require.Equal(t, "operator@mail.test", risks[0].Value)
+		require.Equal(t, 2, risks[0].NodeCount)
+		require.EqualValues(t, 3, risks[0].SegmentsBelowRepair)
+		require.EqualValues(t, 1, risks[0].SegmentsIrreparable)
+	})
+}
@@ -13,6 +13,7 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"storj.io/common/uuid"
 	"storj.io/storj/private/apigen"
@@ -95,6 +96,81 @@ func main() {
 		},
 	})
 
+	group = api.Group("AuditManagement", "audits")
+	group.Middleware = append(group.Middleware, authMiddleware{})
+
+	group.Get("/campaigns", &apigen.Endpoint{
+		Name:           "Get audit campaigns",
+		Description:    "Gets the audit campaigns, the most recent first",
+		GoName:         "GetAuditCampaigns",
+		TypeScriptName: "getAuditCampaigns",
+		Response:       []backoffice.AuditCampaign{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermAuditCampaignView},
+		},
+	})
+
+	group.Post("/campaigns", &apigen.Endpoint{
+		Name:           "Start audit campaign",
+		Description:    "Starts an audit campaign, which audits segments with pieces on the selected nodes before the randomly selected segments",
+		GoName:         "StartAuditCampaign",
+		TypeScriptName: "startAuditCampaign",
+		Request:        backoffice.AuditCampaignRequest{},
+		Response:       backoffice.AuditCampaign{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermAuditCampaignStart},
+		},
+	})
+
+	group.Get("/campaigns/{id}", &apigen.Endpoint{
+		Name:           "Get audit campaign report",
+		Description:    "Gets the audit results of the nodes of an audit campaign",
+		GoName:         "GetAuditCampaignReport",
+		TypeScriptName: "getAuditCampaignReport",
+		PathParams: []apigen.Param{
+			apigen.NewParam("id", uuid.UUID{}),
+		},
+		Response: backoffice.AuditCampaignReport{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermAuditCampaignView},
+		},
+	})
+
+	group = api.Group("NodeManagement", "nodes")
+	group.Middleware = append(group.Middleware, authMiddleware{})
+
+	group.Get("/history/{nodeID}", &apigen.Endpoint{
+		Name:           "Get node history",
+		Description:    "Gets the states of a node recorded between since and before, the most recent first",
+		GoName:         "GetNodeHistory",
+		TypeScriptName: "getNodeHistory",
+		PathParams: []apigen.Param{
+			apigen.NewParam("nodeID", ""),
+		},
+		QueryParams: []apigen.Param{
+			apigen.NewParam("since", time.Time{}),
+			apigen.NewParam("before", time.Time{}),
+		},
+		Response: []backoffice.NodeHistoryEntry{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermNodeView},
+		},
+	})
+
+	group.Get("/durability-risks/{className}", &apigen.Endpoint{
+		Name:           "Get durability risks",
+		Description:    "Gets the latest durability risk report of a node class, the class values whose nodes would endanger the most segments first",
+		GoName:         "GetDurabilityRisks",
+		TypeScriptName: "getDurabilityRisks",
+		PathParams: []apigen.Param{
+			apigen.NewParam("className", ""),
+		},
+		Response: []backoffice.DurabilityRisk{},
+		Settings: map[any]any{
+			authPermsKey: []backoffice.Permission{backoffice.PermNodeView},
+		},
+	})
+
 	api.OutputRootDir = findModuleRootDir()
 	api.MustWriteGo(filepath.Join("satellite", "admin", "back-office", "handlers.gen.go"))
 	api.MustWriteTS(filepath.Join("satellite", "admin", "back-office", "ui", "src", "api", "client.gen.ts"))
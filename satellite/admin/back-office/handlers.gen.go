@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/spacemonkeygo/monkit/v3"
@@ -17,10 +18,14 @@ import (
 	"storj.io/storj/private/api"
 )
 
+const dateLayout = "2006-01-02T15:04:05.999Z"
+
 var ErrSettingsAPI = errs.Class("admin settings api")
 var ErrPlacementsAPI = errs.Class("admin placements api")
 var ErrUsersAPI = errs.Class("admin users api")
 var ErrProjectsAPI = errs.Class("admin projects api")
+var ErrAuditsAPI = errs.Class("admin audits api")
+var ErrNodesAPI = errs.Class("admin nodes api")
 
 type SettingsService interface {
 	GetSettings(ctx context.Context) (*Settings, api.HTTPError)
@@ -39,6 +44,17 @@ type ProjectManagementService interface {
 	UpdateProjectLimits(ctx context.Context, publicID uuid.UUID, request ProjectLimitsUpdate) api.HTTPError
 }
 
+type AuditManagementService interface {
+	GetAuditCampaigns(ctx context.Context) ([]AuditCampaign, api.HTTPError)
+	StartAuditCampaign(ctx context.Context, request AuditCampaignRequest) (*AuditCampaign, api.HTTPError)
+	GetAuditCampaignReport(ctx context.Context, id uuid.UUID) (*AuditCampaignReport, api.HTTPError)
+}
+
+type NodeManagementService interface {
+	GetNodeHistory(ctx context.Context, nodeID string, since, before time.Time) ([]NodeHistoryEntry, api.HTTPError)
+	GetDurabilityRisks(ctx context.Context, className string) ([]DurabilityRisk, api.HTTPError)
+}
+
 // SettingsHandler is an api handler that implements all Settings API endpoints functionality.
 type SettingsHandler struct {
 	log     *zap.Logger
@@ -69,6 +85,22 @@ type ProjectManagementHandler struct {
 	auth    *Authorizer
 }
 
+// AuditManagementHandler is an api handler that implements all AuditManagement API endpoints functionality.
+type AuditManagementHandler struct {
+	log     *zap.Logger
+	mon     *monkit.Scope
+	service AuditManagementService
+	auth    *Authorizer
+}
+
+// NodeManagementHandler is an api handler that implements all NodeManagement API endpoints functionality.
+type NodeManagementHandler struct {
+	log     *zap.Logger
+	mon     *monkit.Scope
+	service NodeManagementService
+	auth    *Authorizer
+}
+
 func NewSettings(log *zap.Logger, mon *monkit.Scope, service SettingsService, router *mux.Router) *SettingsHandler {
 	handler := &SettingsHandler{
 		log:     log,
@@ -124,6 +156,37 @@ func NewProjectManagement(log *zap.Logger, mon *monkit.Scope, service ProjectMan
 	return handler
 }
 
+func NewAuditManagement(log *zap.Logger, mon *monkit.Scope, service AuditManagementService, router *mux.Router, auth *Authorizer) *AuditManagementHandler {
+	handler := &AuditManagementHandler{
+		log:     log,
+		mon:     mon,
+		service: service,
+		auth:    auth,
+	}
+
+	auditsRouter := router.PathPrefix("/back-office/api/v1/audits").Subrouter()
+	auditsRouter.HandleFunc("/campaigns", handler.handleGetAuditCampaigns).Methods("GET")
+	auditsRouter.HandleFunc("/campaigns", handler.handleStartAuditCampaign).Methods("POST")
+	auditsRouter.HandleFunc("/campaigns/{id}", handler.handleGetAuditCampaignReport).Methods("GET")
+
+	return handler
+}
+
+func NewNodeManagement(log *zap.Logger, mon *monkit.Scope, service NodeManagementService, router *mux.Router, auth *Authorizer) *NodeManagementHandler {
+	handler := &NodeManagementHandler{
+		log:     log,
+		mon:     mon,
+		service: service,
+		auth:    auth,
+	}
+
+	nodesRouter := router.PathPrefix("/back-office/api/v1/nodes").Subrouter()
+	nodesRouter.HandleFunc("/history/{nodeID}", handler.handleGetNodeHistory).Methods("GET")
+	nodesRouter.HandleFunc("/durability-risks/{className}", handler.handleGetDurabilityRisks).Methods("GET")
+
+	return handler
+}
+
 func (h *SettingsHandler) handleGetSettings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	var err error
@@ -260,3 +323,172 @@ func (h *ProjectManagementHandler) handleUpdateProjectLimits(w http.ResponseWrit
 		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
 	}
 }
+
+func (h *AuditManagementHandler) handleGetAuditCampaigns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if h.auth.IsRejected(w, r, 8388608) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetAuditCampaigns(ctx)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetAuditCampaigns response", zap.Error(ErrAuditsAPI.Wrap(err)))
+	}
+}
+
+func (h *AuditManagementHandler) handleStartAuditCampaign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	payload := AuditCampaignRequest{}
+	if err = json.NewDecoder(r.Body).Decode(&payload); err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 16777216) {
+		return
+	}
+
+	retVal, httpErr := h.service.StartAuditCampaign(ctx, payload)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json StartAuditCampaign response", zap.Error(ErrAuditsAPI.Wrap(err)))
+	}
+}
+
+func (h *AuditManagementHandler) handleGetAuditCampaignReport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	idParam, ok := mux.Vars(r)["id"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing id route param"))
+		return
+	}
+
+	id, err := uuid.FromString(idParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 8388608) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetAuditCampaignReport(ctx, id)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetAuditCampaignReport response", zap.Error(ErrAuditsAPI.Wrap(err)))
+	}
+}
+
+func (h *NodeManagementHandler) handleGetNodeHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	sinceParam := r.URL.Query().Get("since")
+	if sinceParam == "" {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'since' can't be empty"))
+		return
+	}
+
+	since, err := time.Parse(dateLayout, sinceParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	beforeParam := r.URL.Query().Get("before")
+	if beforeParam == "" {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("parameter 'before' can't be empty"))
+		return
+	}
+
+	before, err := time.Parse(dateLayout, beforeParam)
+	if err != nil {
+		api.ServeError(h.log, w, http.StatusBadRequest, err)
+		return
+	}
+
+	nodeID, ok := mux.Vars(r)["nodeID"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing nodeID route param"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 33554432) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetNodeHistory(ctx, nodeID, since, before)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetNodeHistory response", zap.Error(ErrNodesAPI.Wrap(err)))
+	}
+}
+
+func (h *NodeManagementHandler) handleGetDurabilityRisks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var err error
+	defer h.mon.Task()(&ctx)(&err)
+
+	w.Header().Set("Content-Type", "application/json")
+
+	className, ok := mux.Vars(r)["className"]
+	if !ok {
+		api.ServeError(h.log, w, http.StatusBadRequest, errs.New("missing className route param"))
+		return
+	}
+
+	if h.auth.IsRejected(w, r, 33554432) {
+		return
+	}
+
+	retVal, httpErr := h.service.GetDurabilityRisks(ctx, className)
+	if httpErr.Err != nil {
+		api.ServeError(h.log, w, httpErr.Status, httpErr.Err)
+		return
+	}
+
+	err = json.NewEncoder(w).Encode(retVal)
+	if err != nil {
+		h.log.Debug("failed to write json GetDurabilityRisks response", zap.Error(ErrNodesAPI.Wrap(err)))
+	}
+}
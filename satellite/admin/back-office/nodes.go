@@ -0,0 +1,98 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/private/api"
+	"storj.io/storj/satellite/overlay"
+)
+
+// maxNodeHistoryEntries is the maximum number of entries returned by GetNodeHistory.
+const maxNodeHistoryEntries = 1000
+
+// NodeHistoryEntry is the state of a node at a point in time. Kind is "snapshot"
+// for the periodically recorded states and "transition" for the states recorded
+// when the node changed, e.g. when it was disqualified.
+type NodeHistoryEntry struct {
+	RecordedAt        time.Time `json:"recordedAt"`
+	Kind              string    `json:"kind"`
+	Address           string    `json:"address"`
+	Version           string    `json:"version"`
+	FreeDisk          int64     `json:"freeDisk"`
+	AuditScore        float64   `json:"auditScore"`
+	UnknownAuditScore float64   `json:"unknownAuditScore"`
+	OnlineScore       float64   `json:"onlineScore"`
+	Vetted            bool      `json:"vetted"`
+	Suspended         bool      `json:"suspended"`
+	Exiting           bool      `json:"exiting"`
+	Disqualified      bool      `json:"disqualified"`
+	Details           string    `json:"details"`
+}
+
+// GetNodeHistory returns the states of a node recorded in [since, before), the
+// most recent first.
+func (s *Service) GetNodeHistory(ctx context.Context, nodeID string, since, before time.Time) ([]NodeHistoryEntry, api.HTTPError) {
+	var err error
+	defer mon.Task()(&ctx)(&err)
+
+	id, err := storj.NodeIDFromString(nodeID)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("invalid node ID: %v", err),
+		}
+	}
+	if !before.After(since) {
+		return nil, api.HTTPError{
+			Status: http.StatusBadRequest,
+			Err:    Error.New("before must be after since"),
+		}
+	}
+
+	if _, err = s.overlay.Get(ctx, id); err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			return nil, api.HTTPError{
+				Status: http.StatusNotFound,
+				Err:    Error.New("node not found"),
+			}
+		}
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	entries, err := s.nodeHistory.Get(ctx, id, since, before, maxNodeHistoryEntries)
+	if err != nil {
+		return nil, api.HTTPError{
+			Status: http.StatusInternalServerError,
+			Err:    Error.Wrap(err),
+		}
+	}
+
+	result := make([]NodeHistoryEntry, 0, len(entries))
+	for _, entry := range entries {
+		result = append(result, NodeHistoryEntry{
+			RecordedAt:        entry.RecordedAt,
+			Kind:              entry.Kind.String(),
+			Address:           entry.Address,
+			Version:           entry.Version,
+			FreeDisk:          entry.FreeDisk,
+			AuditScore:        entry.AuditScore,
+			UnknownAuditScore: entry.UnknownAuditScore,
+			OnlineScore:       entry.OnlineScore,
+			Vetted:            entry.Vetted,
+			Suspended:         entry.Suspended,
+			Exiting:           entry.Exiting,
+			Disqualified:      entry.Disqualified,
+			Details:           entry.Details,
+		})
+	}
+	return result, api.HTTPError{}
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestGetNodeHistory(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		service := sat.Admin.Admin.Service
+		node := planet.StorageNodes[0].ID()
+
+		now := time.Now()
+		since, before := now.Add(-time.Hour), now.Add(time.Hour)
+
+		t.Run("invalid request", func(t *testing.T) {
+			_, apiErr := service.GetNodeHistory(ctx, "invalid", since, before)
+			require.Error(t, apiErr.Err)
+			assert.Equal(t, http.StatusBadRequest, apiErr.Status)
+
+			_, apiErr = service.GetNodeHistory(ctx, node.String(), before, since)
+			require.Error(t, apiErr.Err)
+			assert.Equal(t, http.StatusBadRequest, apiErr.Status)
+		})
+
+		t.Run("unknown node", func(t *testing.T) {
+			_, apiErr := service.GetNodeHistory(ctx, testrand.NodeID().String(), since, before)
+			require.Error(t, apiErr.Err)
+			assert.Equal(t, http.StatusNotFound, apiErr.Status)
+		})
+
+		t.Run("history", func(t *testing.T) {
+			require.NoError(t, sat.Overlay.Service.DisqualifyNode(ctx, node, overlay.DisqualificationReasonNodeOffline))
+
+			entries, apiErr := service.GetNodeHistory(ctx, node.String(), since, before)
+			require.NoError(t, apiErr.Err)
+			require.NotEmpty(t, entries)
+			require.Equal(t, "transition", entries[0].Kind)
+			require.True(t, entries[0].Disqualified)
+			require.Equal(t, "disqualified: node offline", entries[0].Details)
+		})
+	})
+}
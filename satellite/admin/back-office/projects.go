@@ -8,6 +8,7 @@ import (
 	"database/sql"
 	"errors"
 	"net/http"
+	"sort"
 	"time"
 
 	"go.uber.org/zap"
@@ -16,6 +17,7 @@ import (
 	"storj.io/common/uuid"
 	"storj.io/storj/private/api"
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/console"
 )
 
 // Project contains the information and configurations of a project.
@@ -34,9 +36,21 @@ type Project struct {
 	BurstLimit *int `json:"burstLimit"`
 	// Maxbuckets is `nil` when satellite applies the configured default max buckets.
 	MaxBuckets *int `json:"maxBuckets"`
+	// BucketRateLimits contains the buckets of the project which have rate limits
+	// configured on top of the project rate limits.
+	BucketRateLimits []BucketRateLimits `json:"bucketRateLimits"`
 	ProjectUsageLimits[*int64]
 }
 
+// BucketRateLimits contains the rate limits configured for a bucket. A nil policy means
+// that only the project rate limits apply to the operation group.
+type BucketRateLimits struct {
+	BucketName string                   `json:"bucketName"`
+	List       *console.RateLimitPolicy `json:"list"`
+	Read       *console.RateLimitPolicy `json:"read"`
+	Write      *console.RateLimitPolicy `json:"write"`
+}
+
 // ProjectUsageLimits holds project usage limits and current usage. It uses generics for allowing
 // to report the limits fields with nil values when they are read from the DB projects table.
 //
@@ -128,6 +142,19 @@ func (s *Service) GetProject(ctx context.Context, id uuid.UUID) (*Project, api.H
 		burst = p.BurstLimit
 	}
 
+	bucketRateLimits := []BucketRateLimits{}
+	for bucketName, limits := range s.defaults.BucketRateLimits.Project(p.ID) {
+		bucketRateLimits = append(bucketRateLimits, BucketRateLimits{
+			BucketName: bucketName,
+			List:       limits.List,
+			Read:       limits.Read,
+			Write:      limits.Write,
+		})
+	}
+	sort.Slice(bucketRateLimits, func(i, j int) bool {
+		return bucketRateLimits[i].BucketName < bucketRateLimits[j].BucketName
+	})
+
 	return &Project{
 		ID:          p.PublicID,
 		Name:        p.Name,
@@ -143,6 +170,7 @@ func (s *Service) GetProject(ctx context.Context, id uuid.UUID) (*Project, api.H
 		RateLimit:        rate,
 		BurstLimit:       burst,
 		MaxBuckets:       maxBuckets,
+		BucketRateLimits: bucketRateLimits,
 		ProjectUsageLimits: ProjectUsageLimits[*int64]{
 			BandwidthLimit: bandwidthl,
 			BandwidthUsed:  bandwidthu,
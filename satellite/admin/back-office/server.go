@@ -90,6 +90,8 @@ func NewServer(
 	NewUserManagement(log, mon, service, root, auth)
 	NewProjectManagement(log, mon, service, root, auth)
 	NewSettings(log, mon, service, root)
+	NewAuditManagement(log, mon, service, root, auth)
+	NewNodeManagement(log, mon, service, root, auth)
 
 	root = root.PathPrefix(PathPrefix).Subrouter()
 	// Static assets for the web interface.
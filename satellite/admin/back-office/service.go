@@ -7,24 +7,33 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/satellite/accounting"
+	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/durability"
+	"storj.io/storj/satellite/nodehistory"
 	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/overlay"
 )
 
 // Defaults contains default values for limits which are not stored in the DB.
 type Defaults struct {
-	MaxBuckets int
-	RateLimit  int
+	MaxBuckets       int
+	RateLimit        int
+	BucketRateLimits console.BucketRateLimits
 }
 
 // Service provides functionality for administrating satellites.
 type Service struct {
-	log          *zap.Logger
-	consoleDB    console.DB
-	accountingDB accounting.ProjectAccounting
-	accounting   *accounting.Service
-	placement    nodeselection.PlacementDefinitions
-	defaults     Defaults
+	log             *zap.Logger
+	consoleDB       console.DB
+	accountingDB    accounting.ProjectAccounting
+	accounting      *accounting.Service
+	overlay         overlay.DB
+	campaigns       *audit.Campaigns
+	nodeHistory     nodehistory.DB
+	durabilityRisks durability.DB
+	placement       nodeselection.PlacementDefinitions
+	defaults        Defaults
 }
 
 // NewService creates a new satellite administration service.
@@ -33,19 +42,29 @@ func NewService(
 	consoleDB console.DB,
 	accountingDB accounting.ProjectAccounting,
 	accounting *accounting.Service,
+	overlay overlay.DB,
+	campaigns *audit.Campaigns,
+	nodeHistory nodehistory.DB,
+	durabilityRisks durability.DB,
 	placement nodeselection.PlacementDefinitions,
 	defaultMaxBuckets int,
 	defaultRateLimit float64,
+	bucketRateLimits console.BucketRateLimits,
 ) *Service {
 	return &Service{
-		log:          log,
-		consoleDB:    consoleDB,
-		accountingDB: accountingDB,
-		accounting:   accounting,
-		placement:    placement,
+		log:             log,
+		consoleDB:       consoleDB,
+		accountingDB:    accountingDB,
+		accounting:      accounting,
+		overlay:         overlay,
+		campaigns:       campaigns,
+		nodeHistory:     nodeHistory,
+		durabilityRisks: durabilityRisks,
+		placement:       placement,
 		defaults: Defaults{
-			MaxBuckets: defaultMaxBuckets,
-			RateLimit:  int(defaultRateLimit),
+			MaxBuckets:       defaultMaxBuckets,
+			RateLimit:        int(defaultRateLimit),
+			BucketRateLimits: bucketRateLimits,
 		},
 	}
 }
@@ -22,6 +22,49 @@ export class AccountFlags {
     view: boolean;
 }
 
+export class AuditCampaign {
+    id: UUID;
+    description: string;
+    nodes: string[] | null;
+    segments: number;
+    queued: number;
+    status: string;
+    error: string;
+    createdAt: Time;
+    queuedAt: Time | null;
+}
+
+export class AuditCampaignNode {
+    nodeID: string;
+    pieces: number;
+    pending: number;
+    successes: number;
+    failures: number;
+    offline: number;
+    contained: number;
+    unknown: number;
+    skipped: number;
+    auditScore: number;
+    unknownAuditScore: number;
+    disqualified: Time | null;
+    unknownAuditSuspended: Time | null;
+    underReview: Time | null;
+}
+
+export class AuditCampaignReport {
+    campaign: AuditCampaign;
+    nodes: AuditCampaignNode[] | null;
+}
+
+export class AuditCampaignRequest {
+    description: string;
+    nodeID: string;
+    tag: string;
+    subnet: string;
+    country: string;
+    segments: number;
+}
+
 export class BucketFlags {
     create: boolean;
     delete: boolean;
@@ -33,6 +76,21 @@ export class BucketFlags {
     view: boolean;
 }
 
+export class BucketRateLimits {
+    bucketName: string;
+    list: RateLimitPolicy | null;
+    read: RateLimitPolicy | null;
+    write: RateLimitPolicy | null;
+}
+
+export class DurabilityRisk {
+    value: string;
+    nodeCount: number;
+    segmentsBelowRepair: number;
+    segmentsIrreparable: number;
+    reportedAt: Time;
+}
+
 export class FeatureFlags {
     account: AccountFlags;
     project: ProjectFlags;
@@ -43,6 +101,22 @@ export class FeatureFlags {
     switchSatellite: boolean;
 }
 
+export class NodeHistoryEntry {
+    recordedAt: Time;
+    kind: string;
+    address: string;
+    version: string;
+    freeDisk: number;
+    auditScore: number;
+    unknownAuditScore: number;
+    onlineScore: number;
+    vetted: boolean;
+    suspended: boolean;
+    exiting: boolean;
+    disqualified: boolean;
+    details: string;
+}
+
 export class PlacementInfo {
     id: number;
     location: string;
@@ -59,6 +133,7 @@ export class Project {
     rateLimit: number | null;
     burstLimit: number | null;
     maxBuckets: number | null;
+    bucketRateLimits: BucketRateLimits[] | null;
     bandwidthLimit: number | null;
     bandwidthUsed: number;
     storageLimit: number | null;
@@ -91,6 +166,11 @@ export class ProjectLimitsUpdate {
     burstLimit: number;
 }
 
+export class RateLimitPolicy {
+    rate: number;
+    burst: number;
+}
+
 export class Settings {
     admin: SettingsAdmin;
 }
@@ -206,3 +286,66 @@ export class ProjectManagementHttpApiV1 {
         throw new APIError(err.error, response.status);
     }
 }
+
+export class AuditManagementHttpApiV1 {
+    private readonly http: HttpClient = new HttpClient();
+    private readonly ROOT_PATH: string = '/back-office/api/v1/audits';
+
+    public async getAuditCampaigns(): Promise<AuditCampaign[]> {
+        const fullPath = `${this.ROOT_PATH}/campaigns`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as AuditCampaign[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async startAuditCampaign(request: AuditCampaignRequest): Promise<AuditCampaign> {
+        const fullPath = `${this.ROOT_PATH}/campaigns`;
+        const response = await this.http.post(fullPath, JSON.stringify(request));
+        if (response.ok) {
+            return response.json().then((body) => body as AuditCampaign);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async getAuditCampaignReport(id: UUID): Promise<AuditCampaignReport> {
+        const fullPath = `${this.ROOT_PATH}/campaigns/${id}`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as AuditCampaignReport);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+}
+
+export class NodeManagementHttpApiV1 {
+    private readonly http: HttpClient = new HttpClient();
+    private readonly ROOT_PATH: string = '/back-office/api/v1/nodes';
+
+    public async getNodeHistory(nodeID: string, since: Time, before: Time): Promise<NodeHistoryEntry[]> {
+        const u = new URL(`${this.ROOT_PATH}/history/${nodeID}`, window.location.href);
+        u.searchParams.set('since', since);
+        u.searchParams.set('before', before);
+        const fullPath = u.toString();
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as NodeHistoryEntry[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+
+    public async getDurabilityRisks(className: string): Promise<DurabilityRisk[]> {
+        const fullPath = `${this.ROOT_PATH}/durability-risks/${className}`;
+        const response = await this.http.get(fullPath);
+        if (response.ok) {
+            return response.json().then((body) => body as DurabilityRisk[]);
+        }
+        const err = await response.json();
+        throw new APIError(err.error, response.status);
+    }
+}
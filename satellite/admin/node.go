@@ -0,0 +1,100 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package admin
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/overlay"
+)
+
+func (server *Server) setNodeMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, ok := nodeIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		sendJSONError(w, "failed to read body",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var input struct {
+		Start time.Time `json:"start"`
+		End   time.Time `json:"end"`
+	}
+	err = json.Unmarshal(body, &input)
+	if err != nil {
+		sendJSONError(w, "failed to unmarshal request",
+			err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = server.overlay.SetNodeMaintenance(ctx, nodeID, overlay.MaintenanceWindow{Start: input.Start, End: input.End})
+	if err != nil {
+		if overlay.ErrInvalidMaintenanceWindow.Has(err) {
+			sendJSONError(w, "invalid maintenance window",
+				err.Error(), http.StatusBadRequest)
+			return
+		}
+		if overlay.ErrNodeNotFound.Has(err) {
+			sendJSONError(w, "node not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to set maintenance window",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+func (server *Server) clearNodeMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	nodeID, ok := nodeIDFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	err := server.overlay.ClearNodeMaintenance(ctx, nodeID)
+	if err != nil {
+		if overlay.ErrNodeNotFound.Has(err) {
+			sendJSONError(w, "node not found",
+				"", http.StatusNotFound)
+			return
+		}
+		sendJSONError(w, "failed to clear maintenance window",
+			err.Error(), http.StatusInternalServerError)
+		return
+	}
+}
+
+// nodeIDFromRequest parses the node ID of the request path. It sends the
+// error response when the node ID is missing or invalid.
+func nodeIDFromRequest(w http.ResponseWriter, r *http.Request) (storj.NodeID, bool) {
+	idString, ok := mux.Vars(r)["nodeid"]
+	if !ok {
+		sendJSONError(w, "node-id missing",
+			"", http.StatusBadRequest)
+		return storj.NodeID{}, false
+	}
+
+	nodeID, err := storj.NodeIDFromString(idString)
+	if err != nil {
+		sendJSONError(w, "invalid node-id",
+			err.Error(), http.StatusBadRequest)
+		return storj.NodeID{}, false
+	}
+	return nodeID, true
+}
@@ -31,6 +31,7 @@ import (
 	"storj.io/storj/satellite/console/restkeys"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/oidc"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/stripe"
 )
@@ -94,6 +95,7 @@ type Server struct {
 	restKeys       *restkeys.Service
 	analytics      *analytics.Service
 	freezeAccounts *console.AccountFreezeService
+	overlay        *overlay.Service
 
 	nowFn func() time.Time
 
@@ -112,6 +114,7 @@ func NewServer(
 	freezeAccounts *console.AccountFreezeService,
 	analyticsService *analytics.Service,
 	accounts payments.Accounts,
+	overlay *overlay.Service,
 	backOfficeService *backoffice.Service,
 	console consoleweb.Config,
 	config Config,
@@ -128,6 +131,7 @@ func NewServer(
 		restKeys:       restKeys,
 		analytics:      analyticsService,
 		freezeAccounts: freezeAccounts,
+		overlay:        overlay,
 
 		nowFn: time.Now,
 
@@ -175,6 +179,8 @@ func NewServer(
 	fullAccessAPI.HandleFunc("/apikeys/{apikey}", server.deleteAPIKey).Methods("DELETE")
 	fullAccessAPI.HandleFunc("/restkeys/{useremail}", server.addRESTKey).Methods("POST")
 	fullAccessAPI.HandleFunc("/restkeys/{apikey}/revoke", server.revokeRESTKey).Methods("PUT")
+	fullAccessAPI.HandleFunc("/nodes/{nodeid}/maintenance", server.setNodeMaintenance).Methods("PUT")
+	fullAccessAPI.HandleFunc("/nodes/{nodeid}/maintenance", server.clearNodeMaintenance).Methods("DELETE")
 
 	// limit update access required
 	limitUpdateAPI := api.NewRoute().Subrouter()
@@ -29,6 +29,7 @@ import (
 	"storj.io/common/version"
 	"storj.io/storj/private/healthcheck"
 	"storj.io/storj/private/lifecycle"
+	"storj.io/storj/private/nodemaintenancepb"
 	"storj.io/storj/private/server"
 	"storj.io/storj/private/version/checker"
 	"storj.io/storj/satellite/abtesting"
@@ -100,6 +101,10 @@ type API struct {
 		Service *overlay.Service
 	}
 
+	Placement struct {
+		Reloader *nodeselection.PlacementReloader
+	}
+
 	Reputation struct {
 		Service *reputation.Service
 	}
@@ -207,6 +212,8 @@ type API struct {
 
 	SuccessTrackers *metainfo.SuccessTrackers
 	FailureTracker  metainfo.SuccessTracker
+	LatencyTrackers *metainfo.LatencyTrackers
+	UplinkLocations *metainfo.UplinkLocations
 	TrustedUplinks  *trust.TrustedPeersList
 }
 
@@ -335,13 +342,26 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.FailureTracker = metainfo.NewStochasticPercentSuccessTracker(float32(config.Metainfo.FailureTrackerChanceToSkip))
 		monkit.ScopeNamed(mon.Name() + ".failure_tracker").Chain(peer.FailureTracker)
 
+		newLatencyTracker, err := metainfo.GetNewLatencyTracker(config.Metainfo.LatencyTrackerPercentile)
+		if err != nil {
+			return nil, err
+		}
+		peer.LatencyTrackers = metainfo.NewLatencyTrackers(successTrackerUplinks, newLatencyTracker)
+		monkit.ScopeNamed(mon.Name() + ".latency_trackers").Chain(peer.LatencyTrackers)
+
 		peer.TrustedUplinks = trust.NewTrustedPeerList(trustedUplinkSlice)
+
+		peer.UplinkLocations = metainfo.NewUplinkLocations(config.Metainfo.UplinkLocationCapacity, config.Metainfo.UplinkLocationExpiration)
 	}
 
-	placements, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nodeselection.NewPlacementConfigEnvironment(peer.SuccessTrackers, peer.FailureTracker))
+	placementEnvironment := nodeselection.NewPlacementConfigEnvironment(peer.SuccessTrackers, peer.FailureTracker, peer.UplinkLocations).
+		WithLatencyTracker(peer.LatencyTrackers)
+	peer.Placement.Reloader, err = nodeselection.LoadPlacementReloader(context.TODO(), peer.Log.Named("placement:reloader"),
+		config.Placement, config.Overlay.Node.CreateDefaultPlacement, peer.DB.PlacementConfigs(), config.PlacementReload, placementEnvironment)
 	if err != nil {
 		return nil, err
 	}
+	_, placements := peer.Placement.Reloader.Placements()
 
 	{ // setup overlay
 		peer.Overlay.DB = peer.DB.OverlayCache()
@@ -385,6 +405,9 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		if err := pb.DRPCRegisterNode(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
 			return nil, errs.Combine(err, peer.Close())
 		}
+		if err := nodemaintenancepb.DRPCRegisterNodeMaintenance(peer.Server.DRPC(), peer.Contact.Endpoint); err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 
 		peer.Services.Add(lifecycle.Item{
 			Name:  "contact:service",
@@ -426,7 +449,7 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.Overlay.Service,
 			peer.Orders.DB,
-			placements.CreateFilters,
+			peer.Placement.Reloader.CreateFilters,
 			config.Orders,
 		)
 		if err != nil {
@@ -470,6 +493,8 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			peer.DB.Revocation(),
 			peer.SuccessTrackers,
 			peer.FailureTracker,
+			peer.LatencyTrackers,
+			peer.UplinkLocations,
 			peer.TrustedUplinks,
 			config.Metainfo,
 			migrationModeFlag,
@@ -492,6 +517,19 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	if peer.Placement.Reloader.Enabled() { // setup placement reloader
+		peer.Placement.Reloader.OnReload(peer.Overlay.Service.SetPlacements)
+		peer.Placement.Reloader.OnReload(peer.Metainfo.Endpoint.SetPlacements)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "placement:reloader",
+			Run:   peer.Placement.Reloader.Run,
+			Close: peer.Placement.Reloader.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Placement Reloader", peer.Placement.Reloader.Loop))
+	}
+
 	{ // setup userinfo.
 		if config.Userinfo.Enabled {
 			peer.Userinfo.Endpoint, err = userinfo.NewEndpoint(
@@ -773,6 +811,10 @@ func NewAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 					ObjectLockEnabled:              config.Metainfo.ObjectLockEnabled,
 					UseBucketLevelObjectVersioning: config.Metainfo.UseBucketLevelObjectVersioning,
 				},
+				console.ScopedRateLimits{
+					Buckets: config.Metainfo.RateLimiter.BucketLimits,
+					APIKeys: config.Metainfo.RateLimiter.APIKeyLimits,
+				},
 				peer.Valdi.Service,
 				consoleConfig.Config,
 			)
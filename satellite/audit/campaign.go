@@ -0,0 +1,328 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/reputation"
+)
+
+// ErrCampaign is the error class for audit campaigns.
+var ErrCampaign = errs.Class("audit campaign")
+
+// ErrCampaignNotFound is returned when a campaign doesn't exist.
+var ErrCampaignNotFound = errs.Class("audit campaign not found")
+
+// CampaignStatus is the state of an audit campaign.
+type CampaignStatus string
+
+const (
+	// CampaignPending is a campaign waiting for the next run of the ranged loop.
+	CampaignPending CampaignStatus = "pending"
+	// CampaignCollecting is a campaign whose segments are collected by the ranged loop.
+	CampaignCollecting CampaignStatus = "collecting"
+	// CampaignQueued is a campaign whose segments are in the verify queue.
+	CampaignQueued CampaignStatus = "queued"
+	// CampaignFailed is a campaign which couldn't queue its segments.
+	CampaignFailed CampaignStatus = "failed"
+)
+
+// Campaign is an audit of segments with pieces on specific nodes, which is
+// triggered by an operator instead of waiting for the random sampling.
+type Campaign struct {
+	ID          uuid.UUID
+	Description string
+	Nodes       []storj.NodeID
+	// Segments is the requested number of segments to audit.
+	Segments int
+	// Queued is the number of segments pushed to the verify queue so far.
+	Queued int
+
+	Status    CampaignStatus
+	Error     string
+	CreatedAt time.Time
+	QueuedAt  *time.Time
+}
+
+// CampaignPiece is a piece of a targeted node in a segment queued by a campaign.
+type CampaignPiece struct {
+	StreamID uuid.UUID
+	Position metabase.SegmentPosition
+	NodeID   storj.NodeID
+}
+
+// CampaignPieceCount is the number of pieces of a node in a campaign with
+// the same audit outcome.
+type CampaignPieceCount struct {
+	NodeID storj.NodeID
+	// Audited is false for the pieces, which weren't audited yet.
+	Audited bool
+	Outcome Outcome
+	Count   int64
+}
+
+// CampaignDB stores the audit campaigns and the outcomes of the audits of
+// their pieces, so they survive restarts of the satellite.
+//
+// architecture: Database
+type CampaignDB interface {
+	// Create stores a new campaign.
+	Create(ctx context.Context, campaign Campaign) error
+	// Get returns the campaign with the given ID.
+	Get(ctx context.Context, id uuid.UUID) (Campaign, error)
+	// List returns all campaigns, the most recent first.
+	List(ctx context.Context) ([]Campaign, error)
+	// Update stores the number of queued segments, the status, the error and
+	// the queue time of the campaign.
+	Update(ctx context.Context, campaign Campaign) error
+
+	// AddPieces stores the pieces of the targeted nodes in the segments
+	// queued by the campaign.
+	AddPieces(ctx context.Context, campaignID uuid.UUID, pieces []CampaignPiece) error
+	// RecordOutcomes stores the outcomes of an audit of the segment for the
+	// pieces of all campaigns, which weren't audited yet. The pieces of the
+	// nodes missing from outcomes get the other outcome.
+	RecordOutcomes(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, outcomes map[storj.NodeID]Outcome, other Outcome) error
+	// CountPieces returns the number of pieces of the campaign per node and outcome.
+	CountPieces(ctx context.Context, campaignID uuid.UUID) ([]CampaignPieceCount, error)
+}
+
+// CampaignNodeResult contains the outcomes of the audits of the pieces of a
+// node in the segments queued by a campaign, and the current reputation of
+// the node.
+type CampaignNodeResult struct {
+	NodeID storj.NodeID
+	// Pieces is the number of pieces of the node in the queued segments.
+	Pieces int64
+	// Pending is the number of pieces, which weren't audited yet.
+	Pending   int64
+	Successes int64
+	Failures  int64
+	Offline   int64
+	// Contained is the number of pieces, which timed out and wait for a
+	// reverification.
+	Contained int64
+	Unknown   int64
+	// Skipped is the number of pieces, which weren't audited, because the
+	// segment was deleted or the node was contained.
+	Skipped int64
+
+	AuditScore            float64
+	UnknownAuditScore     float64
+	Disqualified          *time.Time
+	UnknownAuditSuspended *time.Time
+	UnderReview           *time.Time
+}
+
+// CampaignReport contains the results of a campaign.
+type CampaignReport struct {
+	Campaign Campaign
+	Nodes    []CampaignNodeResult
+}
+
+// Campaigns runs targeted audit campaigns. The segments with pieces on the
+// targeted nodes are collected by the CampaignObserver in the ranged loop and
+// pushed into the verify queue ahead of the randomly selected segments, so
+// the audit workers verify them first. The audit workers record the outcomes
+// of the targeted pieces in the campaign.
+//
+// architecture: Service
+type Campaigns struct {
+	log         *zap.Logger
+	db          CampaignDB
+	reputations reputation.DB
+}
+
+// NewCampaigns creates a new audit campaign service.
+func NewCampaigns(log *zap.Logger, db CampaignDB, reputations reputation.DB) *Campaigns {
+	return &Campaigns{
+		log:         log,
+		db:          db,
+		reputations: reputations,
+	}
+}
+
+// Start creates a new campaign, which audits the given number of segments
+// with pieces on any of the nodes. The segments are collected in the next run
+// of the ranged loop.
+func (campaigns *Campaigns) Start(ctx context.Context, description string, nodes []storj.NodeID, segments int) (_ Campaign, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(nodes) == 0 {
+		return Campaign{}, ErrCampaign.New("no nodes to audit")
+	}
+	if segments <= 0 {
+		return Campaign{}, ErrCampaign.New("invalid number of segments: %d", segments)
+	}
+
+	id, err := uuid.New()
+	if err != nil {
+		return Campaign{}, ErrCampaign.Wrap(err)
+	}
+
+	campaign := Campaign{
+		ID:          id,
+		Description: description,
+		Nodes:       slices.Clone(nodes),
+		Segments:    segments,
+		Status:      CampaignPending,
+		CreatedAt:   time.Now(),
+	}
+	if err := campaigns.db.Create(ctx, campaign); err != nil {
+		return Campaign{}, ErrCampaign.Wrap(err)
+	}
+
+	campaigns.log.Info("audit campaign created",
+		zap.Stringer("Campaign", id),
+		zap.String("Description", description),
+		zap.Int("Nodes", len(nodes)),
+		zap.Int("Segments", segments))
+	return campaign, nil
+}
+
+// Get returns the campaign with the given ID.
+func (campaigns *Campaigns) Get(ctx context.Context, id uuid.UUID) (_ Campaign, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	campaign, err := campaigns.db.Get(ctx, id)
+	if err != nil {
+		if ErrCampaignNotFound.Has(err) {
+			return Campaign{}, err
+		}
+		return Campaign{}, ErrCampaign.Wrap(err)
+	}
+	return campaign, nil
+}
+
+// List returns all campaigns, the most recent first.
+func (campaigns *Campaigns) List(ctx context.Context) (_ []Campaign, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	list, err := campaigns.db.List(ctx)
+	return list, ErrCampaign.Wrap(err)
+}
+
+// Report returns the outcomes of the audits of the pieces of every node of
+// the campaign. Only the audits of the segments queued by the campaign are
+// counted, not the audits of the random sampling.
+func (campaigns *Campaigns) Report(ctx context.Context, id uuid.UUID) (_ CampaignReport, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	campaign, err := campaigns.Get(ctx, id)
+	if err != nil {
+		return CampaignReport{}, err
+	}
+
+	counts, err := campaigns.db.CountPieces(ctx, id)
+	if err != nil {
+		return CampaignReport{}, ErrCampaign.Wrap(err)
+	}
+
+	results := make(map[storj.NodeID]*CampaignNodeResult, len(campaign.Nodes))
+	report := CampaignReport{Campaign: campaign, Nodes: make([]CampaignNodeResult, len(campaign.Nodes))}
+	for i, node := range campaign.Nodes {
+		info, err := campaigns.reputation(ctx, node)
+		if err != nil {
+			return CampaignReport{}, ErrCampaign.Wrap(err)
+		}
+
+		report.Nodes[i] = CampaignNodeResult{
+			NodeID:                node,
+			AuditScore:            score(info.AuditReputationAlpha, info.AuditReputationBeta),
+			UnknownAuditScore:     score(info.UnknownAuditReputationAlpha, info.UnknownAuditReputationBeta),
+			Disqualified:          info.Disqualified,
+			UnknownAuditSuspended: info.UnknownAuditSuspended,
+			UnderReview:           info.UnderReview,
+		}
+		results[node] = &report.Nodes[i]
+	}
+
+	for _, count := range counts {
+		result, ok := results[count.NodeID]
+		if !ok {
+			continue
+		}
+		result.Pieces += count.Count
+		switch {
+		case !count.Audited:
+			result.Pending += count.Count
+		case count.Outcome == OutcomeSuccess:
+			result.Successes += count.Count
+		case count.Outcome == OutcomeFailure:
+			result.Failures += count.Count
+		case count.Outcome == OutcomeNodeOffline:
+			result.Offline += count.Count
+		case count.Outcome == OutcomeTimedOut:
+			result.Contained += count.Count
+		case count.Outcome == OutcomeUnknownError:
+			result.Unknown += count.Count
+		default:
+			result.Skipped += count.Count
+		}
+	}
+	return report, nil
+}
+
+// recordCampaignAudit records the outcomes of the audit of a segment queued
+// by campaigns. err is the error of the audit, the pieces of the nodes
+// missing from the report weren't audited.
+func recordCampaignAudit(ctx context.Context, db CampaignDB, segment Segment, report Report, err error) error {
+	if segment.Priority <= 0 {
+		return nil
+	}
+
+	other := OutcomeNotPerformed
+	if err == nil && report.Segment == nil {
+		// the segment was deleted, expired or modified before the audit.
+		other = OutcomeNotNecessary
+	}
+
+	outcomes := map[storj.NodeID]Outcome{}
+	for _, node := range report.Successes {
+		outcomes[node] = OutcomeSuccess
+	}
+	for _, piece := range report.Fails {
+		outcomes[piece.StorageNode] = OutcomeFailure
+	}
+	for _, node := range report.Offlines {
+		outcomes[node] = OutcomeNodeOffline
+	}
+	for _, pending := range report.PendingAudits {
+		outcomes[pending.Locator.NodeID] = OutcomeTimedOut
+	}
+	for _, node := range report.Unknown {
+		outcomes[node] = OutcomeUnknownError
+	}
+
+	return ErrCampaign.Wrap(db.RecordOutcomes(ctx, segment.StreamID, segment.Position, outcomes, other))
+}
+
+// reputation returns the reputation of the node, or an empty reputation for
+// unknown nodes.
+func (campaigns *Campaigns) reputation(ctx context.Context, node storj.NodeID) (reputation.Info, error) {
+	info, err := campaigns.reputations.Get(ctx, node)
+	if err != nil {
+		if reputation.ErrNodeNotFound.Has(err) {
+			return reputation.Info{}, nil
+		}
+		return reputation.Info{}, err
+	}
+	return *info, nil
+}
+
+func score(alpha, beta float64) float64 {
+	if alpha+beta == 0 {
+		return 0
+	}
+	return alpha / (alpha + beta)
+}
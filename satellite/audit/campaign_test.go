@@ -0,0 +1,106 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/testplanet"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestCampaigns(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		satellite.Audit.Worker.Loop.Pause()
+
+		campaigns := satellite.Admin.Audit.Campaigns
+
+		for i := 0; i < 3; i++ {
+			err := planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "test/path"+string(rune('a'+i)), testrand.Bytes(8*memory.KiB))
+			require.NoError(t, err)
+		}
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 3)
+
+		target := segments[0].Pieces[0].StorageNode
+
+		_, err = campaigns.Start(ctx, "no nodes", nil, 1)
+		require.Error(t, err)
+		_, err = campaigns.Start(ctx, "no segments", []storj.NodeID{target}, 0)
+		require.Error(t, err)
+
+		campaign, err := campaigns.Start(ctx, "suspicious node", []storj.NodeID{target}, 2)
+		require.NoError(t, err)
+		require.Equal(t, audit.CampaignPending, campaign.Status)
+
+		// the segments are collected by the ranged loop.
+		_, err = satellite.RangedLoop.RangedLoop.Service.RunOnce(ctx)
+		require.NoError(t, err)
+
+		campaign, err = campaigns.Get(ctx, campaign.ID)
+		require.NoError(t, err)
+		require.Equal(t, audit.CampaignQueued, campaign.Status, campaign.Error)
+		require.Equal(t, 2, campaign.Queued)
+		require.NotNil(t, campaign.QueuedAt)
+
+		list, err := campaigns.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, campaign.ID, list[0].ID)
+
+		// the pieces of the targeted node are pending until they are audited.
+		report, err := campaigns.Report(ctx, campaign.ID)
+		require.NoError(t, err)
+		require.Len(t, report.Nodes, 1)
+		require.Equal(t, target, report.Nodes[0].NodeID)
+		require.EqualValues(t, 2, report.Nodes[0].Pieces)
+		require.EqualValues(t, 2, report.Nodes[0].Pending)
+
+		// the queued segments have a piece on the targeted node
+		for i := 0; i < campaign.Queued; i++ {
+			queued, err := satellite.Audit.VerifyQueue.Next(ctx)
+			require.NoError(t, err)
+			require.Positive(t, queued.Priority)
+
+			found := false
+			for _, segment := range segments {
+				if segment.StreamID == queued.StreamID && segment.Position == queued.Position {
+					found = slices.ContainsFunc(segment.Pieces, func(piece metabase.Piece) bool {
+						return piece.StorageNode == target
+					})
+				}
+			}
+			require.True(t, found)
+
+			// push it back for the audit worker.
+			require.NoError(t, satellite.Audit.VerifyQueue.PushPriority(ctx, []audit.Segment{queued}, 1))
+		}
+
+		satellite.Audit.Worker.Loop.TriggerWait()
+
+		// only the audits of the campaign are counted.
+		report, err = campaigns.Report(ctx, campaign.ID)
+		require.NoError(t, err)
+		require.Len(t, report.Nodes, 1)
+		require.EqualValues(t, 2, report.Nodes[0].Pieces)
+		require.Zero(t, report.Nodes[0].Pending)
+		require.EqualValues(t, 2, report.Nodes[0].Successes)
+
+		_, err = campaigns.Report(ctx, testrand.UUID())
+		require.True(t, audit.ErrCampaignNotFound.Has(err))
+	})
+}
@@ -0,0 +1,301 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"cmp"
+	"context"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase/rangedloop"
+)
+
+// CampaignObserver collects the segments of the audit campaigns in the ranged
+// loop and pushes them into the verify queue. All campaigns started before a
+// run of the ranged loop are collected by that run, with a uniform random
+// sample of the segments with pieces on the targeted nodes.
+//
+// architecture: Observer
+type CampaignObserver struct {
+	log       *zap.Logger
+	db        CampaignDB
+	queue     VerifyQueue
+	batchSize int
+	seedRand  *rand.Rand
+
+	// The following fields are reset on each segment loop cycle.
+	campaigns []Campaign
+	targets   map[storj.NodeID][]int
+	samples   []*campaignSample
+	startTime time.Time
+}
+
+var _ rangedloop.Observer = (*CampaignObserver)(nil)
+var _ rangedloop.Partial = (*campaignFork)(nil)
+
+// NewCampaignObserver instantiates CampaignObserver.
+func NewCampaignObserver(log *zap.Logger, db CampaignDB, queue VerifyQueue, config Config) *CampaignObserver {
+	return &CampaignObserver{
+		log:       log,
+		db:        db,
+		queue:     queue,
+		batchSize: max(config.VerificationPushBatchSize, 1),
+		seedRand:  rand.New(rand.NewSource(time.Now().Unix())),
+	}
+}
+
+// Start loads the campaigns, which weren't queued yet. A campaign interrupted
+// while collecting only collects the missing segments.
+func (obs *CampaignObserver) Start(ctx context.Context, startTime time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	obs.campaigns = nil
+	obs.targets = make(map[storj.NodeID][]int)
+	obs.samples = nil
+	obs.startTime = startTime
+
+	list, err := obs.db.List(ctx)
+	if err != nil {
+		return ErrCampaign.Wrap(err)
+	}
+
+	// the oldest first.
+	for i := len(list) - 1; i >= 0; i-- {
+		campaign := list[i]
+		if campaign.Status != CampaignPending && campaign.Status != CampaignCollecting {
+			continue
+		}
+
+		campaign.Status = CampaignCollecting
+		if err := obs.db.Update(ctx, campaign); err != nil {
+			return ErrCampaign.Wrap(err)
+		}
+
+		index := len(obs.campaigns)
+		obs.campaigns = append(obs.campaigns, campaign)
+		obs.samples = append(obs.samples, &campaignSample{size: campaign.Segments - campaign.Queued})
+		for _, node := range campaign.Nodes {
+			indexes := obs.targets[node]
+			if len(indexes) > 0 && indexes[len(indexes)-1] == index {
+				continue
+			}
+			obs.targets[node] = append(indexes, index)
+		}
+	}
+	return nil
+}
+
+// Fork returns a new campaign segment collector for the range.
+func (obs *CampaignObserver) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork := &campaignFork{
+		targets:   obs.targets,
+		samples:   make([]*campaignSample, len(obs.samples)),
+		startTime: obs.startTime,
+		rnd:       rand.New(rand.NewSource(obs.seedRand.Int63())),
+	}
+	for i, sample := range obs.samples {
+		fork.samples[i] = &campaignSample{size: sample.size}
+	}
+	return fork, nil
+}
+
+// Join merges the campaign segment collector into the samples of the campaigns.
+func (obs *CampaignObserver) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*campaignFork)
+	if !ok {
+		return errs.New("expected partial type %T but got %T", fork, partial)
+	}
+
+	for i, sample := range fork.samples {
+		obs.samples[i].merge(sample)
+	}
+	return nil
+}
+
+// Finish pushes the collected segments of every campaign into the verify
+// queue. A campaign, which couldn't queue its segments, is marked as failed.
+func (obs *CampaignObserver) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var group errs.Group
+	for i := range obs.campaigns {
+		campaign := &obs.campaigns[i]
+		queued := campaign.Queued
+
+		if err := obs.queueCampaign(ctx, campaign, obs.samples[i].result()); err != nil {
+			if ctx.Err() != nil {
+				// continued in the next run.
+				return err
+			}
+			obs.log.Error("audit campaign failed", zap.Stringer("Campaign", campaign.ID), zap.Error(err))
+			campaign.Status = CampaignFailed
+			campaign.Error = err.Error()
+			group.Add(obs.db.Update(ctx, *campaign))
+			continue
+		}
+
+		mon.Counter("audit_campaign_segments_queued").Inc(int64(campaign.Queued - queued))
+		obs.log.Info("audit campaign queued", zap.Stringer("Campaign", campaign.ID), zap.Int("Segments", campaign.Queued))
+	}
+	return ErrCampaign.Wrap(group.Err())
+}
+
+// queueCampaign pushes the segments of a campaign in batches and marks the
+// campaign as queued.
+func (obs *CampaignObserver) queueCampaign(ctx context.Context, campaign *Campaign, segments []campaignSegment) error {
+	for start := 0; start < len(segments); start += obs.batchSize {
+		if err := obs.push(ctx, campaign, segments[start:min(start+obs.batchSize, len(segments))]); err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	campaign.Status = CampaignQueued
+	campaign.QueuedAt = &now
+	return obs.db.Update(ctx, *campaign)
+}
+
+// push stores the targeted pieces of a batch of segments and pushes the
+// segments to the verify queue. The pieces are stored first, so the audit
+// workers find them.
+func (obs *CampaignObserver) push(ctx context.Context, campaign *Campaign, batch []campaignSegment) error {
+	var pieces []CampaignPiece
+	segments := make([]Segment, 0, len(batch))
+	for _, collected := range batch {
+		for _, node := range collected.nodes {
+			pieces = append(pieces, CampaignPiece{
+				StreamID: collected.segment.StreamID,
+				Position: collected.segment.Position,
+				NodeID:   node,
+			})
+		}
+		segments = append(segments, collected.segment)
+	}
+
+	if err := obs.db.AddPieces(ctx, campaign.ID, pieces); err != nil {
+		return err
+	}
+	if err := obs.queue.PushPriority(ctx, segments, obs.batchSize); err != nil {
+		return err
+	}
+
+	campaign.Queued += len(batch)
+	return obs.db.Update(ctx, *campaign)
+}
+
+// campaignFork collects the segments of the campaigns in a range.
+type campaignFork struct {
+	// targets contains the indexes of the campaigns targeting the node. It's
+	// shared by all forks and must not be modified.
+	targets   map[storj.NodeID][]int
+	samples   []*campaignSample
+	startTime time.Time
+	rnd       *rand.Rand
+
+	matched map[int][]storj.NodeID
+}
+
+// Process samples the segments with pieces on the targeted nodes.
+func (fork *campaignFork) Process(ctx context.Context, segments []rangedloop.Segment) error {
+	if len(fork.targets) == 0 {
+		return nil
+	}
+
+	for i := range segments {
+		segment := &segments[i]
+		if segment.Inline() || segment.Expired(fork.startTime) {
+			continue
+		}
+
+		for _, piece := range segment.Pieces {
+			for _, index := range fork.targets[piece.StorageNode] {
+				if fork.matched == nil {
+					fork.matched = make(map[int][]storj.NodeID)
+				}
+				fork.matched[index] = append(fork.matched[index], piece.StorageNode)
+			}
+		}
+		if len(fork.matched) == 0 {
+			continue
+		}
+
+		for index, nodes := range fork.matched {
+			fork.samples[index].add(fork.rnd.Float64(), campaignSegment{
+				segment: NewSegment(*segment),
+				nodes:   nodes,
+			})
+		}
+		clear(fork.matched)
+	}
+	return nil
+}
+
+// campaignSegment is a collected segment with the targeted nodes storing its pieces.
+type campaignSegment struct {
+	segment Segment
+	nodes   []storj.NodeID
+}
+
+// campaignSample is a uniform random sample of up to size segments. Every
+// segment gets a random key and the segments with the lowest keys are kept,
+// so the samples of the forks can be merged.
+type campaignSample struct {
+	size     int
+	segments []sampledCampaignSegment
+}
+
+// sampledCampaignSegment is a segment of a campaign sample with its key.
+type sampledCampaignSegment struct {
+	key float64
+	campaignSegment
+}
+
+// add adds the segment to the sample.
+func (sample *campaignSample) add(key float64, segment campaignSegment) {
+	if sample.size <= 0 {
+		return
+	}
+	sample.segments = append(sample.segments, sampledCampaignSegment{key: key, campaignSegment: segment})
+	if len(sample.segments) >= 2*sample.size {
+		sample.truncate()
+	}
+}
+
+// merge adds the segments of the other sample.
+func (sample *campaignSample) merge(other *campaignSample) {
+	for _, segment := range other.segments {
+		sample.add(segment.key, segment.campaignSegment)
+	}
+}
+
+// truncate keeps the segments with the lowest keys.
+func (sample *campaignSample) truncate() {
+	slices.SortFunc(sample.segments, func(a, b sampledCampaignSegment) int {
+		return cmp.Compare(a.key, b.key)
+	})
+	if len(sample.segments) > sample.size {
+		clear(sample.segments[sample.size:])
+		sample.segments = sample.segments[:sample.size]
+	}
+}
+
+// result returns the sampled segments.
+func (sample *campaignSample) result() []campaignSegment {
+	sample.truncate()
+	result := make([]campaignSegment, len(sample.segments))
+	for i, segment := range sample.segments {
+		result[i] = segment.campaignSegment
+	}
+	return result
+}
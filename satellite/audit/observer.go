@@ -5,6 +5,8 @@ package audit
 
 import (
 	"context"
+	"encoding/json"
+	"math"
 	"math/rand"
 	"time"
 
@@ -29,7 +31,7 @@ type Observer struct {
 	Reservoirs map[metabase.NodeAlias]*Reservoir
 }
 
-var _ rangedloop.Observer = (*Observer)(nil)
+var _ rangedloop.ResumableObserver = (*Observer)(nil)
 var _ rangedloop.Partial = (*observerFork)(nil)
 
 // NewObserver instantiates Observer.
@@ -88,6 +90,76 @@ func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err
 	return nil
 }
 
+// savedSample is a segment picked by a reservoir together with its key, as
+// stored by SavePartial. Only the fields used to build the audit queue are
+// kept.
+type savedSample struct {
+	Key           float64                  `json:"key"`
+	StreamID      uuid.UUID                `json:"stream_id"`
+	Position      metabase.SegmentPosition `json:"position"`
+	ExpiresAt     *time.Time               `json:"expires_at,omitempty"`
+	EncryptedSize int32                    `json:"encrypted_size"`
+}
+
+// SavePartial serializes the per-node reservoirs of the audit reservoir collector.
+func (obs *Observer) SavePartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return nil, errs.New("expected partial type %T but got %T", fork, partial)
+	}
+
+	saved := make(map[metabase.NodeAlias][]savedSample, len(fork.reservoirs))
+	for nodeAlias, reservoir := range fork.reservoirs {
+		keys := reservoir.Keys()
+		samples := make([]savedSample, 0, len(keys))
+		for i, segment := range reservoir.Segments() {
+			key := keys[i]
+			if math.IsInf(key, 1) {
+				// JSON can't represent infinity; it's the worst key either way.
+				key = math.MaxFloat64
+			}
+			samples = append(samples, savedSample{
+				Key:           key,
+				StreamID:      segment.StreamID,
+				Position:      segment.Position,
+				ExpiresAt:     segment.ExpiresAt,
+				EncryptedSize: segment.EncryptedSize,
+			})
+		}
+		saved[nodeAlias] = samples
+	}
+
+	data, err := json.Marshal(saved)
+	return data, errs.Wrap(err)
+}
+
+// RestorePartial recreates an audit reservoir collector saved by SavePartial.
+func (obs *Observer) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var saved map[metabase.NodeAlias][]savedSample
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	fork := newObserverFork(obs.config.Slots, rand.New(rand.NewSource(obs.seedRand.Int63())))
+	for nodeAlias, samples := range saved {
+		reservoir := NewReservoir(fork.slotCount)
+		for _, sample := range samples {
+			reservoir.sample(sample.Key, rangedloop.Segment{
+				StreamID:      sample.StreamID,
+				Position:      sample.Position,
+				ExpiresAt:     sample.ExpiresAt,
+				EncryptedSize: sample.EncryptedSize,
+			})
+		}
+		fork.reservoirs[nodeAlias] = reservoir
+	}
+	return fork, nil
+}
+
 // Finish builds and dedups an audit queue from the merged per-node reservoirs.
 func (obs *Observer) Finish(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -21,7 +21,13 @@ var ErrEmptyQueue = errs.Class("empty audit queue")
 // stripe of data across all pieces in the segment and ensure that all pieces
 // conform to the same polynomial.
 type VerifyQueue interface {
+	// Push adds segments to the queue.
 	Push(ctx context.Context, segments []Segment, maxBatchSize int) (err error)
+	// PushPriority adds segments to the queue, which are returned by Next
+	// before all segments pushed with Push, e.g. for targeted audit
+	// campaigns. Next returns them with a positive Priority.
+	PushPriority(ctx context.Context, segments []Segment, maxBatchSize int) (err error)
+	// Next removes the next segment from the queue and returns it.
 	Next(ctx context.Context) (Segment, error)
 }
 
@@ -0,0 +1,315 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcpool"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/storj"
+	"storj.io/storj/private/pieceauditpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/overlay"
+)
+
+// VerifyRanges challenges every piece of the segment with several random
+// ranges, instead of a single stripe like Verify. This catches partial
+// corruption deep in large pieces, which a single stripe audit rarely hits.
+//
+// The satellite sends a random seed to the nodes, which selects the challenged
+// stripes. Every node reads its shares of these stripes and answers with their
+// XOR. The XOR of the shares of several stripes is itself a stripe of the
+// erasure code, so the answers are checked against each other like the shares
+// of a single stripe audit, and a node which lost or altered any of its ranges
+// fails the audit. Every node sends a single share, no matter how many ranges
+// are challenged.
+//
+// Nodes, which don't support the challenge yet, are audited with a single
+// random stripe instead, like Verify does. When too few nodes support the
+// challenge, the stripe audit decides for all the nodes.
+func (verifier *Verifier) VerifyRanges(ctx context.Context, segment Segment, skip map[storj.NodeID]bool, count int) (report Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var segmentInfo metabase.Segment
+	defer func() {
+		recordStats(report, len(segmentInfo.Pieces), err)
+	}()
+
+	segmentInfo, err = verifier.metabase.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+	})
+	if err != nil {
+		if metabase.ErrSegmentNotFound.Has(err) {
+			verifier.log.Debug("segment deleted before VerifyRanges")
+			return Report{}, nil
+		}
+		return Report{}, err
+	}
+	if segmentInfo.Expired(verifier.nowFn()) {
+		verifier.log.Debug("segment expired before VerifyRanges")
+		return Report{}, nil
+	}
+
+	seed := cryptoSource{}.Int63()
+	numStripes := getStripeCount(segmentInfo)
+	verifier.log.Debug("VerifyRanges: challenging stripes",
+		zap.String("Segment", segmentInfoString(segment)),
+		zap.Int64("Seed", seed),
+		zap.Int32s("Stripes", GetRandomStripes(segmentInfo, seed, count)))
+
+	var mu sync.Mutex
+	unsupported := map[storj.NodeID]bool{}
+	report, err = verifier.verifyShares(ctx, segment, segmentInfo, skip,
+		func(ctx context.Context, limits []*pb.AddressedOrderLimit, _ storj.PiecePrivateKey, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation) (map[int]Share, error) {
+			return verifier.ProveRanges(ctx, limits, cachedNodesInfo, &pieceauditpb.ProveRangesRequest{
+				Seed:       seed,
+				RangeSize:  segmentInfo.Redundancy.ShareSize,
+				NumRanges:  numStripes,
+				RangeCount: int32(count),
+			}, func(nodeID storj.NodeID) {
+				mu.Lock()
+				defer mu.Unlock()
+				unsupported[nodeID] = true
+			})
+		})
+	if len(unsupported) == 0 {
+		return report, err
+	}
+	mon.Meter("audit_ranges_unsupported").Mark64(int64(len(unsupported)))
+
+	randomIndex, stripeErr := GetRandomStripe(ctx, segmentInfo)
+	if stripeErr != nil {
+		return report, errs.Combine(err, stripeErr)
+	}
+	stripeReport, stripeErr := verifier.verifyShares(ctx, segment, segmentInfo, skip,
+		func(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation) (map[int]Share, error) {
+			return verifier.DownloadShares(ctx, limits, privateKey, cachedNodesInfo, randomIndex, segmentInfo.Redundancy.ShareSize)
+		})
+
+	if ErrNotEnoughShares.Has(err) {
+		verifier.log.Debug("VerifyRanges: not enough nodes support range challenges, verifying a single stripe",
+			zap.String("Segment", segmentInfoString(segment)),
+			zap.Int("Unsupported", len(unsupported)))
+		return stripeReport, stripeErr
+	}
+	if stripeErr != nil {
+		// the nodes without range challenges are left unaudited.
+		verifier.log.Debug("VerifyRanges: verifying a single stripe of the nodes without range challenges failed",
+			zap.String("Segment", segmentInfoString(segment)),
+			zap.Error(stripeErr))
+	}
+	return mergeUnsupportedReport(report, stripeReport, unsupported), err
+}
+
+// mergeUnsupportedReport replaces the results of the unsupported nodes in the
+// range audit report with their results in the stripe audit report.
+func mergeUnsupportedReport(report, stripeReport Report, unsupported map[storj.NodeID]bool) Report {
+	keep := func(nodeID storj.NodeID) bool { return !unsupported[nodeID] }
+	take := func(nodeID storj.NodeID) bool { return unsupported[nodeID] }
+
+	merged := Report{
+		Segment:         report.Segment,
+		Successes:       append(filterNodes(report.Successes, keep), filterNodes(stripeReport.Successes, take)...),
+		Offlines:        append(filterNodes(report.Offlines, keep), filterNodes(stripeReport.Offlines, take)...),
+		Unknown:         append(filterNodes(report.Unknown, keep), filterNodes(stripeReport.Unknown, take)...),
+		NodesReputation: report.NodesReputation,
+	}
+	if merged.Segment == nil {
+		merged.Segment = stripeReport.Segment
+	}
+	for _, piece := range report.Fails {
+		if keep(piece.StorageNode) {
+			merged.Fails = append(merged.Fails, piece)
+		}
+	}
+	for _, piece := range stripeReport.Fails {
+		if take(piece.StorageNode) {
+			merged.Fails = append(merged.Fails, piece)
+		}
+	}
+	for _, job := range report.PendingAudits {
+		if keep(job.Locator.NodeID) {
+			merged.PendingAudits = append(merged.PendingAudits, job)
+		}
+	}
+	for _, job := range stripeReport.PendingAudits {
+		if take(job.Locator.NodeID) {
+			merged.PendingAudits = append(merged.PendingAudits, job)
+		}
+	}
+	for nodeID, reputation := range stripeReport.NodesReputation {
+		if merged.NodesReputation == nil {
+			merged.NodesReputation = make(map[storj.NodeID]overlay.ReputationStatus)
+		}
+		if _, ok := merged.NodesReputation[nodeID]; !ok {
+			merged.NodesReputation[nodeID] = reputation
+		}
+	}
+	return merged
+}
+
+// filterNodes returns the nodes for which include returns true.
+func filterNodes(nodes storj.NodeIDList, include func(storj.NodeID) bool) (filtered storj.NodeIDList) {
+	for _, nodeID := range nodes {
+		if include(nodeID) {
+			filtered = append(filtered, nodeID)
+		}
+	}
+	return filtered
+}
+
+// ProveRanges sends the range challenge to the nodes where remote pieces are
+// located, and returns their proofs as shares. onUnsupported is called for
+// every node, which doesn't support range challenges yet. The shares of these
+// nodes are left out.
+func (verifier *Verifier) ProveRanges(ctx context.Context, limits []*pb.AddressedOrderLimit, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation, challenge *pieceauditpb.ProveRangesRequest, onUnsupported func(storj.NodeID)) (shares map[int]Share, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	shares = make(map[int]Share, len(limits))
+	ch := make(chan *Share, len(limits))
+
+	for i, limit := range limits {
+		if limit == nil {
+			ch <- nil
+			continue
+		}
+
+		var ipPort string
+		node, ok := cachedNodesInfo[limit.Limit.StorageNodeId]
+		if ok && node.LastIPPort != "" {
+			ipPort = node.LastIPPort
+		}
+
+		go func(i int, limit *pb.AddressedOrderLimit) {
+			share := verifier.proveRanges(ctx, limit, ipPort, challenge, i)
+			if share.Error != nil && rangesUnsupported(share.Error) {
+				onUnsupported(share.NodeID)
+				ch <- nil
+				return
+			}
+			ch <- &share
+		}(i, limit)
+	}
+
+	for range limits {
+		share := <-ch
+		if share != nil {
+			shares[share.PieceNum] = *share
+		}
+	}
+
+	return shares, nil
+}
+
+// rangesUnsupported checks whether the error of a node means, that it doesn't
+// support range challenges. Older nodes don't know the method at all, and
+// their RPC server rejects it without a status code.
+func rangesUnsupported(err error) bool {
+	return errs2.IsRPC(err, rpcstatus.Unimplemented) || strings.Contains(err.Error(), "unknown rpc")
+}
+
+// proveRanges sends the range challenge to a single node.
+func (verifier *Verifier) proveRanges(ctx context.Context, limit *pb.AddressedOrderLimit, cachedIPAndPort string, challenge *pieceauditpb.ProveRangesRequest, pieceNum int) (share Share) {
+	defer mon.Task()(&ctx)(&share.Error)
+
+	share.PieceNum = pieceNum
+	share.NodeID = limit.GetLimit().StorageNodeId
+	share.FailurePhase = DialFailure
+
+	// the node reads all the challenged ranges, but sends back only one of them.
+	timedCtx := ctx
+	if verifier.minBytesPerSecond > 0 {
+		maxTransferTime := time.Duration(int64(time.Second) * int64(challenge.RangeSize) * int64(challenge.RangeCount) / verifier.minBytesPerSecond.Int64())
+		if maxTransferTime < verifier.minDownloadTimeout {
+			maxTransferTime = verifier.minDownloadTimeout
+		}
+		var cancel func()
+		timedCtx, cancel = context.WithTimeout(ctx, maxTransferTime)
+		defer cancel()
+	}
+
+	targetNodeID := limit.GetLimit().StorageNodeId
+	log := verifier.log.Named(targetNodeID.String())
+
+	addresses := []string{limit.GetStorageNodeAddress().GetAddress()}
+	if cachedIPAndPort != "" {
+		addresses = []string{cachedIPAndPort, limit.GetStorageNodeAddress().GetAddress()}
+	}
+
+	var dialErr error
+	for _, address := range addresses {
+		conn, err := verifier.dialer.DialNodeURL(rpcpool.WithForceDial(timedCtx), storj.NodeURL{
+			ID:      targetNodeID,
+			Address: address,
+		})
+		if err != nil {
+			log.Debug("failed to connect to audit target node", zap.String("address", address), zap.Error(err))
+			dialErr = errs.Combine(dialErr, err)
+			continue
+		}
+		defer func() {
+			if err := conn.Close(); err != nil {
+				verifier.log.Error("audit verifier failed to close conn to node", zap.Error(err))
+			}
+		}()
+
+		share.FailurePhase = RequestFailure
+
+		serializedLimit, err := pb.Marshal(limit.GetLimit())
+		if err != nil {
+			share.Error = Error.Wrap(err)
+			return share
+		}
+
+		response, err := pieceauditpb.NewDRPCPieceAuditClient(conn).ProveRanges(timedCtx, &pieceauditpb.ProveRangesRequest{
+			Limit:      serializedLimit,
+			Seed:       challenge.Seed,
+			RangeSize:  challenge.RangeSize,
+			NumRanges:  challenge.NumRanges,
+			RangeCount: challenge.RangeCount,
+		})
+		if err != nil {
+			share.Error = err
+			return share
+		}
+		share.Data = response.Proof
+		if len(share.Data) != int(challenge.RangeSize) {
+			// a proof of a wrong size fails the erasure code check.
+			share.Data = make([]byte, challenge.RangeSize)
+			copy(share.Data, response.Proof)
+		}
+		share.FailurePhase = NoFailure
+		return share
+	}
+
+	share.Error = Error.Wrap(dialErr)
+	return share
+}
+
+// getStripeCount returns the number of stripes of the segment.
+func getStripeCount(segment metabase.Segment) int32 {
+	// the last segment could be smaller than stripe size
+	if segment.EncryptedSize < segment.Redundancy.StripeSize() {
+		return 1
+	}
+	return segment.Redundancy.StripeCount(segment.EncryptedSize)
+}
+
+// GetRandomStripes returns count distinct stripe indexes of the segment, in
+// ascending order, chosen by the seed. These are the stripes, which the nodes
+// prove for the same seed. When the segment has fewer stripes, all of them
+// are returned.
+func GetRandomStripes(segment metabase.Segment, seed int64, count int) []int32 {
+	return pieceauditpb.Ranges(seed, getStripeCount(segment), count)
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package audit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/private/pieceauditpb"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink/private/eestream"
+)
+
+func TestGetRandomStripes(t *testing.T) {
+	segment := metabase.Segment{
+		EncryptedSize: 64 * 1024,
+		Redundancy: storj.RedundancyScheme{
+			Algorithm:      storj.ReedSolomon,
+			ShareSize:      256,
+			RequiredShares: 4,
+			RepairShares:   6,
+			OptimalShares:  8,
+			TotalShares:    10,
+		},
+	}
+	numStripes := segment.Redundancy.StripeCount(segment.EncryptedSize)
+	require.EqualValues(t, 64, numStripes)
+
+	stripes := GetRandomStripes(segment, 1, 10)
+	require.Len(t, stripes, 10)
+	require.IsIncreasing(t, stripes)
+	for _, stripe := range stripes {
+		require.GreaterOrEqual(t, stripe, int32(0))
+		require.Less(t, stripe, numStripes)
+	}
+
+	// the same seed challenges the same stripes
+	require.Equal(t, stripes, GetRandomStripes(segment, 1, 10))
+	require.NotEqual(t, stripes, GetRandomStripes(segment, 2, 10))
+
+	require.Len(t, GetRandomStripes(segment, 1, 100), int(numStripes))
+	require.Empty(t, GetRandomStripes(segment, 1, 0))
+
+	// the last segment could be smaller than stripe size
+	segment.EncryptedSize = 100
+	require.Equal(t, []int32{0}, GetRandomStripes(segment, 1, 10))
+}
+
+func TestRangeProofs(t *testing.T) {
+	const (
+		required  = 4
+		total     = 10
+		shareSize = 64
+		stripes   = 16
+	)
+
+	f, err := eestream.NewFEC(required, total)
+	require.NoError(t, err)
+
+	// pieces[n] is the content of piece n, a share of every stripe.
+	pieces := make([][]byte, total)
+	for stripe := 0; stripe < stripes; stripe++ {
+		err := f.Encode(testrand.BytesInt(required*shareSize), func(s eestream.Share) {
+			pieces[s.Number] = append(pieces[s.Number], s.Data...)
+		})
+		require.NoError(t, err)
+	}
+
+	prove := func(piece []byte, ranges []int32) []byte {
+		proof := make([]byte, shareSize)
+		for _, index := range ranges {
+			pieceauditpb.AddToProof(proof, piece[int(index)*shareSize:int(index+1)*shareSize])
+		}
+		return proof
+	}
+
+	ranges := pieceauditpb.Ranges(testrand.Int63n(1<<62), stripes, 5)
+	require.Len(t, ranges, 5)
+
+	// a single altered byte, deep in a piece, is found by the proofs.
+	corrupted := int(ranges[len(ranges)-1])*shareSize + 7
+	pieces[3][corrupted] ^= 0xff
+
+	proofs := make(map[int]Share, total)
+	for num, piece := range pieces {
+		proofs[num] = Share{PieceNum: num, Data: prove(piece, ranges)}
+	}
+
+	altered, _, err := auditShares(context.Background(), required, total, proofs)
+	require.NoError(t, err)
+	require.Equal(t, []int{3}, altered)
+
+	// ranges which aren't challenged don't affect the proofs.
+	pieces[3][corrupted] ^= 0xff
+	for num, piece := range pieces {
+		proofs[num] = Share{PieceNum: num, Data: prove(piece, ranges)}
+	}
+	altered, _, err = auditShares(context.Background(), required, total, proofs)
+	require.NoError(t, err)
+	require.Empty(t, altered)
+}
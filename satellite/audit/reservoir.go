@@ -96,6 +96,7 @@ type Segment struct {
 	Position      metabase.SegmentPosition
 	ExpiresAt     *time.Time
 	EncryptedSize int32 // size of the whole segment (not a piece)
+	Priority      int   // positive for the segments pushed with PushPriority
 }
 
 // NewSegment creates a new segment to audit from a metainfo loop segment.
@@ -4,6 +4,7 @@
 package audit
 
 import (
+	"context"
 	"encoding/binary"
 	"fmt"
 	"math/rand"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
 
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
@@ -81,6 +83,42 @@ func TestReservoirMerge(t *testing.T) {
 
 }
 
+func TestObserverRestorePartial(t *testing.T) {
+	ctx := context.Background()
+
+	segments := []rangedloop.Segment{
+		makeSegment(1), makeSegment(2), makeSegment(3), makeSegment(4),
+	}
+	for i := range segments {
+		segments[i].RootPieceID = testrand.PieceID()
+		segments[i].Pieces = metabase.Pieces{{Number: 0}, {Number: 1}}
+		segments[i].AliasPieces = metabase.AliasPieces{{Number: 0, Alias: 1}, {Number: 1, Alias: 2}}
+	}
+
+	obs := NewObserver(zap.NewNop(), nil, Config{Slots: 2})
+	require.NoError(t, obs.Start(ctx, time.Now()))
+
+	partial, err := obs.Fork(ctx)
+	require.NoError(t, err)
+	require.NoError(t, partial.Process(ctx, segments))
+
+	data, err := obs.SavePartial(ctx, partial)
+	require.NoError(t, err)
+	restored, err := obs.RestorePartial(ctx, data)
+	require.NoError(t, err)
+
+	original := partial.(*observerFork).reservoirs
+	require.Len(t, original, 2)
+	require.Len(t, restored.(*observerFork).reservoirs, len(original))
+	for alias, reservoir := range restored.(*observerFork).reservoirs {
+		require.Equal(t, original[alias].Keys(), reservoir.Keys())
+		require.Len(t, reservoir.Segments(), 2)
+		for i, segment := range reservoir.Segments() {
+			require.Equal(t, NewSegment(original[alias].Segments()[i]), NewSegment(segment))
+		}
+	}
+}
+
 func TestReservoirWeights(t *testing.T) {
 	var weight10StreamID = testrand.UUID()
 	var weight5StreamID = testrand.UUID()
@@ -129,6 +129,21 @@ func (verifier *Verifier) Verify(ctx context.Context, segment Segment, skip map[
 		return Report{}, err
 	}
 
+	return verifier.verifyShares(ctx, segment, segmentInfo, skip,
+		func(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation) (map[int]Share, error) {
+			return verifier.DownloadShares(ctx, limits, privateKey, cachedNodesInfo, randomIndex, segmentInfo.Redundancy.ShareSize)
+		})
+}
+
+// fetchSharesFunc gets a share from every node with an order limit. The shares
+// must form a stripe of the erasure code of the segment.
+type fetchSharesFunc func(ctx context.Context, limits []*pb.AddressedOrderLimit, privateKey storj.PiecePrivateKey, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation) (map[int]Share, error)
+
+// verifyShares fetches a share of every piece of the segment and verifies them
+// with the erasure code.
+func (verifier *Verifier) verifyShares(ctx context.Context, segment Segment, segmentInfo metabase.Segment, skip map[storj.NodeID]bool, fetch fetchSharesFunc) (report Report, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	var offlineNodes storj.NodeIDList
 	var failedNodes metabase.Pieces
 	var unknownNodes storj.NodeIDList
@@ -166,7 +181,7 @@ func (verifier *Verifier) Verify(ctx context.Context, segment Segment, skip map[
 		"StreamID":       segment.StreamID.String(),
 		"StreamPosition": strconv.Itoa(int(segment.Position.Encode())),
 	})
-	shares, err := verifier.DownloadShares(ctx, orderLimits, privateKey, cachedNodesInfo, randomIndex, segmentInfo.Redundancy.ShareSize)
+	shares, err := fetch(ctx, orderLimits, privateKey, cachedNodesInfo)
 	if err != nil {
 		return Report{
 			Offlines: offlineNodes,
@@ -22,6 +22,7 @@ import (
 
 	"storj.io/common/errs2"
 	"storj.io/common/memory"
+	"storj.io/common/pb"
 	"storj.io/common/peertls/tlsopts"
 	"storj.io/common/rpc"
 	"storj.io/common/rpc/rpcstatus"
@@ -603,6 +604,151 @@ func TestVerifierOfflineNode(t *testing.T) {
 	})
 }
 
+func TestVerifierRanges(t *testing.T) {
+	testWithRangedLoop(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, pauseQueueing pauseQueueingFunc, runQueueingOnce runQueueingOnceFunc) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		pauseQueueing(satellite)
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(32 * memory.KiB)
+
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		err = runQueueingOnce(ctx, satellite)
+		require.NoError(t, err)
+
+		queue := audits.VerifyQueue
+		queueSegment, err := queue.Next(ctx)
+		require.NoError(t, err)
+
+		segment, err := satellite.Metabase.DB.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+			StreamID: queueSegment.StreamID,
+			Position: queueSegment.Position,
+		})
+		require.NoError(t, err)
+
+		report, err := audits.Verifier.VerifyRanges(ctx, queueSegment, nil, 4)
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, len(segment.Pieces))
+		assert.Len(t, report.Fails, 0)
+		assert.Len(t, report.Offlines, 0)
+		assert.Len(t, report.PendingAudits, 0)
+
+		// alter the content of the second piece
+		altered := segment.Pieces[1]
+		alteredID := segment.RootPieceID.Derive(altered.StorageNode, int32(altered.Number))
+		flip := func(content []byte, header *pb.PieceHeader) {
+			for i := range content {
+				content[i] ^= 0xff
+			}
+		}
+		planet.FindNode(altered.StorageNode).Storage2.PieceBackend.TestingMutatePiece(satellite.ID(), alteredID, flip)
+
+		report, err = audits.Verifier.VerifyRanges(ctx, queueSegment, nil, 4)
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, len(segment.Pieces)-1)
+		assert.Equal(t, metabase.Pieces{altered}, report.Fails)
+
+		// restore the content again
+		planet.FindNode(altered.StorageNode).Storage2.PieceBackend.TestingMutatePiece(satellite.ID(), alteredID, flip)
+
+		// delete the piece from the first node
+		piece := segment.Pieces[0]
+		pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+		node := planet.FindNode(piece.StorageNode)
+		node.Storage2.PieceBackend.TestingDeletePiece(satellite.ID(), pieceID)
+
+		report, err = audits.Verifier.VerifyRanges(ctx, queueSegment, nil, 4)
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, len(segment.Pieces)-1)
+		assert.NotContains(t, report.Successes, piece.StorageNode)
+		assert.Equal(t, metabase.Pieces{piece}, report.Fails)
+		assert.Len(t, report.Offlines, 0)
+		assert.Len(t, report.PendingAudits, 0)
+	})
+}
+
+func TestVerifierRangesUnsupportedNode(t *testing.T) {
+	testWithRangedLoop(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet, pauseQueueing pauseQueueingFunc, runQueueingOnce runQueueingOnceFunc) {
+		satellite := planet.Satellites[0]
+		audits := satellite.Audit
+
+		audits.Worker.Loop.Pause()
+		pauseQueueing(satellite)
+
+		ul := planet.Uplinks[0]
+		testData := testrand.Bytes(32 * memory.KiB)
+
+		err := ul.Upload(ctx, satellite, "testbucket", "test/path", testData)
+		require.NoError(t, err)
+
+		err = runQueueingOnce(ctx, satellite)
+		require.NoError(t, err)
+
+		queue := audits.VerifyQueue
+		queueSegment, err := queue.Next(ctx)
+		require.NoError(t, err)
+
+		segment, err := satellite.Metabase.DB.GetSegmentByPosition(ctx, metabase.GetSegmentByPosition{
+			StreamID: queueSegment.StreamID,
+			Position: queueSegment.Position,
+		})
+		require.NoError(t, err)
+
+		// the first node is an older node, which doesn't know range challenges.
+		piece := segment.Pieces[0]
+		node := planet.FindNode(piece.StorageNode)
+		node.Storage2.Endpoint.TestingDisableProveRanges(true)
+
+		// the node is audited with a single stripe, instead of being unknown.
+		report, err := audits.Verifier.VerifyRanges(ctx, queueSegment, nil, 4)
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, len(segment.Pieces))
+		assert.Contains(t, report.Successes, piece.StorageNode)
+		assert.Len(t, report.Fails, 0)
+		assert.Len(t, report.Offlines, 0)
+		assert.Len(t, report.Unknown, 0)
+		assert.Len(t, report.PendingAudits, 0)
+
+		// the stripe audit fails the node, when it lost its piece.
+		pieceID := segment.RootPieceID.Derive(piece.StorageNode, int32(piece.Number))
+		node.Storage2.PieceBackend.TestingDeletePiece(satellite.ID(), pieceID)
+
+		report, err = audits.Verifier.VerifyRanges(ctx, queueSegment, nil, 4)
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, len(segment.Pieces)-1)
+		assert.NotContains(t, report.Successes, piece.StorageNode)
+		assert.Equal(t, metabase.Pieces{piece}, report.Fails)
+		assert.Len(t, report.Unknown, 0)
+
+		// when no node supports range challenges, all of them are audited
+		// with a single stripe.
+		for _, node := range planet.StorageNodes {
+			node.Storage2.Endpoint.TestingDisableProveRanges(true)
+		}
+
+		report, err = audits.Verifier.VerifyRanges(ctx, queueSegment, nil, 4)
+		require.NoError(t, err)
+
+		assert.Len(t, report.Successes, len(segment.Pieces)-1)
+		assert.Equal(t, metabase.Pieces{piece}, report.Fails)
+		assert.Len(t, report.Unknown, 0)
+	})
+}
+
 func TestVerifierMissingPiece(t *testing.T) {
 	testWithRangedLoop(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 4, UplinkCount: 1,
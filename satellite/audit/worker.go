@@ -29,6 +29,7 @@ type Config struct {
 	Slots                     int           `help:"number of reservoir slots allotted for nodes, currently capped at 3" default:"3"`
 	VerificationPushBatchSize int           `help:"number of audit jobs to push at once to the verification queue" devDefault:"10" releaseDefault:"4096"`
 	WorkerConcurrency         int           `help:"number of workers to run audits on segments" default:"2"`
+	RandomRanges              int           `help:"number of random stripes of every piece verified per audit; more than one catches partial corruption deep in large pieces" default:"1"`
 	UseRangedLoop             bool          `help:"whether use Audit observer with ranged loop." default:"true"`
 
 	ReverifyWorkerConcurrency   int           `help:"number of workers to run reverify audits on pieces" default:"2"`
@@ -44,12 +45,14 @@ type Worker struct {
 	verifier      *Verifier
 	reverifyQueue ReverifyQueue
 	reporter      Reporter
+	campaigns     CampaignDB
 	Loop          *sync2.Cycle
 	concurrency   int
+	randomRanges  int
 }
 
 // NewWorker instantiates Worker.
-func NewWorker(log *zap.Logger, queue VerifyQueue, verifier *Verifier, reverifyQueue ReverifyQueue, reporter Reporter, config Config) *Worker {
+func NewWorker(log *zap.Logger, queue VerifyQueue, verifier *Verifier, reverifyQueue ReverifyQueue, reporter Reporter, campaigns CampaignDB, config Config) *Worker {
 	return &Worker{
 		log: log,
 
@@ -57,8 +60,10 @@ func NewWorker(log *zap.Logger, queue VerifyQueue, verifier *Verifier, reverifyQ
 		verifier:      verifier,
 		reverifyQueue: reverifyQueue,
 		reporter:      reporter,
+		campaigns:     campaigns,
 		Loop:          sync2.NewCycle(config.QueueInterval),
 		concurrency:   config.WorkerConcurrency,
+		randomRanges:  config.RandomRanges,
 	}
 }
 
@@ -135,7 +140,12 @@ func (worker *Worker) work(ctx context.Context, segment Segment) (err error) {
 	}
 
 	// Next, audit the remaining nodes that are not in containment mode.
-	report, err := worker.verifier.Verify(ctx, segment, skip)
+	var report Report
+	if worker.randomRanges > 1 {
+		report, err = worker.verifier.VerifyRanges(ctx, segment, skip, worker.randomRanges)
+	} else {
+		report, err = worker.verifier.Verify(ctx, segment, skip)
+	}
 	if err != nil {
 		if metabase.ErrSegmentNotFound.Has(err) {
 			// no need to add this error; Verify() will encounter it again
@@ -148,5 +158,8 @@ func (worker *Worker) work(ctx context.Context, segment Segment) (err error) {
 
 	worker.reporter.RecordAudits(ctx, report)
 
+	// the segments of campaigns have a priority.
+	errlist.Add(recordCampaignAudit(ctx, worker.campaigns, segment, report, err))
+
 	return errlist.Err()
 }
@@ -28,6 +28,7 @@ import (
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/reputation"
@@ -55,7 +56,11 @@ type Auditor struct {
 		Server   *debug.Server
 	}
 
-	Mail       *mailservice.Service
+	Mail      *mailservice.Service
+	Placement struct {
+		Reloader *nodeselection.PlacementReloader
+	}
+
 	Overlay    *overlay.Service
 	Reputation *reputation.Service
 	Orders     struct {
@@ -79,8 +84,10 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 	revocationDB extensions.RevocationDB,
 	verifyQueue audit.VerifyQueue,
 	reverifyQueue audit.ReverifyQueue,
+	campaignDB audit.CampaignDB,
 	overlayCache overlay.DB,
 	nodeEvents nodeevents.DB,
+	placementConfigs nodeselection.PlacementConfigDB,
 	reputationdb reputation.DB,
 	containmentDB audit.Containment,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel,
@@ -139,12 +146,17 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 		peer.Dialer = rpc.NewDefaultDialer(tlsOptions)
 	}
 
-	placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
-	if err != nil {
-		return nil, err
+	{ // setup placement
+		var err error
+		peer.Placement.Reloader, err = nodeselection.LoadPlacementReloader(context.TODO(), log.Named("placement:reloader"), config.Placement, config.Overlay.Node.CreateDefaultPlacement, placementConfigs, config.PlacementReload, nil)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 	}
 
 	{ // setup overlay
+		_, placement := peer.Placement.Reloader.Placements()
+
 		var err error
 		peer.Overlay, err = overlay.NewService(log.Named("overlay"), overlayCache, nodeEvents, placement, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
 		if err != nil {
@@ -179,12 +191,7 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 	}
 
 	{ // setup orders
-
-		placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
-		if err != nil {
-			return nil, err
-		}
-
+		var err error
 		peer.Orders.Service, err = orders.NewService(
 			log.Named("orders"),
 			signing.SignerFromFullIdentity(peer.Identity),
@@ -193,7 +200,7 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 			// PUT and GET actions which are not used by
 			// auditor so we can set noop implementation.
 			orders.NewNoopDB(),
-			placement.CreateFilters,
+			peer.Placement.Reloader.CreateFilters,
 			config.Orders,
 		)
 		if err != nil {
@@ -240,6 +247,7 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 			peer.Audit.Verifier,
 			reverifyQueue,
 			peer.Audit.Reporter,
+			campaignDB,
 			config.Audit)
 		peer.Services.Add(lifecycle.Item{
 			Name:  "audit:verify-worker",
@@ -263,6 +271,18 @@ func NewAuditor(log *zap.Logger, full *identity.FullIdentity,
 			debug.Cycle("Audit Reverify Worker", peer.Audit.ReverifyWorker.Loop))
 	}
 
+	if peer.Placement.Reloader.Enabled() { // setup placement reloader
+		peer.Placement.Reloader.OnReload(peer.Overlay.SetPlacements)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "placement:reloader",
+			Run:   peer.Placement.Reloader.Run,
+			Close: peer.Placement.Reloader.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Placement Reloader", peer.Placement.Reloader.Loop))
+	}
+
 	return peer, nil
 }
 
@@ -85,6 +85,10 @@ type ConsoleAPI struct {
 		Service *overlay.Service
 	}
 
+	Placement struct {
+		Reloader *nodeselection.PlacementReloader
+	}
+
 	Orders struct {
 		DB       orders.DB
 		Endpoint *orders.Endpoint
@@ -292,10 +296,12 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		peer.OIDC.Service = oidc.NewService(db.OIDC())
 	}
 
-	placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nodeselection.NewPlacementConfigEnvironment(nil, nil))
+	peer.Placement.Reloader, err = nodeselection.LoadPlacementReloader(context.TODO(), peer.Log.Named("placement:reloader"),
+		config.Placement, config.Overlay.Node.CreateDefaultPlacement, peer.DB.PlacementConfigs(), config.PlacementReload, nodeselection.NewPlacementConfigEnvironment(nil, nil, nil))
 	if err != nil {
 		return nil, err
 	}
+	_, placement := peer.Placement.Reloader.Placements()
 
 	{ // setup orders
 		peer.Orders.DB = rollupsWriteCache
@@ -313,7 +319,7 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 			signing.SignerFromFullIdentity(peer.Identity),
 			peer.Overlay.Service,
 			peer.Orders.DB,
-			placement.CreateFilters,
+			peer.Placement.Reloader.CreateFilters,
 			config.Orders,
 		)
 		if err != nil {
@@ -551,6 +557,10 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 				ObjectLockEnabled:              config.Metainfo.ObjectLockEnabled,
 				UseBucketLevelObjectVersioning: config.Metainfo.UseBucketLevelObjectVersioning,
 			},
+			console.ScopedRateLimits{
+				Buckets: config.Metainfo.RateLimiter.BucketLimits,
+				APIKeys: config.Metainfo.RateLimiter.APIKeyLimits,
+			},
 			peer.Valdi.Service,
 			consoleConfig.Config,
 		)
@@ -588,6 +598,18 @@ func NewConsoleAPI(log *zap.Logger, full *identity.FullIdentity, db DB,
 		})
 	}
 
+	if peer.Placement.Reloader.Enabled() { // setup placement reloader
+		peer.Placement.Reloader.OnReload(peer.Console.Service.SetPlacements)
+
+		peer.Services.Add(lifecycle.Item{
+			Name:  "placement:reloader",
+			Run:   peer.Placement.Reloader.Run,
+			Close: peer.Placement.Reloader.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Placement Reloader", peer.Placement.Reloader.Loop))
+	}
+
 	{ // setup health check
 		if config.HealthCheck.Enabled {
 			listener, err := net.Listen("tcp", config.HealthCheck.Address)
@@ -88,6 +88,9 @@ type APIKeyInfo struct {
 	ProjectStorageLimit   *int64 `json:"-"`
 	ProjectSegmentsLimit  *int64 `json:"-"`
 	ProjectBandwidthLimit *int64 `json:"-"`
+
+	// RateLimits is set when the satellite has rate limits configured for the API key.
+	RateLimits *OperationRateLimits `json:"rateLimits,omitempty"`
 }
 
 // APIKeyCursor holds info for api keys cursor pagination.
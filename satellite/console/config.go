@@ -157,6 +157,14 @@ type PlacementDetail struct {
 	Name        string                    `json:"name"`
 	Title       string                    `json:"title"`
 	Description string                    `json:"description"`
+	Regions     []PlacementRegion         `json:"regions,omitempty"`
+}
+
+// PlacementRegion represents human-readable details of a region required by a placement.
+type PlacementRegion struct {
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Countries   []string `json:"countries"`
 }
 
 // PlacementDetails represents a mapping between placement IDs and their human-readable details.
@@ -0,0 +1,249 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+)
+
+// RateLimitOperation is a group of metainfo requests that share a rate limit
+// when the limit is configured for a bucket or an API key.
+type RateLimitOperation string
+
+const (
+	// RateLimitOperationList covers listing objects and pending uploads.
+	RateLimitOperationList RateLimitOperation = "list"
+	// RateLimitOperationRead covers reading object metadata and downloads.
+	RateLimitOperationRead RateLimitOperation = "read"
+	// RateLimitOperationWrite covers uploads, copies, moves and deletes.
+	RateLimitOperationWrite RateLimitOperation = "write"
+)
+
+// RateLimitOperationOf returns the operation group the limit kind belongs to.
+// It returns false for kinds that aren't limited per bucket or API key.
+func RateLimitOperationOf(kind LimitKind) (RateLimitOperation, bool) {
+	switch kind {
+	case RateLimitList:
+		return RateLimitOperationList, true
+	case RateLimitHead, RateLimitGet:
+		return RateLimitOperationRead, true
+	case RateLimitPut, RateLimitPutNoError, RateLimitDelete:
+		return RateLimitOperationWrite, true
+	default:
+		return "", false
+	}
+}
+
+// RateLimitPolicy is a token bucket configuration.
+type RateLimitPolicy struct {
+	Rate  float64 `json:"rate"`
+	Burst int     `json:"burst"`
+}
+
+// OperationRateLimits holds rate limits of operation groups. A nil policy means
+// that only the project rate limits apply to the operation group.
+type OperationRateLimits struct {
+	List  *RateLimitPolicy `json:"list,omitempty"`
+	Read  *RateLimitPolicy `json:"read,omitempty"`
+	Write *RateLimitPolicy `json:"write,omitempty"`
+}
+
+// Policy returns the policy of the operation group.
+func (limits OperationRateLimits) Policy(op RateLimitOperation) *RateLimitPolicy {
+	switch op {
+	case RateLimitOperationList:
+		return limits.List
+	case RateLimitOperationRead:
+		return limits.Read
+	case RateLimitOperationWrite:
+		return limits.Write
+	default:
+		return nil
+	}
+}
+
+// parseOperationRateLimits parses limits in the format
+// "list=<rate>/<burst>,read=<rate>/<burst>,write=<rate>/<burst>".
+// Any of the operation groups may be omitted and the burst defaults to the rate.
+func parseOperationRateLimits(s string) (limits OperationRateLimits, err error) {
+	for _, entry := range strings.Split(s, ",") {
+		op, value, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok {
+			return limits, errs.New("invalid rate limit %q, expected <operation>=<rate>/<burst>", entry)
+		}
+
+		rateValue, burstValue, hasBurst := strings.Cut(value, "/")
+		rate, err := strconv.ParseFloat(rateValue, 64)
+		if err != nil || rate < 0 {
+			return limits, errs.New("invalid rate %q", rateValue)
+		}
+		policy := &RateLimitPolicy{Rate: rate, Burst: int(rate)}
+		if hasBurst {
+			policy.Burst, err = strconv.Atoi(burstValue)
+			if err != nil || policy.Burst < 0 {
+				return limits, errs.New("invalid burst %q", burstValue)
+			}
+		}
+
+		switch RateLimitOperation(op) {
+		case RateLimitOperationList:
+			limits.List = policy
+		case RateLimitOperationRead:
+			limits.Read = policy
+		case RateLimitOperationWrite:
+			limits.Write = policy
+		default:
+			return limits, errs.New("unknown operation %q", op)
+		}
+	}
+	return limits, nil
+}
+
+// String returns the limits in the format accepted by parseOperationRateLimits.
+func (limits OperationRateLimits) String() string {
+	var entries []string
+	for _, op := range []RateLimitOperation{RateLimitOperationList, RateLimitOperationRead, RateLimitOperationWrite} {
+		if policy := limits.Policy(op); policy != nil {
+			entries = append(entries, string(op)+"="+strconv.FormatFloat(policy.Rate, 'f', -1, 64)+"/"+strconv.Itoa(policy.Burst))
+		}
+	}
+	return strings.Join(entries, ",")
+}
+
+// BucketRateLimitKey identifies a bucket with rate limits.
+type BucketRateLimitKey struct {
+	ProjectID  uuid.UUID
+	BucketName string
+}
+
+// BucketRateLimits holds rate limits of individual buckets.
+//
+// Can be used as a flag.
+type BucketRateLimits map[BucketRateLimitKey]OperationRateLimits
+
+// Type implements pflag.Value.
+func (BucketRateLimits) Type() string { return "console.BucketRateLimits" }
+
+// Set sets the value from a string in the format
+// "<project-id>/<bucket>:<limits>;<project-id>/<bucket>:<limits>".
+func (limits *BucketRateLimits) Set(s string) error {
+	result := BucketRateLimits{}
+	err := parseScopedRateLimits(s, func(scope string, value OperationRateLimits) error {
+		projectID, bucketName, ok := strings.Cut(scope, "/")
+		if !ok || bucketName == "" {
+			return errs.New("invalid bucket %q, expected <project-id>/<bucket>", scope)
+		}
+		id, err := uuid.FromString(projectID)
+		if err != nil {
+			return errs.New("invalid project id %q: %w", projectID, err)
+		}
+		result[BucketRateLimitKey{ProjectID: id, BucketName: bucketName}] = value
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*limits = result
+	return nil
+}
+
+// String implements pflag.Value.
+func (limits BucketRateLimits) String() string {
+	entries := make([]string, 0, len(limits))
+	for key, value := range limits {
+		entries = append(entries, key.ProjectID.String()+"/"+key.BucketName+":"+value.String())
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ";")
+}
+
+// Project returns the rate limits of buckets that belong to the project, keyed by bucket name.
+func (limits BucketRateLimits) Project(projectID uuid.UUID) map[string]OperationRateLimits {
+	result := map[string]OperationRateLimits{}
+	for key, value := range limits {
+		if key.ProjectID == projectID {
+			result[key.BucketName] = value
+		}
+	}
+	return result
+}
+
+// APIKeyRateLimits holds rate limits of individual API keys, keyed by the
+// macaroon head. The limits apply to the API key and all its restrictions.
+//
+// Can be used as a flag.
+type APIKeyRateLimits map[string]OperationRateLimits
+
+// Type implements pflag.Value.
+func (APIKeyRateLimits) Type() string { return "console.APIKeyRateLimits" }
+
+// Set sets the value from a string in the format
+// "<hex-encoded-head>:<limits>;<hex-encoded-head>:<limits>".
+func (limits *APIKeyRateLimits) Set(s string) error {
+	result := APIKeyRateLimits{}
+	err := parseScopedRateLimits(s, func(scope string, value OperationRateLimits) error {
+		head, err := hex.DecodeString(scope)
+		if err != nil || len(head) == 0 {
+			return errs.New("invalid API key head %q", scope)
+		}
+		result[string(head)] = value
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	*limits = result
+	return nil
+}
+
+// String implements pflag.Value.
+func (limits APIKeyRateLimits) String() string {
+	entries := make([]string, 0, len(limits))
+	for head, value := range limits {
+		entries = append(entries, hex.EncodeToString([]byte(head))+":"+value.String())
+	}
+	sort.Strings(entries)
+	return strings.Join(entries, ";")
+}
+
+// Get returns the rate limits of the API key with the specified head.
+func (limits APIKeyRateLimits) Get(head []byte) (OperationRateLimits, bool) {
+	value, ok := limits[string(head)]
+	return value, ok
+}
+
+// ScopedRateLimits are the rate limits of individual buckets and API keys,
+// which are configured for the metainfo rate limiter.
+type ScopedRateLimits struct {
+	Buckets BucketRateLimits
+	APIKeys APIKeyRateLimits
+}
+
+func parseScopedRateLimits(s string, add func(scope string, value OperationRateLimits) error) error {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	for _, entry := range strings.Split(s, ";") {
+		scope, value, ok := strings.Cut(strings.TrimSpace(entry), ":")
+		if !ok {
+			return errs.New("invalid rate limits %q, expected <scope>:<limits>", entry)
+		}
+		limits, err := parseOperationRateLimits(value)
+		if err != nil {
+			return errs.New("invalid rate limits for %q: %w", scope, err)
+		}
+		if err := add(scope, limits); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/console"
+)
+
+func TestBucketRateLimits(t *testing.T) {
+	projectID := testrand.UUID()
+
+	var limits console.BucketRateLimits
+	require.NoError(t, limits.Set(""))
+	require.Empty(t, limits)
+
+	require.NoError(t, limits.Set(projectID.String()+"/bucket:list=10/20,write=1.5/3;"+projectID.String()+"/other:read=5"))
+	require.Equal(t, console.BucketRateLimits{
+		{ProjectID: projectID, BucketName: "bucket"}: {
+			List:  &console.RateLimitPolicy{Rate: 10, Burst: 20},
+			Write: &console.RateLimitPolicy{Rate: 1.5, Burst: 3},
+		},
+		{ProjectID: projectID, BucketName: "other"}: {
+			Read: &console.RateLimitPolicy{Rate: 5, Burst: 5},
+		},
+	}, limits)
+
+	var parsed console.BucketRateLimits
+	require.NoError(t, parsed.Set(limits.String()))
+	require.Equal(t, limits, parsed)
+
+	require.Len(t, limits.Project(projectID), 2)
+	require.Empty(t, limits.Project(testrand.UUID()))
+
+	for _, invalid := range []string{
+		"bucket:list=10/20",
+		projectID.String() + "/:list=10/20",
+		"invalid/bucket:list=10/20",
+		projectID.String() + "/bucket",
+		projectID.String() + "/bucket:delete=10/20",
+		projectID.String() + "/bucket:list=-1/20",
+		projectID.String() + "/bucket:list=10/x",
+	} {
+		require.Error(t, limits.Set(invalid), invalid)
+	}
+}
+
+func TestAPIKeyRateLimits(t *testing.T) {
+	head := testrand.Bytes(32)
+
+	var limits console.APIKeyRateLimits
+	require.NoError(t, limits.Set(hex.EncodeToString(head)+":read=100/200"))
+
+	value, ok := limits.Get(head)
+	require.True(t, ok)
+	require.Equal(t, console.OperationRateLimits{
+		Read: &console.RateLimitPolicy{Rate: 100, Burst: 200},
+	}, value)
+	require.Nil(t, value.Policy(console.RateLimitOperationList))
+	require.Equal(t, value.Read, value.Policy(console.RateLimitOperationRead))
+
+	_, ok = limits.Get(testrand.Bytes(32))
+	require.False(t, ok)
+
+	var parsed console.APIKeyRateLimits
+	require.NoError(t, parsed.Set(limits.String()))
+	require.Equal(t, limits, parsed)
+
+	require.Error(t, limits.Set("not-hex:read=100/200"))
+}
+
+func TestRateLimitOperationOf(t *testing.T) {
+	for kind, expected := range map[console.LimitKind]console.RateLimitOperation{
+		console.RateLimitList:       console.RateLimitOperationList,
+		console.RateLimitHead:       console.RateLimitOperationRead,
+		console.RateLimitGet:        console.RateLimitOperationRead,
+		console.RateLimitPut:        console.RateLimitOperationWrite,
+		console.RateLimitPutNoError: console.RateLimitOperationWrite,
+		console.RateLimitDelete:     console.RateLimitOperationWrite,
+	} {
+		op, ok := console.RateLimitOperationOf(kind)
+		require.True(t, ok)
+		require.Equal(t, expected, op)
+	}
+
+	_, ok := console.RateLimitOperationOf(console.RateLimit)
+	require.False(t, ok)
+}
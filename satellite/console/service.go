@@ -20,6 +20,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 	"unicode/utf8"
 
@@ -218,6 +219,7 @@ type Service struct {
 	projectAccounting          accounting.ProjectAccounting
 	projectUsage               *accounting.Service
 	buckets                    buckets.DB
+	placementMu                sync.RWMutex
 	placements                 nodeselection.PlacementDefinitions
 	placementNameLookup        map[string]storj.PlacementConstraint
 	accounts                   payments.Accounts
@@ -246,6 +248,7 @@ type Service struct {
 	paymentSourceChainIDs map[int64]string
 
 	objectLockAndVersioningConfig ObjectLockAndVersioningConfig
+	scopedRateLimits              ScopedRateLimits
 
 	nowFn func() time.Time
 }
@@ -273,7 +276,7 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 	billingDb billing.TransactionsDB, analytics *analytics.Service, tokens *consoleauth.Service, mailService *mailservice.Service,
 	accountFreezeService *AccountFreezeService, emission *emission.Service, kmsService *kms.Service, ssoService *sso.Service, satelliteAddress string,
 	satelliteName string, maxProjectBuckets int, ssoEnabled bool, placements nodeselection.PlacementDefinitions,
-	objectLockAndVersioningConfig ObjectLockAndVersioningConfig, valdiService *valdi.Service, config Config) (*Service, error) {
+	objectLockAndVersioningConfig ObjectLockAndVersioningConfig, scopedRateLimits ScopedRateLimits, valdiService *valdi.Service, config Config) (*Service, error) {
 	if store == nil {
 		return nil, errs.New("store can't be nil")
 	}
@@ -313,11 +316,6 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		}
 	}
 
-	placementNameLookup := make(map[string]storj.PlacementConstraint, len(placements))
-	for _, placement := range placements {
-		placementNameLookup[placement.Name] = placement.ID
-	}
-
 	return &Service{
 		log:                           log,
 		auditLogger:                   log.Named("auditlog"),
@@ -327,7 +325,7 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		projectUsage:                  projectUsage,
 		buckets:                       buckets,
 		placements:                    placements,
-		placementNameLookup:           placementNameLookup,
+		placementNameLookup:           newPlacementNameLookup(placements),
 		accounts:                      accounts,
 		depositWallets:                depositWallets,
 		billing:                       billingDb,
@@ -348,6 +346,7 @@ func NewService(log *zap.Logger, store DB, restKeys RESTKeys, projectAccounting
 		config:                        config,
 		varPartners:                   partners,
 		objectLockAndVersioningConfig: objectLockAndVersioningConfig,
+		scopedRateLimits:              scopedRateLimits,
 		paymentSourceChainIDs:         paymentSourceChainIDs,
 		nowFn:                         time.Now,
 	}, nil
@@ -4547,6 +4546,12 @@ func (s *Service) GetAPIKeys(ctx context.Context, reqProjectID uuid.UUID, cursor
 		}
 	}
 
+	for i := range page.APIKeys {
+		if limits, ok := s.scopedRateLimits.APIKeys.Get(page.APIKeys[i].Head); ok {
+			page.APIKeys[i].RateLimits = &limits
+		}
+	}
+
 	return page, err
 }
 
@@ -4629,7 +4634,7 @@ func (s *Service) GetBucketTotals(ctx context.Context, projectID uuid.UUID, curs
 
 	for i := range usage.BucketUsages {
 		placementID := usage.BucketUsages[i].DefaultPlacement
-		usage.BucketUsages[i].Location = s.placements[placementID].Name
+		usage.BucketUsages[i].Location = s.currentPlacements()[placementID].Name
 	}
 
 	return usage, nil
@@ -4654,11 +4659,63 @@ func (s *Service) GetSingleBucketTotals(ctx context.Context, projectID uuid.UUID
 		return nil, Error.Wrap(err)
 	}
 
-	usage.Location = s.placements[usage.DefaultPlacement].Name
+	usage.Location = s.currentPlacements()[usage.DefaultPlacement].Name
+
+	return usage, nil
+}
+
+// GetBucketPrefixUsage retrieves the usage of a single bucket grouped by encrypted prefix
+// as collected by the last tally.
+func (s *Service) GetBucketPrefixUsage(ctx context.Context, projectID uuid.UUID, bucketName string) (_ []accounting.PrefixUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket prefix usage", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	tallies, err := s.projectUsage.GetBucketPrefixTallies(ctx, isMember.project.ID, bucketName)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	usage := make([]accounting.PrefixUsage, 0, len(tallies))
+	for _, tally := range tallies {
+		usage = append(usage, accounting.PrefixUsage{
+			Prefix:       []byte(tally.Prefix),
+			Storage:      tally.TotalEncryptedSize,
+			ObjectCount:  tally.ObjectCount,
+			SegmentCount: tally.TotalSegments,
+			UpdatedAt:    tally.UpdatedAt,
+		})
+	}
 
 	return usage, nil
 }
 
+// GetBucketRateLimits retrieves the rate limits configured for individual buckets
+// of the project, keyed by bucket name.
+func (s *Service) GetBucketRateLimits(ctx context.Context, projectID uuid.UUID) (_ map[string]OperationRateLimits, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	user, err := s.getUserAndAuditLog(ctx, "get bucket rate limits", zap.String("projectID", projectID.String()))
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	isMember, err := s.isProjectMember(ctx, user.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.scopedRateLimits.Buckets.Project(isMember.project.ID), nil
+}
+
 // GetAllBucketNames retrieves all bucket names of a specific project.
 // projectID here may be Project.ID or Project.PublicID.
 func (s *Service) GetAllBucketNames(ctx context.Context, projectID uuid.UUID) (_ []string, err error) {
@@ -4729,7 +4786,7 @@ func (s *Service) GetBucketMetadata(ctx context.Context, projectID uuid.UUID) (l
 			Versioning: bucket.Versioning,
 			Placement: Placement{
 				DefaultPlacement: bucket.Placement,
-				Location:         s.placements[bucket.Placement].Name,
+				Location:         s.currentPlacements()[bucket.Placement].Name,
 			},
 			ObjectLockEnabled: bucket.ObjectLock.Enabled,
 		})
@@ -4753,6 +4810,13 @@ func (s *Service) GetPlacementDetails(ctx context.Context, projectID uuid.UUID)
 	placements := s.accounts.GetPartnerPlacements(string(isMember.project.UserAgent))
 	for _, placement := range placements {
 		if detail, ok := s.config.SelfServePlacementDetails.detailMap[placement]; ok {
+			for _, region := range s.currentPlacements()[placement].Regions {
+				detail.Regions = append(detail.Regions, PlacementRegion{
+					Name:        region.Name,
+					Description: region.Description,
+					Countries:   region.Countries,
+				})
+			}
 			details = append(details, detail)
 		}
 	}
@@ -5298,12 +5362,45 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 
 // GetPlacementByName returns the placement constraint by name.
 func (s *Service) GetPlacementByName(name string) (storj.PlacementConstraint, error) {
+	s.placementMu.RLock()
+	defer s.placementMu.RUnlock()
+
 	if placement, ok := s.placementNameLookup[name]; ok {
 		return placement, nil
 	}
 	return storj.DefaultPlacement, ErrPlacementNotFound.New("")
 }
 
+// SetPlacements replaces the placement definitions, e.g. after they are
+// reloaded at runtime.
+func (s *Service) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	s.placementMu.Lock()
+	defer s.placementMu.Unlock()
+
+	s.placements = placements
+	s.placementNameLookup = newPlacementNameLookup(placements)
+	return nil
+}
+
+// currentPlacements returns the current placement definitions.
+func (s *Service) currentPlacements() nodeselection.PlacementDefinitions {
+	s.placementMu.RLock()
+	defer s.placementMu.RUnlock()
+
+	return s.placements
+}
+
+// newPlacementNameLookup maps the names of the placements to their IDs.
+func newPlacementNameLookup(placements nodeselection.PlacementDefinitions) map[string]storj.PlacementConstraint {
+	lookup := make(map[string]storj.PlacementConstraint, len(placements))
+	for _, placement := range placements {
+		lookup[placement.Name] = placement.ID
+	}
+	return lookup
+}
+
 // WalletInfo contains all the information about a destination wallet assigned to a user.
 type WalletInfo struct {
 	Address blockchain.Address `json:"address"`
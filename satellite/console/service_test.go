@@ -45,6 +45,7 @@ import (
 	"storj.io/storj/satellite/console/consoleweb/consoleapi"
 	"storj.io/storj/satellite/console/valdi/valdiclient"
 	"storj.io/storj/satellite/kms"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/payments"
 	"storj.io/storj/satellite/payments/billing"
@@ -1152,6 +1153,40 @@ func TestService(t *testing.T) {
 				require.True(t, console.ErrUnauthorized.Has(err))
 			})
 
+			t.Run("GetBucketPrefixUsage", func(t *testing.T) {
+				location := metabase.BucketLocation{ProjectID: up2Proj.ID, BucketName: "test-prefix-usage"}
+
+				_, err = service.GetBucketPrefixUsage(userCtx1, up2Proj.ID, location.BucketName.String())
+				require.True(t, console.ErrUnauthorized.Has(err))
+
+				usage, err := service.GetBucketPrefixUsage(userCtx2, up2Proj.ID, location.BucketName.String())
+				require.NoError(t, err)
+				require.Empty(t, usage)
+
+				now := time.Now()
+				err = sat.Metabase.DB.ReplacePrefixTallies(ctx, metabase.ReplacePrefixTallies{
+					BucketLocation: location,
+					Tallies: []metabase.PrefixTally{{
+						BucketLocation:     location,
+						Prefix:             "prefix/",
+						ObjectCount:        2,
+						TotalEncryptedSize: 100,
+						TotalSegments:      3,
+						UpdatedAt:          now,
+					}},
+				})
+				require.NoError(t, err)
+
+				usage, err = service.GetBucketPrefixUsage(userCtx2, up2Proj.ID, location.BucketName.String())
+				require.NoError(t, err)
+				require.Len(t, usage, 1)
+				require.Equal(t, []byte("prefix/"), usage[0].Prefix)
+				require.EqualValues(t, 2, usage[0].ObjectCount)
+				require.EqualValues(t, 100, usage[0].Storage)
+				require.EqualValues(t, 3, usage[0].SegmentCount)
+				require.WithinDuration(t, now, usage[0].UpdatedAt, time.Second)
+			})
+
 			t.Run("GetBucketMetadata", func(t *testing.T) {
 				list, err := sat.DB.Buckets().ListBuckets(ctx, up2Proj.ID, buckets.ListOptions{Direction: buckets.DirectionForward}, macaroon.AllowedBuckets{All: true})
 				require.NoError(t, err)
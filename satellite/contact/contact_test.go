@@ -9,6 +9,7 @@ import (
 	"net"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -19,6 +20,7 @@ import (
 	"storj.io/common/signing"
 	"storj.io/common/storj"
 	"storj.io/common/testcontext"
+	"storj.io/storj/private/nodemaintenancepb"
 	"storj.io/storj/private/testplanet"
 	"storj.io/storj/shared/nodetag"
 	"storj.io/storj/storagenode"
@@ -338,3 +340,63 @@ func TestSatelliteContactEndpoint_WithWrongNodeTags(t *testing.T) {
 		require.Len(t, tags, 0)
 	})
 }
+
+func TestSatelliteDeclareMaintenance(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 0,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		node := planet.StorageNodes[0]
+
+		conn, err := node.Dialer.DialNodeURL(ctx, satellite.NodeURL())
+		require.NoError(t, err)
+		defer ctx.Check(conn.Close)
+		client := nodemaintenancepb.NewDRPCNodeMaintenanceClient(conn)
+
+		// windows longer than the maximum are rejected.
+		_, err = client.DeclareMaintenance(ctx, &nodemaintenancepb.DeclareMaintenanceRequest{
+			EndUnixNano: time.Now().Add(30 * 24 * time.Hour).UnixNano(),
+		})
+		require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+		end := time.Now().Add(time.Hour)
+		_, err = client.DeclareMaintenance(ctx, &nodemaintenancepb.DeclareMaintenanceRequest{
+			EndUnixNano: end.UnixNano(),
+		})
+		require.NoError(t, err)
+
+		dossier, err := satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.NotNil(t, dossier.Maintenance)
+		require.True(t, dossier.Maintenance.Active(time.Now()))
+		require.WithinDuration(t, end, dossier.Maintenance.End, time.Second)
+
+		// renewing the window before the cooldown is over is rejected.
+		_, err = client.DeclareMaintenance(ctx, &nodemaintenancepb.DeclareMaintenanceRequest{
+			EndUnixNano: time.Now().Add(2 * time.Hour).UnixNano(),
+		})
+		require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+
+		// the started window can be changed while it keeps its start.
+		end = time.Now().Add(2 * time.Hour)
+		_, err = client.DeclareMaintenance(ctx, &nodemaintenancepb.DeclareMaintenanceRequest{
+			StartUnixNano: dossier.Maintenance.Start.UnixNano(),
+			EndUnixNano:   end.UnixNano(),
+		})
+		require.NoError(t, err)
+
+		_, err = client.CancelMaintenance(ctx, &nodemaintenancepb.CancelMaintenanceRequest{})
+		require.NoError(t, err)
+
+		// the canceled window ends, but it's kept for the cooldown.
+		dossier, err = satellite.Overlay.Service.Get(ctx, node.ID())
+		require.NoError(t, err)
+		require.NotNil(t, dossier.Maintenance)
+		require.False(t, dossier.Maintenance.Active(time.Now()))
+
+		_, err = client.DeclareMaintenance(ctx, &nodemaintenancepb.DeclareMaintenanceRequest{
+			EndUnixNano: time.Now().Add(time.Hour).UnixNano(),
+		})
+		require.Equal(t, rpcstatus.InvalidArgument, rpcstatus.Code(err))
+	})
+}
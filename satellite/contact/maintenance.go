@@ -0,0 +1,69 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package contact
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/identity"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/private/nodemaintenancepb"
+	"storj.io/storj/satellite/overlay"
+)
+
+var _ nodemaintenancepb.DRPCNodeMaintenanceServer = (*Endpoint)(nil)
+
+// DeclareMaintenance is called by storage nodes to declare a planned maintenance window.
+func (endpoint *Endpoint) DeclareMaintenance(ctx context.Context, req *nodemaintenancepb.DeclareMaintenanceRequest) (_ *nodemaintenancepb.DeclareMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	window := overlay.MaintenanceWindow{End: time.Unix(0, req.EndUnixNano)}
+	if req.StartUnixNano != 0 {
+		window.Start = time.Unix(0, req.StartUnixNano)
+	}
+
+	err = endpoint.service.overlay.SetNodeMaintenance(ctx, peerID.ID, window)
+	if err != nil {
+		return nil, maintenanceError(err)
+	}
+
+	endpoint.log.Info("maintenance window declared", zap.Stringer("Node ID", peerID.ID), zap.Time("start", window.Start), zap.Time("end", window.End))
+	return &nodemaintenancepb.DeclareMaintenanceResponse{}, nil
+}
+
+// CancelMaintenance is called by storage nodes to cancel their planned maintenance window.
+func (endpoint *Endpoint) CancelMaintenance(ctx context.Context, req *nodemaintenancepb.CancelMaintenanceRequest) (_ *nodemaintenancepb.CancelMaintenanceResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	peerID, err := identity.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, rpcstatus.Error(rpcstatus.Unauthenticated, err.Error())
+	}
+
+	err = endpoint.service.overlay.ClearNodeMaintenance(ctx, peerID.ID)
+	if err != nil {
+		return nil, maintenanceError(err)
+	}
+	return &nodemaintenancepb.CancelMaintenanceResponse{}, nil
+}
+
+// maintenanceError converts the overlay errors to rpc errors.
+func maintenanceError(err error) error {
+	switch {
+	case overlay.ErrInvalidMaintenanceWindow.Has(err):
+		return rpcstatus.Error(rpcstatus.InvalidArgument, err.Error())
+	case overlay.ErrNodeNotFound.Has(err):
+		return rpcstatus.Error(rpcstatus.NotFound, err.Error())
+	default:
+		return rpcstatus.Error(rpcstatus.Internal, err.Error())
+	}
+}
@@ -38,9 +38,11 @@ import (
 	"storj.io/storj/satellite/gc/sender"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/inventory"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodehistory"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/overlay/offlinenodes"
 	"storj.io/storj/satellite/overlay/straynodes"
@@ -100,6 +102,11 @@ type Core struct {
 		Chore    *nodeevents.Chore
 	}
 
+	NodeHistory struct {
+		DB    nodehistory.DB
+		Chore *nodehistory.Chore
+	}
+
 	Metainfo struct {
 		Metabase *metabase.DB
 	}
@@ -122,6 +129,10 @@ type Core struct {
 		Chore *zombiedeletion.Chore
 	}
 
+	Inventory struct {
+		Chore *inventory.Chore
+	}
+
 	Accounting struct {
 		Tally                 *tally.Service
 		Rollup                *rollup.Service
@@ -316,6 +327,18 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 		}
 	}
 
+	{ // setup node history
+		peer.NodeHistory.DB = peer.DB.NodeHistory()
+		peer.NodeHistory.Chore = nodehistory.NewChore(peer.Log.Named("node-history:chore"), peer.NodeHistory.DB, config.NodeHistory)
+		peer.Services.Add(lifecycle.Item{
+			Name:  "node-history:chore",
+			Run:   peer.NodeHistory.Chore.Run,
+			Close: peer.NodeHistory.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Node History", peer.NodeHistory.Chore.Loop))
+	}
+
 	{ // setup live accounting
 		peer.LiveAccounting.Cache = liveAccounting
 	}
@@ -394,6 +417,26 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB,
 			debug.Cycle("Zombie Objects Chore", peer.ZombieDeletion.Chore.Loop))
 	}
 
+	{ // setup bucket inventory reports
+		peer.Inventory.Chore, err = inventory.NewChore(
+			peer.Log.Named("core-inventory"),
+			config.Inventory,
+			peer.DB.InventoryReports(),
+			peer.Metainfo.Metabase,
+			inventory.UplinkUploader{},
+		)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+		peer.Services.Add(lifecycle.Item{
+			Name:  "inventory:chore",
+			Run:   peer.Inventory.Chore.Run,
+			Close: peer.Inventory.Chore.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Bucket Inventory Chore", peer.Inventory.Chore.Loop))
+	}
+
 	{ // setup accounting
 		peer.Accounting.Tally = tally.New(peer.Log.Named("accounting:tally"), peer.DB.StoragenodeAccounting(), peer.DB.ProjectAccounting(), peer.LiveAccounting.Cache, peer.Metainfo.Metabase, peer.DB.Buckets(), config.Tally)
 		peer.Services.Add(lifecycle.Item{
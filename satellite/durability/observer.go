@@ -5,8 +5,10 @@ package durability
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -134,7 +136,8 @@ type NodeClassifier func(node *nodeselection.SelectedNode) string
 
 // ReportConfig configures durability report.
 type ReportConfig struct {
-	Enabled bool `help:"whether to enable durability report (rangedloop observer)" default:"true"`
+	Enabled      bool `help:"whether to enable durability report (rangedloop observer)" default:"true"`
+	TopOffenders int  `help:"number of class values with the highest risk stored in the durability risk report" default:"100"`
 }
 
 // Report  is a calculator (rangloop.Observer) which checks the availability of pieces without certain nodes.
@@ -162,12 +165,22 @@ type Report struct {
 	// contains the available classes for each node alias.
 	classified []ClassID
 
+	// number of nodes for each class.
+	nodeCount []int
+	// endangered segments for each class, if all the nodes of the class vanished.
+	risks        []riskCounter
+	riskDB       DB
+	topOffenders int
+
 	Class      string
 	nodeGetter NodeGetter
+
+	// mu protects the classes, which are extended when restoring partials.
+	mu sync.Mutex
 }
 
 // NewDurability creates the new instance.
-func NewDurability(db overlay.DB, metabaseDB *metabase.DB, nodeGetter NodeGetter, class string, classifier NodeClassifier, asOfSystemInterval time.Duration) *Report {
+func NewDurability(db overlay.DB, metabaseDB *metabase.DB, nodeGetter NodeGetter, class string, classifier NodeClassifier, riskDB DB, topOffenders int, asOfSystemInterval time.Duration) *Report {
 	return &Report{
 		healthStat:         make([]HistogramByPlacement, 3),
 		nodeGetter:         nodeGetter,
@@ -175,6 +188,8 @@ func NewDurability(db overlay.DB, metabaseDB *metabase.DB, nodeGetter NodeGetter
 		db:                 db,
 		metabaseDB:         metabaseDB,
 		classifier:         classifier,
+		riskDB:             riskDB,
+		topOffenders:       topOffenders,
 		asOfSystemInterval: asOfSystemInterval,
 		nodes:              []nodeselection.SelectedNode{},
 		reporter:           reportToEventkit,
@@ -208,6 +223,7 @@ func (c *Report) classifyNodeAliases() {
 
 	classes["unclassified"] = 0
 	c.className[0] = "unclassified"
+	c.nodeCount = []int{0}
 
 	c.classified = make([]ClassID, c.aliasMap.Max()+1)
 	for _, node := range c.nodes {
@@ -222,22 +238,172 @@ func (c *Report) classifyNodeAliases() {
 			id = ClassID(len(classes))
 			c.className[id] = class
 			classes[class] = id
+			c.nodeCount = append(c.nodeCount, 0)
 		}
 		c.classified[alias] = id
+		c.nodeCount[id]++
 	}
+	c.risks = make([]riskCounter, len(c.className))
 }
 
 // Fork implements rangedloop.Observer.
 func (c *Report) Fork(ctx context.Context) (rangedloop.Partial, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
 	d := &ObserverFork{
 		nodesCache:             c.nodeGetter,
 		healthStat:             make([]HistogramByPlacement, 3),
 		controlledByClassCache: make([]int32, len(c.className)),
 		classified:             c.classified,
+		risks:                  make([]riskCounter, len(c.className)),
 	}
 	return d, nil
 }
 
+// savedFork is the serialized state of ObserverFork. Classes are stored
+// by name, as the ClassIDs are assigned again by every Start.
+type savedFork struct {
+	HealthStat [][]savedHistogram   `json:"health_stat"`
+	Risks      map[string]savedRisk `json:"risks"`
+}
+
+type savedHistogram struct {
+	Buckets         []savedBucket `json:"buckets"`
+	NegativeBuckets []savedBucket `json:"negative_buckets"`
+}
+
+type savedBucket struct {
+	SegmentCount     int      `json:"segment_count"`
+	SegmentExemplars []string `json:"segment_exemplars"`
+	ClassExemplars   []string `json:"class_exemplars"`
+}
+
+type savedRisk struct {
+	BelowRepair int64 `json:"below_repair"`
+	Irreparable int64 `json:"irreparable"`
+}
+
+// SavePartial implements rangedloop.ResumableObserver.
+func (c *Report) SavePartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	fork, ok := partial.(*ObserverFork)
+	if !ok {
+		return nil, errs.New("expected partial type %T but got %T", fork, partial)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	saveBuckets := func(buckets []*Bucket) []savedBucket {
+		saved := make([]savedBucket, len(buckets))
+		for i, b := range buckets {
+			saved[i].SegmentCount = b.SegmentCount
+			saved[i].SegmentExemplars = b.SegmentExemplars
+			for _, class := range b.ClassExemplars {
+				saved[i].ClassExemplars = append(saved[i].ClassExemplars, c.className[class])
+			}
+		}
+		return saved
+	}
+
+	saved := savedFork{
+		HealthStat: make([][]savedHistogram, len(fork.healthStat)),
+		Risks:      map[string]savedRisk{},
+	}
+	for ix, byPlacement := range fork.healthStat {
+		saved.HealthStat[ix] = make([]savedHistogram, len(byPlacement))
+		for placement, histogram := range byPlacement {
+			saved.HealthStat[ix][placement] = savedHistogram{
+				Buckets:         saveBuckets(histogram.Buckets),
+				NegativeBuckets: saveBuckets(histogram.NegativeBuckets),
+			}
+		}
+	}
+	for class, risk := range fork.risks {
+		if risk.belowRepair == 0 && risk.irreparable == 0 {
+			continue
+		}
+		saved.Risks[c.className[ClassID(class)]] = savedRisk{
+			BelowRepair: risk.belowRepair,
+			Irreparable: risk.irreparable,
+		}
+	}
+
+	data, err := json.Marshal(saved)
+	return data, errs.Wrap(err)
+}
+
+// RestorePartial implements rangedloop.ResumableObserver.
+func (c *Report) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var saved savedFork
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	classes := make(map[string]ClassID, len(c.className))
+	for id, name := range c.className {
+		classes[name] = id
+	}
+	classID := func(name string) ClassID {
+		id, ok := classes[name]
+		if !ok {
+			// the nodes of the class are gone since the partial was saved.
+			id = ClassID(len(c.className))
+			c.className[id] = name
+			classes[name] = id
+			c.nodeCount = append(c.nodeCount, 0)
+			c.risks = append(c.risks, riskCounter{})
+		}
+		return id
+	}
+	restoreBuckets := func(saved []savedBucket) []*Bucket {
+		buckets := make([]*Bucket, len(saved))
+		for i, b := range saved {
+			buckets[i] = &Bucket{
+				SegmentCount:     b.SegmentCount,
+				SegmentExemplars: b.SegmentExemplars,
+			}
+			for _, name := range b.ClassExemplars {
+				buckets[i].ClassExemplars = append(buckets[i].ClassExemplars, classID(name))
+			}
+		}
+		return buckets
+	}
+
+	healthStat := make([]HistogramByPlacement, len(saved.HealthStat))
+	for ix, byPlacement := range saved.HealthStat {
+		healthStat[ix] = make(HistogramByPlacement, len(byPlacement))
+		for placement, histogram := range byPlacement {
+			healthStat[ix][placement] = Histogram{
+				Buckets:         restoreBuckets(histogram.Buckets),
+				NegativeBuckets: restoreBuckets(histogram.NegativeBuckets),
+			}
+		}
+	}
+	risks := map[ClassID]savedRisk{}
+	for name, risk := range saved.Risks {
+		risks[classID(name)] = risk
+	}
+
+	fork := &ObserverFork{
+		nodesCache:             c.nodeGetter,
+		healthStat:             healthStat,
+		controlledByClassCache: make([]int32, len(c.className)),
+		classified:             c.classified,
+		risks:                  make([]riskCounter, len(c.className)),
+	}
+	for class, risk := range risks {
+		fork.risks[class] = riskCounter{belowRepair: risk.BelowRepair, irreparable: risk.Irreparable}
+	}
+	return fork, nil
+}
+
 // Join implements rangedloop.Observer.
 func (c *Report) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -251,6 +417,10 @@ func (c *Report) Join(ctx context.Context, partial rangedloop.Partial) (err erro
 			c.healthStat[ix][placement].Merge(hs)
 		}
 	}
+	for class, risk := range fork.risks {
+		c.risks[class].belowRepair += risk.belowRepair
+		c.risks[class].irreparable += risk.irreparable
+	}
 	return nil
 }
 
@@ -259,7 +429,9 @@ func (c *Report) resolveClass(id ClassID) string {
 }
 
 // Finish implements rangedloop.Observer.
-func (c *Report) Finish(ctx context.Context) error {
+func (c *Report) Finish(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	reportTime := time.Now()
 	for group, healthStat := range c.healthStat {
 		for placement, histogram := range healthStat {
@@ -271,7 +443,34 @@ func (c *Report) Finish(ctx context.Context) error {
 			}
 		}
 	}
-	return nil
+
+	if c.riskDB == nil {
+		return nil
+	}
+	return errs.Wrap(c.riskDB.ReplaceRisks(ctx, c.Class, c.TopRisks(reportTime)))
+}
+
+// TopRisks returns the class values with the highest risk, based on the last execution.
+func (c *Report) TopRisks(reportTime time.Time) []Risk {
+	var risks []Risk
+	for class, risk := range c.risks {
+		if risk.belowRepair == 0 && risk.irreparable == 0 {
+			continue
+		}
+		risks = append(risks, Risk{
+			Class:               c.Class,
+			Value:               c.className[ClassID(class)],
+			NodeCount:           c.nodeCount[class],
+			SegmentsBelowRepair: risk.belowRepair,
+			SegmentsIrreparable: risk.irreparable,
+			ReportedAt:          reportTime,
+		})
+	}
+	SortRisks(risks)
+	if c.topOffenders > 0 && len(risks) > c.topOffenders {
+		risks = risks[:c.topOffenders]
+	}
+	return risks
 }
 
 // TestChangeReporter modifies the reporter for unit tests.
@@ -300,6 +499,8 @@ type ObserverFork struct {
 
 	classified []ClassID
 	placements nodeselection.PlacementDefinitions
+
+	risks []riskCounter
 }
 
 // Process implements rangedloop.Partial.
@@ -364,6 +565,18 @@ func (c *ObserverFork) Process(ctx context.Context, segments []rangedloop.Segmen
 			healthyPieceCount++
 		}
 
+		// check what would happen if all the nodes of one class vanished
+		required, repairThreshold := int(s.Redundancy.RequiredShares), int(s.Redundancy.RepairShares)
+		for _, counter := range counters.Counters {
+			remaining := healthyPieceCount - counter.Counter
+			if healthyPieceCount >= required && remaining < required {
+				c.risks[counter.Class].irreparable++
+			}
+			if healthyPieceCount > repairThreshold && remaining <= repairThreshold {
+				c.risks[counter.Class].belowRepair++
+			}
+		}
+
 		// calculate normal distribution + distribution without the biggest class(es)
 		healthyPiceces := healthyPieceCount
 		for i := 0; i < len(c.healthStat); i++ {
@@ -407,7 +620,7 @@ func reportToEventkit(n time.Time, class string, missingProviders int, ix int, p
 	)
 }
 
-var _ rangedloop.Observer = &Report{}
+var _ rangedloop.ResumableObserver = &Report{}
 
 var _ rangedloop.Partial = &ObserverFork{}
 
@@ -41,6 +41,27 @@ func (n nodeList) GetNodes(ctx context.Context, validUpTo time.Time, nodeIDs []s
 
 var _ NodeGetter = (*nodeList)(nil)
 
+type riskDB struct {
+	risks map[string][]Risk
+}
+
+func (db *riskDB) ReplaceRisks(ctx context.Context, class string, risks []Risk) error {
+	if db.risks == nil {
+		db.risks = map[string][]Risk{}
+	}
+	for i := range risks {
+		risks[i].ReportedAt = time.Time{}
+	}
+	db.risks[class] = risks
+	return nil
+}
+
+func (db *riskDB) GetRisks(ctx context.Context, class string, limit int) ([]Risk, error) {
+	return db.risks[class], nil
+}
+
+var _ DB = (*riskDB)(nil)
+
 func TestDurability(t *testing.T) {
 	var storageNodes []*nodeselection.SelectedNode
 	var aliases []metabase.NodeAliasEntry
@@ -80,6 +101,7 @@ func TestDurability(t *testing.T) {
 		// it's not inline if non-default redundancy is set.
 		res.Redundancy = storj.RedundancyScheme{
 			RequiredShares: 3,
+			RepairShares:   4,
 			ShareSize:      123,
 		}
 
@@ -93,7 +115,7 @@ func TestDurability(t *testing.T) {
 	ctx := testcontext.New(t)
 	c := NewDurability(nil, nil, nodeList{nodes: storageNodes}, "net", func(node *nodeselection.SelectedNode) string {
 		return node.LastNet
-	}, 0)
+	}, nil, 1, 0)
 
 	c.aliasMap = metabase.NewNodeAliasMap(aliases)
 	for _, node := range storageNodes {
@@ -116,6 +138,12 @@ func TestDurability(t *testing.T) {
 		})
 		require.NoError(t, err)
 
+		// interrupted and resumed between the batches
+		state, err := c.SavePartial(ctx, fork)
+		require.NoError(t, err)
+		fork, err = c.RestorePartial(ctx, state)
+		require.NoError(t, err)
+
 		// second batch
 		err = fork.Process(ctx, []rangedloop.Segment{
 			segment(storageNodes, 2, 3, 4, 7),
@@ -144,6 +172,25 @@ func TestDurability(t *testing.T) {
 	require.Equal(t, 0, c.healthStat[0][5].Buckets[0].SegmentCount)
 	require.Equal(t, 1, c.healthStat[0][5].Buckets[1].SegmentCount)
 
+	// risks of losing all the nodes of one class
+	risks := c.TopRisks(time.Time{})
+	require.Len(t, risks, 1)
+	require.Equal(t, Risk{
+		Class:               "net",
+		Value:               "127.0.0.0",
+		NodeCount:           4,
+		SegmentsIrreparable: 2,
+	}, risks[0])
+
+	c.topOffenders = 0
+	db := &riskDB{}
+	c.riskDB = db
+	require.NoError(t, c.Finish(ctx))
+	require.Equal(t, []Risk{
+		{Class: "net", Value: "127.0.0.0", NodeCount: 4, SegmentsIrreparable: 2},
+		{Class: "net", Value: "127.0.1.0", NodeCount: 3, SegmentsBelowRepair: 1, SegmentsIrreparable: 1},
+	}, db.risks["net"])
+
 	// usually called with c.Start()
 	c.resetStat()
 
@@ -0,0 +1,58 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package durability
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Risk is the durability risk of losing all the nodes with the same class value
+// (for example all the nodes of one operator).
+type Risk struct {
+	// Class is the node attribute used for the classification (like email or last_net).
+	Class string
+	// Value is the value of the node attribute.
+	Value string
+	// NodeCount is the number of the nodes with the class value.
+	NodeCount int
+	// SegmentsBelowRepair is the number of segments which are above the repair
+	// threshold, but would drop to or below it without the nodes.
+	SegmentsBelowRepair int64
+	// SegmentsIrreparable is the number of segments which are repairable, but
+	// would have less pieces than required without the nodes.
+	SegmentsIrreparable int64
+	// ReportedAt is when the report was generated.
+	ReportedAt time.Time
+}
+
+// DB stores the durability risk reports.
+//
+// architecture: Database
+type DB interface {
+	// ReplaceRisks replaces the stored risk report of the class.
+	ReplaceRisks(ctx context.Context, class string, risks []Risk) error
+	// GetRisks returns the stored risk report of the class, the highest risks first.
+	GetRisks(ctx context.Context, class string, limit int) ([]Risk, error)
+}
+
+// riskCounter counts the endangered segments of a class value.
+type riskCounter struct {
+	belowRepair int64
+	irreparable int64
+}
+
+// SortRisks sorts the risks, the ones with the most irreparable segments first.
+func SortRisks(risks []Risk) {
+	sort.SliceStable(risks, func(i, j int) bool {
+		if risks[i].SegmentsIrreparable != risks[j].SegmentsIrreparable {
+			return risks[i].SegmentsIrreparable > risks[j].SegmentsIrreparable
+		}
+		if risks[i].SegmentsBelowRepair != risks[j].SegmentsBelowRepair {
+			return risks[i].SegmentsBelowRepair > risks[j].SegmentsBelowRepair
+		}
+		return risks[i].Value < risks[j].Value
+	})
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/shared/bloomfilter"
+	"storj.io/storj/shared/nodeidmap"
+)
+
+// runParameters are chosen by Start and have to be shared by all the partials
+// of a run, otherwise their bloom filters can't be merged.
+type runParameters struct {
+	Seed byte
+	Run  uint64
+	// PieceCounts and Partitioned determine the size of the filters.
+	PieceCounts map[storj.NodeID]int64
+	Partitioned map[storj.NodeID]bool
+}
+
+// savedRetainInfo is the checkpointed form of a RetainInfo.
+type savedRetainInfo struct {
+	Filter []byte
+	Count  int
+}
+
+// resumeState tracks whether the partials of an interrupted run were restored
+// after Start, so the observers continue with the parameters of that run.
+type resumeState struct {
+	forked   bool
+	restored bool
+}
+
+// adopt checks whether the parameters of a restored partial can be used in
+// the current run. It returns true when the observer has to switch to them.
+func (state *resumeState) adopt(current, restored runParameters) (bool, error) {
+	if state.restored {
+		if current.Seed != restored.Seed || current.Run != restored.Run {
+			return false, errs.New("partial belongs to a different run")
+		}
+		return false, nil
+	}
+	if state.forked {
+		return false, errs.New("partial restored after new partials were created")
+	}
+	state.restored = true
+	return true, nil
+}
+
+// saveRetainInfos converts the retain infos to their checkpointed form.
+//
+// Note: the checkpoint contains the full bloom filters, so it's as large as
+// the filters of the partial.
+func saveRetainInfos(infos nodeidmap.Map[*RetainInfo]) map[storj.NodeID]savedRetainInfo {
+	saved := make(map[storj.NodeID]savedRetainInfo, infos.Count())
+	infos.Range(func(nodeID storj.NodeID, info *RetainInfo) bool {
+		saved[nodeID] = savedRetainInfo{
+			Filter: info.Filter.Bytes(),
+			Count:  info.Count,
+		}
+		return true
+	})
+	return saved
+}
+
+// restoreRetainInfos recreates the retain infos saved by saveRetainInfos.
+func restoreRetainInfos(saved map[storj.NodeID]savedRetainInfo) (nodeidmap.Map[*RetainInfo], error) {
+	infos := nodeidmap.MakeSized[*RetainInfo](len(saved))
+	for nodeID, info := range saved {
+		filter, err := bloomfilter.NewFromBytes(info.Filter)
+		if err != nil {
+			return infos, errs.New("invalid bloom filter of node %s: %w", nodeID, err)
+		}
+		infos.Store(nodeID, &RetainInfo{
+			Filter: filter,
+			Count:  info.Count,
+		})
+	}
+	return infos, nil
+}
@@ -16,10 +16,12 @@ type Config struct {
 	UseSyncObserver bool `help:"whether to use test GC SyncObserver with ranged loop" default:"true"`
 
 	// value for InitialPieces currently based on average pieces per node
-	InitialPieces        int64       `help:"the initial number of pieces expected for a storage node to have, used for creating a filter" releaseDefault:"400000" devDefault:"10"`
-	FalsePositiveRate    float64     `help:"the false positive rate used for creating a garbage collection bloom filter" releaseDefault:"0.1" devDefault:"0.1"`
-	MaxBloomFilterSize   memory.Size `help:"maximum size of a single bloom filter" default:"2m"`
-	ExcludeExpiredPieces bool        `help:"do not include expired pieces into bloom filter" default:"true"`
+	InitialPieces            int64       `help:"the initial number of pieces expected for a storage node to have, used for creating a filter" releaseDefault:"400000" devDefault:"10"`
+	FalsePositiveRate        float64     `help:"the false positive rate used for creating a garbage collection bloom filter" releaseDefault:"0.1" devDefault:"0.1"`
+	MaxBloomFilterSize       memory.Size `help:"maximum size of a single bloom filter" default:"2m"`
+	ExcludeExpiredPieces     bool        `help:"do not include expired pieces into bloom filter" default:"true"`
+	MaxPartitions            int         `help:"maximum number of piece ID prefix partitions of a node, when its bloom filter would be larger than the maximum size; every run sends the filter of a single partition; only nodes running partitions-minimum-version or later get partitioned filters, older ones reject them and get the filter of all their pieces" default:"1"`
+	PartitionsMinimumVersion string      `help:"the minimum storage node version, which accepts partitioned bloom filters" default:"v1.125.0"`
 
 	AccessGrant  string        `help:"Access Grant which will be used to upload bloom filters to the bucket" default:""`
 	Bucket       string        `help:"Bucket which will be used to upload bloom filters" default:"" testDefault:"gc-queue"` // TODO do we need full location?
@@ -5,6 +5,8 @@ package bloomfilter
 
 import (
 	"context"
+	"encoding/json"
+	"math/rand"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -12,7 +14,9 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/common/storj"
+	"storj.io/common/version"
 	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/shared/bloomfilter"
 	"storj.io/storj/shared/nodeidmap"
 )
@@ -28,6 +32,7 @@ type TestingObserver interface {
 // Overlay minimal set of overlay functions that are needed for the observer.
 type Overlay interface {
 	ActiveNodesPieceCounts(ctx context.Context) (pieceCounts map[storj.NodeID]int64, err error)
+	Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error)
 }
 
 // RetainInfo contains info needed for a storage node to retain important data and delete garbage data.
@@ -36,8 +41,95 @@ type RetainInfo struct {
 	Count  int
 }
 
+// newRetainInfo creates the RetainInfo of a node, which is expected to store numPieces pieces.
+//
+// When the filter of all the pieces would be larger than MaxBloomFilterSize, the piece
+// space of the node is split into partitions by the first byte of the piece ID, up to
+// MaxPartitions. The filter only contains the partition selected by the run, so
+// successive runs cycle through the partitions with more precise filters. Only nodes
+// with partitioned set, which support such filters, are partitioned.
+func newRetainInfo(config Config, seed byte, numPieces int64, run uint64, forcedTableSize int, partitioned bool) *RetainInfo {
+	partitions := int64(1)
+	if partitioned {
+		partitions = partitionCount(config, numPieces)
+	}
+
+	hashCount, tableSize := bloomfilter.OptimalParameters((numPieces+partitions-1)/partitions, config.FalsePositiveRate, config.MaxBloomFilterSize)
+	// limit size of bloom filter to ensure we are under the limit for RPC
+	if forcedTableSize > 0 {
+		tableSize = forcedTableSize
+	}
+
+	filter := bloomfilter.NewExplicit(seed, hashCount, tableSize)
+	if partitions > 1 {
+		partition := int64(run % uint64(partitions))
+		filter.SetPrefixRange(byte(partition*256/partitions), byte((partition+1)*256/partitions-1))
+	}
+	return &RetainInfo{
+		Filter: filter,
+	}
+}
+
+// partitionCount returns the number of partitions of the bloom filter of a
+// node, which is expected to store numPieces pieces.
+func partitionCount(config Config, numPieces int64) int64 {
+	if config.MaxPartitions <= 1 || config.MaxBloomFilterSize <= 0 {
+		return 1
+	}
+	_, fullSize := bloomfilter.OptimalParameters(numPieces, config.FalsePositiveRate, 0)
+	maxSize := config.MaxBloomFilterSize.Int64()
+	partitions := (int64(fullSize) + maxSize - 1) / maxSize
+	return max(min(partitions, int64(config.MaxPartitions), 256), 1)
+}
+
+// partitionedNodes returns the nodes, which need a partitioned bloom filter and
+// run a version supporting them. Older nodes reject partitioned filters, so
+// they get a filter of all their pieces, even when it's less precise.
+func partitionedNodes(ctx context.Context, log *zap.Logger, config Config, overlay Overlay, pieceCounts map[storj.NodeID]int64) (_ map[storj.NodeID]bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if config.MaxPartitions <= 1 || config.MaxBloomFilterSize <= 0 {
+		return nil, nil
+	}
+
+	minimum, err := version.NewSemVer(config.PartitionsMinimumVersion)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	partitioned := make(map[storj.NodeID]bool)
+	for nodeID, numPieces := range pieceCounts {
+		if numPieces <= 0 {
+			numPieces = config.InitialPieces
+		}
+		if partitionCount(config, numPieces) <= 1 {
+			continue
+		}
+
+		node, err := overlay.Get(ctx, nodeID)
+		if err != nil {
+			log.Error("error getting node version", zap.Stringer("Node ID", nodeID), zap.Error(err))
+			continue
+		}
+		nodeVersion, err := version.NewSemVer(node.Version.GetVersion())
+		if err != nil || nodeVersion.Compare(minimum) < 0 {
+			log.Debug("node doesn't support partitioned bloom filters",
+				zap.Stringer("Node ID", nodeID),
+				zap.String("Version", node.Version.GetVersion()))
+			continue
+		}
+		partitioned[nodeID] = true
+	}
+	return partitioned, nil
+}
+
 // Observer implements a rangedloop observer to collect bloom filters for the garbage collection.
 //
+// The checkpoint of a partial contains the seed and the piece counts chosen by Start,
+// so an interrupted run continues with the same filter parameters, and the filters
+// collected by the partial. The checkpoint is as large as the filters. The partials
+// depend on the parameters of Start, so they aren't processed by workers.
+//
 // architecture: Observer
 type Observer struct {
 	log     *zap.Logger
@@ -48,16 +140,22 @@ type Observer struct {
 	// The following fields are reset for each loop.
 	startTime       time.Time
 	lastPieceCounts map[storj.NodeID]int64
-	retainInfos     nodeidmap.Map[*RetainInfo]
-	creationTime    time.Time
-	seed            byte
+	// partitioned contains the nodes, which get partitioned bloom filters.
+	partitioned  map[storj.NodeID]bool
+	retainInfos  nodeidmap.Map[*RetainInfo]
+	creationTime time.Time
+	seed         byte
+	// run selects the partition of the nodes with partitioned bloom filters.
+	run uint64
 
 	forcedTableSize int
 
 	inlineCount, expiredCount, remoteCount int
+
+	resume resumeState
 }
 
-var _ (rangedloop.Observer) = (*Observer)(nil)
+var _ (rangedloop.LocalObserver) = (*Observer)(nil)
 var _ (rangedloop.Partial) = (*observerFork)(nil)
 
 // NewObserver creates a new instance of the gc rangedloop observer.
@@ -67,6 +165,8 @@ func NewObserver(log *zap.Logger, config Config, overlay Overlay) *Observer {
 		overlay: overlay,
 		upload:  NewUpload(log, config),
 		config:  config,
+		// start with a random partition, so restarts don't always send the same partition.
+		run: rand.Uint64(),
 	}
 }
 
@@ -90,11 +190,19 @@ func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error)
 		lastPieceCounts = make(map[storj.NodeID]int64)
 	}
 
+	partitioned, err := partitionedNodes(ctx, obs.log, obs.config, obs.overlay, lastPieceCounts)
+	if err != nil {
+		return err
+	}
+
 	obs.startTime = startTime
 	obs.lastPieceCounts = lastPieceCounts
+	obs.partitioned = partitioned
 	obs.retainInfos = nodeidmap.MakeSized[*RetainInfo](len(lastPieceCounts))
 	obs.creationTime = time.Now()
 	obs.seed = bloomfilter.GenerateSeed()
+	obs.run++
+	obs.resume = resumeState{}
 	return nil
 }
 
@@ -102,7 +210,8 @@ func (obs *Observer) Start(ctx context.Context, startTime time.Time) (err error)
 func (obs *Observer) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	return newObserverFork(obs.log.Named("gc observer"), obs.config, obs.lastPieceCounts, obs.seed, obs.startTime, obs.forcedTableSize), nil
+	obs.resume.forked = true
+	return newObserverFork(obs.log.Named("gc observer"), obs.config, obs.lastPieceCounts, obs.partitioned, obs.seed, obs.run, obs.startTime, obs.forcedTableSize), nil
 }
 
 // Join merges the bloom filters gathered by each Partial.
@@ -145,6 +254,83 @@ func (obs *Observer) Join(ctx context.Context, partial rangedloop.Partial) (err
 	return nil
 }
 
+// observerForkState is the checkpointed state of an observerFork.
+type observerForkState struct {
+	runParameters
+	RetainInfos        map[storj.NodeID]savedRetainInfo
+	LatestCreationTime map[string]time.Time
+
+	InlineCount, ExpiredCount, RemoteCount int
+}
+
+// SavePartial serializes the bloom filters of a partial with the parameters
+// of the run.
+func (obs *Observer) SavePartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return nil, errs.New("expected %T but got %T", fork, partial)
+	}
+
+	data, err := json.Marshal(observerForkState{
+		runParameters: runParameters{
+			Seed:        fork.seed,
+			Run:         fork.run,
+			PieceCounts: fork.pieceCounts,
+			Partitioned: fork.partitioned,
+		},
+		RetainInfos:        saveRetainInfos(fork.retainInfos),
+		LatestCreationTime: fork.latestCreationTime,
+		InlineCount:        fork.inlineCount,
+		ExpiredCount:       fork.expiredCount,
+		RemoteCount:        fork.remoteCount,
+	})
+	return data, errs.Wrap(err)
+}
+
+// RestorePartial recreates a partial saved by SavePartial. The first restored
+// partial of a run replaces the parameters chosen by Start, so the partials
+// forked afterwards create filters, which can be merged with the restored ones.
+func (obs *Observer) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var state observerForkState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	adopt, err := obs.resume.adopt(runParameters{Seed: obs.seed, Run: obs.run}, state.runParameters)
+	if err != nil {
+		return nil, err
+	}
+	if adopt {
+		obs.seed, obs.run = state.Seed, state.Run
+		obs.lastPieceCounts, obs.partitioned = state.PieceCounts, state.Partitioned
+		// pieces of segments created after the interrupted run started may be
+		// missing from the restored filters.
+		if obs.startTime.Before(obs.creationTime) {
+			obs.creationTime = obs.startTime
+		}
+	}
+
+	fork := newObserverFork(obs.log.Named("gc observer"), obs.config, obs.lastPieceCounts, obs.partitioned, obs.seed, obs.run, obs.startTime, obs.forcedTableSize)
+	fork.retainInfos, err = restoreRetainInfos(state.RetainInfos)
+	if err != nil {
+		return nil, err
+	}
+	if state.LatestCreationTime != nil {
+		fork.latestCreationTime = state.LatestCreationTime
+	}
+	fork.inlineCount = state.InlineCount
+	fork.expiredCount = state.ExpiredCount
+	fork.remoteCount = state.RemoteCount
+	return fork, nil
+}
+
+// LocalOnly implements rangedloop.LocalObserver.
+func (obs *Observer) LocalOnly() {}
+
 // Finish uploads the bloom filters.
 func (obs *Observer) Finish(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -183,7 +369,9 @@ type observerFork struct {
 	config Config
 	// TODO: should we use int or int64 consistently for piece count (db type is int64)?
 	pieceCounts map[storj.NodeID]int64
+	partitioned map[storj.NodeID]bool
 	seed        byte
+	run         uint64
 	startTime   time.Time
 
 	retainInfos nodeidmap.Map[*RetainInfo]
@@ -200,13 +388,15 @@ type observerFork struct {
 }
 
 // newObserverFork instantiates a new observer fork to process different segment range.
-// The seed is passed so that it can be shared among all parallel forks.
-func newObserverFork(log *zap.Logger, config Config, pieceCounts map[storj.NodeID]int64, seed byte, startTime time.Time, forcedTableSize int) *observerFork {
+// The seed and the run are passed so that they can be shared among all parallel forks.
+func newObserverFork(log *zap.Logger, config Config, pieceCounts map[storj.NodeID]int64, partitioned map[storj.NodeID]bool, seed byte, run uint64, startTime time.Time, forcedTableSize int) *observerFork {
 	return &observerFork{
 		log:                log,
 		config:             config,
 		pieceCounts:        pieceCounts,
+		partitioned:        partitioned,
 		seed:               seed,
+		run:                run,
 		startTime:          startTime,
 		forcedTableSize:    forcedTableSize,
 		retainInfos:        nodeidmap.MakeSized[*RetainInfo](len(pieceCounts)),
@@ -266,19 +456,13 @@ func (fork *observerFork) add(nodeID storj.NodeID, pieceID storj.PieceID) {
 			return
 		}
 
-		hashCount, tableSize := bloomfilter.OptimalParameters(numPieces, fork.config.FalsePositiveRate, fork.config.MaxBloomFilterSize)
-		// limit size of bloom filter to ensure we are under the limit for RPC
-		if fork.forcedTableSize > 0 {
-			tableSize = fork.forcedTableSize
-		}
-
-		filter := bloomfilter.NewExplicit(fork.seed, hashCount, tableSize)
-		info = &RetainInfo{
-			Filter: filter,
-		}
+		info = newRetainInfo(fork.config, fork.seed, numPieces, fork.run, fork.forcedTableSize, fork.partitioned[nodeID])
 		fork.retainInfos.Store(nodeID, info)
 	}
 
+	if !info.Filter.InPrefixRange(pieceID) {
+		return
+	}
 	info.Filter.Add(pieceID)
 	info.Count++
 }
@@ -5,6 +5,8 @@ package bloomfilter
 
 import (
 	"context"
+	"encoding/json"
+	"math/rand"
 	"sync"
 	"time"
 
@@ -18,6 +20,11 @@ import (
 )
 
 // SyncObserver implements a rangedloop observer to collect bloom filters for the garbage collection.
+// All the ranges share the same state, so the checkpoint of each range contains the
+// bloom filters collected by all of them until then. Restoring merges these snapshots,
+// which keeps the filters complete, but the piece counts of a resumed run are only
+// estimates: they are at least the counts of the latest snapshot, and they include
+// the pieces, which are processed again after resuming.
 type SyncObserver struct {
 	log     *zap.Logger
 	config  Config
@@ -27,7 +34,11 @@ type SyncObserver struct {
 	// The following fields are reset for each loop.
 	startTime       time.Time
 	lastPieceCounts map[storj.NodeID]int64
-	seed            byte
+	// partitioned contains the nodes, which get partitioned bloom filters.
+	partitioned map[storj.NodeID]bool
+	seed        byte
+	// run selects the partition of the nodes with partitioned bloom filters.
+	run uint64
 
 	mu          sync.Mutex
 	retainInfos nodeidmap.Map[*RetainInfo]
@@ -39,9 +50,11 @@ type SyncObserver struct {
 	forcedTableSize int
 
 	inlineCount, remoteCount int
+
+	resume resumeState
 }
 
-var _ (rangedloop.Observer) = (*SyncObserver)(nil)
+var _ (rangedloop.LocalObserver) = (*SyncObserver)(nil)
 var _ (rangedloop.Partial) = (*SyncObserver)(nil)
 
 // NewSyncObserver creates a new instance of the gc rangedloop observer.
@@ -51,6 +64,8 @@ func NewSyncObserver(log *zap.Logger, config Config, overlay Overlay) *SyncObser
 		overlay: overlay,
 		upload:  NewUpload(log, config),
 		config:  config,
+		// start with a random partition, so restarts don't always send the same partition.
+		run: rand.Uint64(),
 	}
 }
 
@@ -74,19 +89,118 @@ func (obs *SyncObserver) Start(ctx context.Context, startTime time.Time) (err er
 		lastPieceCounts = make(map[storj.NodeID]int64)
 	}
 
+	partitioned, err := partitionedNodes(ctx, obs.log, obs.config, obs.overlay, lastPieceCounts)
+	if err != nil {
+		return err
+	}
+
 	obs.startTime = startTime
 	obs.lastPieceCounts = lastPieceCounts
+	obs.partitioned = partitioned
 	obs.retainInfos = nodeidmap.MakeSized[*RetainInfo](len(lastPieceCounts))
 	obs.latestCreationTime = time.Time{}
 	obs.seed = bloomfilter.GenerateSeed()
+	obs.run++
+	obs.resume = resumeState{}
 	return nil
 }
 
 // Fork creates a Partial to build bloom filters over a chunk of all the segments.
 func (obs *SyncObserver) Fork(ctx context.Context) (_ rangedloop.Partial, err error) {
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+
+	obs.resume.forked = true
+	return obs, nil
+}
+
+// syncObserverState is the checkpointed state of a SyncObserver.
+type syncObserverState struct {
+	runParameters
+	RetainInfos        map[storj.NodeID]savedRetainInfo
+	LatestCreationTime time.Time
+
+	InlineCount, RemoteCount int
+}
+
+// SavePartial serializes the bloom filters collected so far with the
+// parameters of the run.
+func (obs *SyncObserver) SavePartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if partial != obs {
+		return nil, errs.New("expected %T but got %T", obs, partial)
+	}
+
+	obs.mu.Lock()
+	state := syncObserverState{
+		runParameters: runParameters{
+			Seed:        obs.seed,
+			Run:         obs.run,
+			PieceCounts: obs.lastPieceCounts,
+			Partitioned: obs.partitioned,
+		},
+		RetainInfos:        saveRetainInfos(obs.retainInfos),
+		LatestCreationTime: obs.latestCreationTime,
+		InlineCount:        obs.inlineCount,
+		RemoteCount:        obs.remoteCount,
+	}
+	obs.mu.Unlock()
+
+	data, err := json.Marshal(state)
+	return data, errs.Wrap(err)
+}
+
+// RestorePartial merges the bloom filters saved by SavePartial into the
+// shared state. The first restored state of a run replaces the parameters
+// chosen by Start.
+func (obs *SyncObserver) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var state syncObserverState
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, errs.Wrap(err)
+	}
+	retainInfos, err := restoreRetainInfos(state.RetainInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	obs.mu.Lock()
+	defer obs.mu.Unlock()
+
+	adopt, err := obs.resume.adopt(runParameters{Seed: obs.seed, Run: obs.run}, state.runParameters)
+	if err != nil {
+		return nil, err
+	}
+	if adopt {
+		obs.seed, obs.run = state.Seed, state.Run
+		obs.lastPieceCounts, obs.partitioned = state.PieceCounts, state.Partitioned
+	}
+
+	var failures []error
+	obs.retainInfos.Add(retainInfos, func(old *RetainInfo, new *RetainInfo) *RetainInfo {
+		old.Count = max(old.Count, new.Count)
+		if err := old.Filter.AddFilter(new.Filter); err != nil {
+			failures = append(failures, err)
+		}
+		return old
+	})
+	if len(failures) > 0 {
+		return nil, errs.Combine(failures...)
+	}
+
+	if obs.latestCreationTime.Before(state.LatestCreationTime) {
+		obs.latestCreationTime = state.LatestCreationTime
+	}
+	obs.inlineCount = max(obs.inlineCount, state.InlineCount)
+	obs.remoteCount = max(obs.remoteCount, state.RemoteCount)
 	return obs, nil
 }
 
+// LocalOnly implements rangedloop.LocalObserver.
+func (obs *SyncObserver) LocalOnly() {}
+
 // Join merges the bloom filters gathered by each Partial.
 func (obs *SyncObserver) Join(ctx context.Context, partial rangedloop.Partial) (err error) {
 	return nil
@@ -180,18 +294,13 @@ func (obs *SyncObserver) add(nodeID storj.NodeID, pieceID storj.PieceID) {
 			return
 		}
 
-		hashCount, tableSize := bloomfilter.OptimalParameters(numPieces, obs.config.FalsePositiveRate, obs.config.MaxBloomFilterSize)
-		// limit size of bloom filter to ensure we are under the limit for RPC
-		if obs.forcedTableSize > 0 {
-			tableSize = obs.forcedTableSize
-		}
-		filter := bloomfilter.NewExplicit(obs.seed, hashCount, tableSize)
-		info = &RetainInfo{
-			Filter: filter,
-		}
+		info = newRetainInfo(obs.config, obs.seed, numPieces, obs.run, obs.forcedTableSize, obs.partitioned[nodeID])
 		obs.retainInfos.Store(nodeID, info)
 	}
 
+	if !info.Filter.InPrefixRange(pieceID) {
+		return
+	}
 	info.Filter.Add(pieceID)
 	info.Count++
 }
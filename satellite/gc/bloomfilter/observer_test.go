@@ -11,12 +11,14 @@ import (
 	"io"
 	"sort"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zaptest"
+	zapobserver "go.uber.org/zap/zaptest/observer"
 	"golang.org/x/exp/slices"
 
 	"storj.io/common/memory"
@@ -423,6 +425,100 @@ func TestObserverGarbageCollection_CreationDate_MultipleSources(t *testing.T) {
 	}
 }
 
+func TestObserverGarbageCollection_Partitions(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	const numPieces = 10000
+
+	nodeID, oldNodeID := testrand.NodeID(), testrand.NodeID()
+	overlay := &partitionOverlay{
+		pieceCounts: map[storj.NodeID]int64{nodeID: numPieces, oldNodeID: numPieces},
+		versions:    map[storj.NodeID]string{nodeID: "v1.125.0", oldNodeID: "v1.124.3"},
+	}
+
+	var segments []rangedloop.Segment
+	for i := 0; i < numPieces; i++ {
+		segments = append(segments, rangedloop.Segment{
+			StreamID:    testrand.UUID(),
+			RootPieceID: testrand.PieceID(),
+			Pieces:      metabase.Pieces{{Number: 0, StorageNode: nodeID}, {Number: 1, StorageNode: oldNodeID}},
+		})
+	}
+
+	config := bloomfilter.Config{
+		AccessGrant:       "test",
+		Bucket:            "test",
+		FalsePositiveRate: 0.1,
+		// the filter of all pieces is about 6KiB.
+		MaxBloomFilterSize:       memory.KiB,
+		MaxPartitions:            4,
+		PartitionsMinimumVersion: "v1.125.0",
+	}
+
+	for _, observer := range []interface {
+		rangedloop.Observer
+		bloomfilter.TestingObserver
+	}{
+		bloomfilter.NewObserver(zaptest.NewLogger(t), config, overlay),
+		bloomfilter.NewSyncObserver(zaptest.NewLogger(t), config, overlay),
+	} {
+		t.Run(fmt.Sprintf("%T", observer), func(t *testing.T) {
+			covered := map[[2]byte]bool{}
+			for run := 0; run < 4; run++ {
+				require.NoError(t, observer.Start(ctx, time.Now()))
+				partial, err := observer.Fork(ctx)
+				require.NoError(t, err)
+				require.NoError(t, partial.Process(ctx, segments))
+				require.NoError(t, observer.Join(ctx, partial))
+
+				info, ok := observer.TestingRetainInfos().Load(nodeID)
+				require.True(t, ok)
+
+				first, last := info.Filter.PrefixRange()
+				require.EqualValues(t, 63, last-first)
+				covered[[2]byte{first, last}] = true
+
+				_, size := info.Filter.Parameters()
+				require.LessOrEqual(t, size, memory.KiB.Int())
+
+				inRange := 0
+				for _, segment := range segments {
+					pieceID := segment.RootPieceID.Derive(nodeID, 0)
+					require.True(t, info.Filter.Contains(pieceID))
+					if info.Filter.InPrefixRange(pieceID) {
+						inRange++
+					}
+				}
+				require.Equal(t, inRange, info.Count)
+
+				// the old node doesn't support partitioned filters.
+				info, ok = observer.TestingRetainInfos().Load(oldNodeID)
+				require.True(t, ok)
+				first, last = info.Filter.PrefixRange()
+				require.EqualValues(t, 0, first)
+				require.EqualValues(t, 255, last)
+				require.Equal(t, numPieces, info.Count)
+			}
+			// successive runs cycle through all partitions.
+			require.Len(t, covered, 4)
+		})
+	}
+}
+
+type partitionOverlay struct {
+	pieceCounts map[storj.NodeID]int64
+	versions    map[storj.NodeID]string
+}
+
+func (o *partitionOverlay) ActiveNodesPieceCounts(ctx context.Context) (map[storj.NodeID]int64, error) {
+	return o.pieceCounts, nil
+}
+
+func (o *partitionOverlay) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error) {
+	return &overlay.NodeDossier{Version: pb.NodeVersion{Version: o.versions[nodeID]}}, nil
+}
+
 type mockOverlay struct {
 	pieceCounts map[storj.NodeID]int64
 }
@@ -430,3 +526,109 @@ type mockOverlay struct {
 func (o *mockOverlay) ActiveNodesPieceCounts(ctx context.Context) (pieceCounts map[storj.NodeID]int64, err error) {
 	return pieceCounts, nil
 }
+
+func (o *mockOverlay) Get(ctx context.Context, nodeID storj.NodeID) (*overlay.NodeDossier, error) {
+	return nil, overlay.ErrNodeNotFound.New("%v", nodeID)
+}
+
+func TestObserverGarbageCollection_Resume(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+	overlay := &partitionOverlay{
+		pieceCounts: map[storj.NodeID]int64{nodeA: 100, nodeB: 100},
+	}
+
+	segments := make([]rangedloop.Segment, 40)
+	for i := range segments {
+		segments[i] = rangedloop.Segment{
+			StreamID:    testrand.UUID(),
+			CreatedAt:   time.Now().Add(-time.Hour),
+			RootPieceID: testrand.PieceID(),
+			Pieces:      metabase.Pieces{{Number: 0, StorageNode: nodeA}, {Number: 1, StorageNode: nodeB}},
+		}
+	}
+	provider := &rangedlooptest.RangeSplitter{Segments: segments}
+
+	config := bloomfilter.Config{
+		AccessGrant:       "test",
+		Bucket:            "test",
+		FalsePositiveRate: 0.1,
+	}
+
+	for _, newObserver := range []func() resumableTestingObserver{
+		func() resumableTestingObserver {
+			return bloomfilter.NewObserver(zaptest.NewLogger(t), config, overlay)
+		},
+		func() resumableTestingObserver {
+			return bloomfilter.NewSyncObserver(zaptest.NewLogger(t), config, overlay)
+		},
+	} {
+		t.Run(fmt.Sprintf("%T", newObserver()), func(t *testing.T) {
+			loopConfig := rangedloop.Config{
+				BatchSize:      2,
+				Parallelism:    2,
+				CheckpointPath: ctx.File(fmt.Sprintf("%T", newObserver()), "checkpoint.json"),
+			}
+
+			// interrupt the run after a few batches.
+			interruptedCtx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			var processedBatches int32
+			_, err := rangedloop.NewService(zaptest.NewLogger(t), loopConfig, provider, []rangedloop.Observer{
+				skipUpload{newObserver()},
+				&rangedlooptest.CountObserver{},
+				&rangedlooptest.CallbackObserver{
+					OnProcess: func(ctx context.Context, segments []rangedloop.Segment) error {
+						if atomic.AddInt32(&processedBatches, 1) == 5 {
+							cancel()
+						}
+						return nil
+					},
+				},
+			}).RunOnce(interruptedCtx)
+			require.ErrorIs(t, err, context.Canceled)
+			require.FileExists(t, loopConfig.CheckpointPath)
+
+			// a new observer, with a different seed, continues the run.
+			observer := newObserver()
+			counter := &rangedlooptest.CountObserver{}
+			core, logs := zapobserver.New(zap.InfoLevel)
+			_, err = rangedloop.NewService(zap.New(core), loopConfig, provider, []rangedloop.Observer{
+				skipUpload{observer},
+				counter,
+			}).RunOnce(ctx)
+			require.NoError(t, err)
+			require.NoFileExists(t, loopConfig.CheckpointPath)
+			require.Equal(t, 1, logs.FilterMessage("resuming interrupted ranged loop").Len())
+
+			// the run continued, every segment was processed exactly once.
+			require.Equal(t, len(segments), counter.NumSegments)
+
+			for i, nodeID := range []storj.NodeID{nodeA, nodeB} {
+				info, ok := observer.TestingRetainInfos().Load(nodeID)
+				require.True(t, ok)
+				require.GreaterOrEqual(t, info.Count, len(segments))
+				if _, ok := observer.(*bloomfilter.Observer); ok {
+					require.Equal(t, len(segments), info.Count)
+				}
+				for _, segment := range segments {
+					require.True(t, info.Filter.Contains(segment.RootPieceID.Derive(nodeID, int32(i))))
+				}
+			}
+		})
+	}
+}
+
+type resumableTestingObserver interface {
+	rangedloop.LocalObserver
+	bloomfilter.TestingObserver
+}
+
+// skipUpload is an observer, which doesn't upload the bloom filters.
+type skipUpload struct {
+	resumableTestingObserver
+}
+
+func (skipUpload) Finish(ctx context.Context) error { return nil }
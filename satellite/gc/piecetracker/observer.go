@@ -5,6 +5,7 @@ package piecetracker
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -24,8 +25,8 @@ var (
 	mon   = monkit.Package()
 
 	// check if Observer and Partial interfaces are satisfied.
-	_ rangedloop.Observer = (*Observer)(nil)
-	_ rangedloop.Partial  = (*observerFork)(nil)
+	_ rangedloop.ResumableObserver = (*Observer)(nil)
+	_ rangedloop.Partial           = (*observerFork)(nil)
 )
 
 // Observer implements piecetraker ranged loop observer.
@@ -83,6 +84,29 @@ func (observer *Observer) Join(ctx context.Context, partial rangedloop.Partial)
 	return nil
 }
 
+// SavePartial implements ranged loop observer save partial method.
+func (observer *Observer) SavePartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+	pieceTracker, ok := partial.(*observerFork)
+	if !ok {
+		return nil, Error.New("expected %T but got %T", pieceTracker, partial)
+	}
+
+	data, err := json.Marshal(pieceTracker.pieceCounts)
+	return data, Error.Wrap(err)
+}
+
+// RestorePartial implements ranged loop observer restore partial method.
+func (observer *Observer) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	fork := newObserverFork()
+	if err := json.Unmarshal(data, &fork.pieceCounts); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return fork, nil
+}
+
 // Finish updates piece counts in the DB.
 func (observer *Observer) Finish(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
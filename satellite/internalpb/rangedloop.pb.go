@@ -0,0 +1,449 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: rangedloop.proto
+
+package internalpb
+
+import (
+	fmt "fmt"
+	math "math"
+
+	proto "github.com/gogo/protobuf/proto"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type NextRangeRequest struct {
+	// Identifier of the worker, used for logging
+	WorkerId             string   `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NextRangeRequest) Reset()         { *m = NextRangeRequest{} }
+func (m *NextRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*NextRangeRequest) ProtoMessage()    {}
+func (*NextRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d731a033cc1ba42e, []int{0}
+}
+func (m *NextRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NextRangeRequest.Unmarshal(m, b)
+}
+func (m *NextRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NextRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *NextRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextRangeRequest.Merge(m, src)
+}
+func (m *NextRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_NextRangeRequest.Size(m)
+}
+func (m *NextRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextRangeRequest proto.InternalMessageInfo
+
+func (m *NextRangeRequest) GetWorkerId() string {
+	if m != nil {
+		return m.WorkerId
+	}
+	return ""
+}
+
+type NextRangeResponse struct {
+	// Identifier of the assignment, empty when no range is available
+	LeaseId []byte `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Otherwise, worker should wait this many milliseconds and then try again
+	ComeBackInMillis int32 `protobuf:"varint,2,opt,name=come_back_in_millis,json=comeBackInMillis,proto3" json:"come_back_in_millis,omitempty"`
+	// Identifier of the loop run, the observers are started once per run
+	RunId []byte `protobuf:"bytes,3,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	// Start time of the loop run, passed to the observers
+	RunStartTimeUnixNano int64 `protobuf:"varint,4,opt,name=run_start_time_unix_nano,json=runStartTimeUnixNano,proto3" json:"run_start_time_unix_nano,omitempty"`
+	// Number of ranges and batch size the segments are split with
+	NumRanges int32 `protobuf:"varint,5,opt,name=num_ranges,json=numRanges,proto3" json:"num_ranges,omitempty"`
+	BatchSize int32 `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	// Index of the assigned range
+	RangeIndex int32 `protobuf:"varint,7,opt,name=range_index,json=rangeIndex,proto3" json:"range_index,omitempty"`
+	// Boundaries of the assigned range, empty when open-ended
+	RangeStart []byte `protobuf:"bytes,8,opt,name=range_start,json=rangeStart,proto3" json:"range_start,omitempty"`
+	RangeEnd   []byte `protobuf:"bytes,9,opt,name=range_end,json=rangeEnd,proto3" json:"range_end,omitempty"`
+	// Names of the observers, whose partial state should be returned
+	Observers            []string `protobuf:"bytes,10,rep,name=observers,proto3" json:"observers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NextRangeResponse) Reset()         { *m = NextRangeResponse{} }
+func (m *NextRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*NextRangeResponse) ProtoMessage()    {}
+func (*NextRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d731a033cc1ba42e, []int{1}
+}
+func (m *NextRangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NextRangeResponse.Unmarshal(m, b)
+}
+func (m *NextRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NextRangeResponse.Marshal(b, m, deterministic)
+}
+func (m *NextRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextRangeResponse.Merge(m, src)
+}
+func (m *NextRangeResponse) XXX_Size() int {
+	return xxx_messageInfo_NextRangeResponse.Size(m)
+}
+func (m *NextRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextRangeResponse proto.InternalMessageInfo
+
+func (m *NextRangeResponse) GetLeaseId() []byte {
+	if m != nil {
+		return m.LeaseId
+	}
+	return nil
+}
+
+func (m *NextRangeResponse) GetComeBackInMillis() int32 {
+	if m != nil {
+		return m.ComeBackInMillis
+	}
+	return 0
+}
+
+func (m *NextRangeResponse) GetRunId() []byte {
+	if m != nil {
+		return m.RunId
+	}
+	return nil
+}
+
+func (m *NextRangeResponse) GetRunStartTimeUnixNano() int64 {
+	if m != nil {
+		return m.RunStartTimeUnixNano
+	}
+	return 0
+}
+
+func (m *NextRangeResponse) GetNumRanges() int32 {
+	if m != nil {
+		return m.NumRanges
+	}
+	return 0
+}
+
+func (m *NextRangeResponse) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *NextRangeResponse) GetRangeIndex() int32 {
+	if m != nil {
+		return m.RangeIndex
+	}
+	return 0
+}
+
+func (m *NextRangeResponse) GetRangeStart() []byte {
+	if m != nil {
+		return m.RangeStart
+	}
+	return nil
+}
+
+func (m *NextRangeResponse) GetRangeEnd() []byte {
+	if m != nil {
+		return m.RangeEnd
+	}
+	return nil
+}
+
+func (m *NextRangeResponse) GetObservers() []string {
+	if m != nil {
+		return m.Observers
+	}
+	return nil
+}
+
+type RangeHeartbeatRequest struct {
+	LeaseId              []byte   `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RangeHeartbeatRequest) Reset()         { *m = RangeHeartbeatRequest{} }
+func (m *RangeHeartbeatRequest) String() string { return proto.CompactTextString(m) }
+func (*RangeHeartbeatRequest) ProtoMessage()    {}
+func (*RangeHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d731a033cc1ba42e, []int{2}
+}
+func (m *RangeHeartbeatRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RangeHeartbeatRequest.Unmarshal(m, b)
+}
+func (m *RangeHeartbeatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RangeHeartbeatRequest.Marshal(b, m, deterministic)
+}
+func (m *RangeHeartbeatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeHeartbeatRequest.Merge(m, src)
+}
+func (m *RangeHeartbeatRequest) XXX_Size() int {
+	return xxx_messageInfo_RangeHeartbeatRequest.Size(m)
+}
+func (m *RangeHeartbeatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeHeartbeatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeHeartbeatRequest proto.InternalMessageInfo
+
+func (m *RangeHeartbeatRequest) GetLeaseId() []byte {
+	if m != nil {
+		return m.LeaseId
+	}
+	return nil
+}
+
+type RangeHeartbeatResponse struct {
+	// Set when the range was reassigned and the worker should stop processing it
+	LeaseExpired         bool     `protobuf:"varint,1,opt,name=lease_expired,json=leaseExpired,proto3" json:"lease_expired,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RangeHeartbeatResponse) Reset()         { *m = RangeHeartbeatResponse{} }
+func (m *RangeHeartbeatResponse) String() string { return proto.CompactTextString(m) }
+func (*RangeHeartbeatResponse) ProtoMessage()    {}
+func (*RangeHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d731a033cc1ba42e, []int{3}
+}
+func (m *RangeHeartbeatResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RangeHeartbeatResponse.Unmarshal(m, b)
+}
+func (m *RangeHeartbeatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RangeHeartbeatResponse.Marshal(b, m, deterministic)
+}
+func (m *RangeHeartbeatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RangeHeartbeatResponse.Merge(m, src)
+}
+func (m *RangeHeartbeatResponse) XXX_Size() int {
+	return xxx_messageInfo_RangeHeartbeatResponse.Size(m)
+}
+func (m *RangeHeartbeatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RangeHeartbeatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RangeHeartbeatResponse proto.InternalMessageInfo
+
+func (m *RangeHeartbeatResponse) GetLeaseExpired() bool {
+	if m != nil {
+		return m.LeaseExpired
+	}
+	return false
+}
+
+type FinishRangeRequest struct {
+	LeaseId []byte `protobuf:"bytes,1,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// Set when the range could not be processed, the range will be reassigned
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Serialized partial state of the observers
+	Partials             []*ObserverPartial `protobuf:"bytes,3,rep,name=partials,proto3" json:"partials,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *FinishRangeRequest) Reset()         { *m = FinishRangeRequest{} }
+func (m *FinishRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*FinishRangeRequest) ProtoMessage()    {}
+func (*FinishRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d731a033cc1ba42e, []int{4}
+}
+func (m *FinishRangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinishRangeRequest.Unmarshal(m, b)
+}
+func (m *FinishRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinishRangeRequest.Marshal(b, m, deterministic)
+}
+func (m *FinishRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinishRangeRequest.Merge(m, src)
+}
+func (m *FinishRangeRequest) XXX_Size() int {
+	return xxx_messageInfo_FinishRangeRequest.Size(m)
+}
+func (m *FinishRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinishRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinishRangeRequest proto.InternalMessageInfo
+
+func (m *FinishRangeRequest) GetLeaseId() []byte {
+	if m != nil {
+		return m.LeaseId
+	}
+	return nil
+}
+
+func (m *FinishRangeRequest) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *FinishRangeRequest) GetPartials() []*ObserverPartial {
+	if m != nil {
+		return m.Partials
+	}
+	return nil
+}
+
+type ObserverPartial struct {
+	Observer string `protobuf:"bytes,1,opt,name=observer,proto3" json:"observer,omitempty"`
+	State    []byte `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// Set when the observer failed to process the range
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ObserverPartial) Reset()         { *m = ObserverPartial{} }
+func (m *ObserverPartial) String() string { return proto.CompactTextString(m) }
+func (*ObserverPartial) ProtoMessage()    {}
+func (*ObserverPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d731a033cc1ba42e, []int{5}
+}
+func (m *ObserverPartial) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ObserverPartial.Unmarshal(m, b)
+}
+func (m *ObserverPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ObserverPartial.Marshal(b, m, deterministic)
+}
+func (m *ObserverPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObserverPartial.Merge(m, src)
+}
+func (m *ObserverPartial) XXX_Size() int {
+	return xxx_messageInfo_ObserverPartial.Size(m)
+}
+func (m *ObserverPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObserverPartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObserverPartial proto.InternalMessageInfo
+
+func (m *ObserverPartial) GetObserver() string {
+	if m != nil {
+		return m.Observer
+	}
+	return ""
+}
+
+func (m *ObserverPartial) GetState() []byte {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+func (m *ObserverPartial) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type FinishRangeResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FinishRangeResponse) Reset()         { *m = FinishRangeResponse{} }
+func (m *FinishRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*FinishRangeResponse) ProtoMessage()    {}
+func (*FinishRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d731a033cc1ba42e, []int{6}
+}
+func (m *FinishRangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FinishRangeResponse.Unmarshal(m, b)
+}
+func (m *FinishRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FinishRangeResponse.Marshal(b, m, deterministic)
+}
+func (m *FinishRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinishRangeResponse.Merge(m, src)
+}
+func (m *FinishRangeResponse) XXX_Size() int {
+	return xxx_messageInfo_FinishRangeResponse.Size(m)
+}
+func (m *FinishRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinishRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinishRangeResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*NextRangeRequest)(nil), "satellite.rangedloop.NextRangeRequest")
+	proto.RegisterType((*NextRangeResponse)(nil), "satellite.rangedloop.NextRangeResponse")
+	proto.RegisterType((*RangeHeartbeatRequest)(nil), "satellite.rangedloop.RangeHeartbeatRequest")
+	proto.RegisterType((*RangeHeartbeatResponse)(nil), "satellite.rangedloop.RangeHeartbeatResponse")
+	proto.RegisterType((*FinishRangeRequest)(nil), "satellite.rangedloop.FinishRangeRequest")
+	proto.RegisterType((*ObserverPartial)(nil), "satellite.rangedloop.ObserverPartial")
+	proto.RegisterType((*FinishRangeResponse)(nil), "satellite.rangedloop.FinishRangeResponse")
+}
+
+func init() { proto.RegisterFile("rangedloop.proto", fileDescriptor_d731a033cc1ba42e) }
+
+var fileDescriptor_d731a033cc1ba42e = []byte{
+	// 568 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xfd, 0x52, 0x7f, 0x69, 0xed, 0x69, 0x81, 0xb0, 0x4d, 0x90, 0x09, 0x20, 0x22, 0x57, 0x05,
+	0x23, 0x20, 0x91, 0x82, 0xc4, 0x1d, 0x17, 0x14, 0x15, 0x11, 0x09, 0x0a, 0x72, 0xe1, 0x02, 0x2e,
+	0xb0, 0xd6, 0xf1, 0x88, 0x2e, 0xb1, 0x77, 0xcd, 0xee, 0x1a, 0xa2, 0x3e, 0x01, 0x0f, 0xc7, 0x7b,
+	0xf0, 0x1a, 0xc8, 0xbb, 0x71, 0xfe, 0x08, 0xd0, 0xbb, 0xcc, 0x39, 0x73, 0x76, 0xce, 0xfc, 0xc4,
+	0xd0, 0x92, 0x94, 0x7f, 0xc2, 0x34, 0x13, 0xa2, 0xe8, 0x17, 0x52, 0x68, 0x41, 0xda, 0x8a, 0x6a,
+	0xcc, 0x32, 0xa6, 0xb1, 0xbf, 0xe0, 0x82, 0x01, 0xb4, 0x4e, 0x70, 0xaa, 0xa3, 0x0a, 0x89, 0xf0,
+	0x4b, 0x89, 0x4a, 0x93, 0x1b, 0xe0, 0x7d, 0x13, 0x72, 0x82, 0x32, 0x66, 0xa9, 0xdf, 0xe8, 0x35,
+	0x42, 0x2f, 0x72, 0x2d, 0x30, 0x4a, 0x83, 0x9f, 0x5b, 0x70, 0x75, 0x49, 0xa1, 0x0a, 0xc1, 0x15,
+	0x92, 0xeb, 0xe0, 0x66, 0x48, 0x15, 0xd6, 0x8a, 0xbd, 0x68, 0xc7, 0xc4, 0xa3, 0x94, 0x3c, 0x84,
+	0xfd, 0xb1, 0xc8, 0x31, 0x4e, 0xe8, 0x78, 0x12, 0x33, 0x1e, 0xe7, 0x2c, 0xcb, 0x98, 0xf2, 0xb7,
+	0x7a, 0x8d, 0xb0, 0x19, 0xb5, 0x2a, 0xea, 0x88, 0x8e, 0x27, 0x23, 0xfe, 0xca, 0xe0, 0xa4, 0x03,
+	0xdb, 0xb2, 0xe4, 0xd5, 0x3b, 0x8e, 0x79, 0xa7, 0x29, 0x4b, 0x3e, 0x4a, 0xc9, 0x63, 0xf0, 0x2b,
+	0x58, 0x69, 0x2a, 0x75, 0xac, 0x59, 0x8e, 0x71, 0xc9, 0xd9, 0x34, 0xe6, 0x94, 0x0b, 0xff, 0xff,
+	0x5e, 0x23, 0x74, 0xa2, 0xb6, 0x2c, 0xf9, 0x69, 0x45, 0xbf, 0x65, 0x39, 0xbe, 0xe3, 0x6c, 0x7a,
+	0x42, 0xb9, 0x20, 0xb7, 0x00, 0x78, 0x99, 0xc7, 0xa6, 0x63, 0xe5, 0x37, 0x4d, 0x51, 0x8f, 0x97,
+	0xb9, 0xb1, 0xaf, 0x2a, 0x3a, 0xa1, 0x7a, 0x7c, 0x16, 0x2b, 0x76, 0x8e, 0xfe, 0xb6, 0xa5, 0x0d,
+	0x72, 0xca, 0xce, 0x91, 0xdc, 0x86, 0x5d, 0xa3, 0x8c, 0x19, 0x4f, 0x71, 0xea, 0xef, 0x18, 0x1e,
+	0x0c, 0x34, 0xaa, 0x90, 0x45, 0x82, 0x31, 0xe6, 0xbb, 0xc6, 0xb2, 0x4d, 0x30, 0x5e, 0xaa, 0x59,
+	0xda, 0x04, 0xe4, 0xa9, 0xef, 0x19, 0xda, 0x35, 0xc0, 0x31, 0x4f, 0xc9, 0x4d, 0xf0, 0x44, 0xa2,
+	0x50, 0x7e, 0x45, 0xa9, 0x7c, 0xe8, 0x39, 0xa1, 0x17, 0x2d, 0x80, 0x60, 0x08, 0x1d, 0xe3, 0xf2,
+	0x05, 0x52, 0xa9, 0x13, 0xa4, 0xba, 0xde, 0xcf, 0x9f, 0x87, 0x1d, 0x3c, 0x81, 0x6b, 0xeb, 0x9a,
+	0xd9, 0x86, 0x0e, 0xe0, 0x92, 0x15, 0xe1, 0xb4, 0x60, 0x12, 0xad, 0xd2, 0x8d, 0xf6, 0x0c, 0x78,
+	0x6c, 0xb1, 0xe0, 0x7b, 0x03, 0xc8, 0x73, 0xc6, 0x99, 0x3a, 0x5b, 0x39, 0x88, 0xbf, 0x6c, 0xb7,
+	0x0d, 0x4d, 0x94, 0x52, 0x48, 0xb3, 0x4f, 0x2f, 0xb2, 0x01, 0x79, 0x0a, 0x6e, 0x41, 0xa5, 0x66,
+	0x34, 0x53, 0xbe, 0xd3, 0x73, 0xc2, 0xdd, 0xe1, 0x61, 0x7f, 0xd3, 0xf9, 0xf5, 0x5f, 0xcf, 0xba,
+	0x7d, 0x63, 0xb3, 0xa3, 0xb9, 0x2c, 0x78, 0x0f, 0x57, 0xd6, 0x48, 0xd2, 0x05, 0xb7, 0x9e, 0x4e,
+	0x7d, 0x96, 0x75, 0x5c, 0xf9, 0x50, 0x9a, 0x6a, 0x34, 0x3e, 0xf6, 0x22, 0x1b, 0x2c, 0xdc, 0x39,
+	0x4b, 0xee, 0x82, 0x0e, 0xec, 0xaf, 0x34, 0x69, 0x27, 0x34, 0xfc, 0xb1, 0x35, 0x1b, 0x78, 0xfa,
+	0x52, 0x88, 0xe2, 0x99, 0x10, 0x32, 0x65, 0x9c, 0x6a, 0x21, 0xc9, 0x47, 0xf0, 0xe6, 0x27, 0x4f,
+	0xee, 0x6c, 0xee, 0x64, 0xfd, 0x5f, 0xd4, 0xbd, 0xfb, 0xcf, 0x3c, 0x5b, 0x37, 0xf8, 0x8f, 0xe4,
+	0x70, 0x79, 0x75, 0x6b, 0xe4, 0xfe, 0x66, 0xf1, 0xc6, 0x7b, 0xe8, 0x3e, 0xb8, 0x58, 0xf2, 0xbc,
+	0x5c, 0x0a, 0xbb, 0x4b, 0xfd, 0x93, 0x70, 0xb3, 0xfc, 0xf7, 0x3b, 0xe8, 0xde, 0xbb, 0x40, 0x66,
+	0x5d, 0xe5, 0xe8, 0xf0, 0xc3, 0x81, 0xd2, 0x42, 0x7e, 0xee, 0x33, 0x31, 0x30, 0x3f, 0x06, 0x73,
+	0xf1, 0x80, 0x71, 0x8d, 0x92, 0xd3, 0xac, 0x48, 0x92, 0x6d, 0xf3, 0x75, 0x7a, 0xf4, 0x6b, 0x00,
+	0x6d, 0x38, 0xb4, 0xa5, 0xb1, 0x04, 0x00, 0x00,
+}
@@ -0,0 +1,207 @@
+// Code generated by protoc-gen-go-drpc. DO NOT EDIT.
+// protoc-gen-go-drpc version: v0.0.35-0.20240709171858-0075ac871661
+// source: rangedloop.proto
+
+package internalpb
+
+import (
+	bytes "bytes"
+	context "context"
+	errors "errors"
+
+	jsonpb "github.com/gogo/protobuf/jsonpb"
+	proto "github.com/gogo/protobuf/proto"
+
+	drpc "storj.io/drpc"
+	drpcerr "storj.io/drpc/drpcerr"
+)
+
+type drpcEncoding_File_rangedloop_proto struct{}
+
+func (drpcEncoding_File_rangedloop_proto) Marshal(msg drpc.Message) ([]byte, error) {
+	return proto.Marshal(msg.(proto.Message))
+}
+
+func (drpcEncoding_File_rangedloop_proto) Unmarshal(buf []byte, msg drpc.Message) error {
+	return proto.Unmarshal(buf, msg.(proto.Message))
+}
+
+func (drpcEncoding_File_rangedloop_proto) JSONMarshal(msg drpc.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	err := new(jsonpb.Marshaler).Marshal(&buf, msg.(proto.Message))
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func (drpcEncoding_File_rangedloop_proto) JSONUnmarshal(buf []byte, msg drpc.Message) error {
+	return jsonpb.Unmarshal(bytes.NewReader(buf), msg.(proto.Message))
+}
+
+type DRPCRangedLoopCoordinatorClient interface {
+	DRPCConn() drpc.Conn
+
+	NextRange(ctx context.Context, in *NextRangeRequest) (*NextRangeResponse, error)
+	RangeHeartbeat(ctx context.Context, in *RangeHeartbeatRequest) (*RangeHeartbeatResponse, error)
+	FinishRange(ctx context.Context, in *FinishRangeRequest) (*FinishRangeResponse, error)
+}
+
+type drpcRangedLoopCoordinatorClient struct {
+	cc drpc.Conn
+}
+
+func NewDRPCRangedLoopCoordinatorClient(cc drpc.Conn) DRPCRangedLoopCoordinatorClient {
+	return &drpcRangedLoopCoordinatorClient{cc}
+}
+
+func (c *drpcRangedLoopCoordinatorClient) DRPCConn() drpc.Conn { return c.cc }
+
+func (c *drpcRangedLoopCoordinatorClient) NextRange(ctx context.Context, in *NextRangeRequest) (*NextRangeResponse, error) {
+	out := new(NextRangeResponse)
+	err := c.cc.Invoke(ctx, "/satellite.rangedloop.RangedLoopCoordinator/NextRange", drpcEncoding_File_rangedloop_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcRangedLoopCoordinatorClient) RangeHeartbeat(ctx context.Context, in *RangeHeartbeatRequest) (*RangeHeartbeatResponse, error) {
+	out := new(RangeHeartbeatResponse)
+	err := c.cc.Invoke(ctx, "/satellite.rangedloop.RangedLoopCoordinator/RangeHeartbeat", drpcEncoding_File_rangedloop_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *drpcRangedLoopCoordinatorClient) FinishRange(ctx context.Context, in *FinishRangeRequest) (*FinishRangeResponse, error) {
+	out := new(FinishRangeResponse)
+	err := c.cc.Invoke(ctx, "/satellite.rangedloop.RangedLoopCoordinator/FinishRange", drpcEncoding_File_rangedloop_proto{}, in, out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+type DRPCRangedLoopCoordinatorServer interface {
+	NextRange(context.Context, *NextRangeRequest) (*NextRangeResponse, error)
+	RangeHeartbeat(context.Context, *RangeHeartbeatRequest) (*RangeHeartbeatResponse, error)
+	FinishRange(context.Context, *FinishRangeRequest) (*FinishRangeResponse, error)
+}
+
+type DRPCRangedLoopCoordinatorUnimplementedServer struct{}
+
+func (s *DRPCRangedLoopCoordinatorUnimplementedServer) NextRange(context.Context, *NextRangeRequest) (*NextRangeResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCRangedLoopCoordinatorUnimplementedServer) RangeHeartbeat(context.Context, *RangeHeartbeatRequest) (*RangeHeartbeatResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+func (s *DRPCRangedLoopCoordinatorUnimplementedServer) FinishRange(context.Context, *FinishRangeRequest) (*FinishRangeResponse, error) {
+	return nil, drpcerr.WithCode(errors.New("Unimplemented"), drpcerr.Unimplemented)
+}
+
+type DRPCRangedLoopCoordinatorDescription struct{}
+
+func (DRPCRangedLoopCoordinatorDescription) NumMethods() int { return 3 }
+
+func (DRPCRangedLoopCoordinatorDescription) Method(n int) (string, drpc.Encoding, drpc.Receiver, interface{}, bool) {
+	switch n {
+	case 0:
+		return "/satellite.rangedloop.RangedLoopCoordinator/NextRange", drpcEncoding_File_rangedloop_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCRangedLoopCoordinatorServer).
+					NextRange(
+						ctx,
+						in1.(*NextRangeRequest),
+					)
+			}, DRPCRangedLoopCoordinatorServer.NextRange, true
+	case 1:
+		return "/satellite.rangedloop.RangedLoopCoordinator/RangeHeartbeat", drpcEncoding_File_rangedloop_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCRangedLoopCoordinatorServer).
+					RangeHeartbeat(
+						ctx,
+						in1.(*RangeHeartbeatRequest),
+					)
+			}, DRPCRangedLoopCoordinatorServer.RangeHeartbeat, true
+	case 2:
+		return "/satellite.rangedloop.RangedLoopCoordinator/FinishRange", drpcEncoding_File_rangedloop_proto{},
+			func(srv interface{}, ctx context.Context, in1, in2 interface{}) (drpc.Message, error) {
+				return srv.(DRPCRangedLoopCoordinatorServer).
+					FinishRange(
+						ctx,
+						in1.(*FinishRangeRequest),
+					)
+			}, DRPCRangedLoopCoordinatorServer.FinishRange, true
+	default:
+		return "", nil, nil, nil, false
+	}
+}
+
+func DRPCRegisterRangedLoopCoordinator(mux drpc.Mux, impl DRPCRangedLoopCoordinatorServer) error {
+	return mux.Register(impl, DRPCRangedLoopCoordinatorDescription{})
+}
+
+type DRPCRangedLoopCoordinator_NextRangeStream interface {
+	drpc.Stream
+	SendAndClose(*NextRangeResponse) error
+}
+
+type drpcRangedLoopCoordinator_NextRangeStream struct {
+	drpc.Stream
+}
+
+func (x *drpcRangedLoopCoordinator_NextRangeStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcRangedLoopCoordinator_NextRangeStream) SendAndClose(m *NextRangeResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_rangedloop_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCRangedLoopCoordinator_RangeHeartbeatStream interface {
+	drpc.Stream
+	SendAndClose(*RangeHeartbeatResponse) error
+}
+
+type drpcRangedLoopCoordinator_RangeHeartbeatStream struct {
+	drpc.Stream
+}
+
+func (x *drpcRangedLoopCoordinator_RangeHeartbeatStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcRangedLoopCoordinator_RangeHeartbeatStream) SendAndClose(m *RangeHeartbeatResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_rangedloop_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
+
+type DRPCRangedLoopCoordinator_FinishRangeStream interface {
+	drpc.Stream
+	SendAndClose(*FinishRangeResponse) error
+}
+
+type drpcRangedLoopCoordinator_FinishRangeStream struct {
+	drpc.Stream
+}
+
+func (x *drpcRangedLoopCoordinator_FinishRangeStream) GetStream() drpc.Stream {
+	return x.Stream
+}
+
+func (x *drpcRangedLoopCoordinator_FinishRangeStream) SendAndClose(m *FinishRangeResponse) error {
+	if err := x.MsgSend(m, drpcEncoding_File_rangedloop_proto{}); err != nil {
+		return err
+	}
+	return x.CloseSend()
+}
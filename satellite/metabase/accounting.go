@@ -26,6 +26,10 @@ type BucketTally struct {
 	TotalBytes    int64
 
 	MetadataSize int64
+
+	// Prefixes contains the tallies of the bucket grouped by prefix, when
+	// requested with CollectBucketTallies.PrefixDepth.
+	Prefixes []PrefixTally
 }
 
 // CollectBucketTallies contains arguments necessary for looping through objects in metabase.
@@ -35,6 +39,14 @@ type CollectBucketTallies struct {
 	AsOfSystemTime     time.Time
 	AsOfSystemInterval time.Duration
 	Now                time.Time
+
+	// PrefixDepth is the number of leading path components used for grouping
+	// the tallies by prefix, zero disables collecting prefix tallies.
+	PrefixDepth int
+	// MaxPrefixes limits the number of prefix tallies of a bucket. Buckets
+	// with more prefixes are grouped by fewer path components. Zero means
+	// no limit.
+	MaxPrefixes int
 }
 
 // Verify verifies CollectBucketTallies request fields.
@@ -45,6 +57,12 @@ func (opts *CollectBucketTallies) Verify() error {
 	if opts.To.ProjectID == opts.From.ProjectID && opts.To.BucketName < opts.From.BucketName {
 		return ErrInvalidRequest.New("bucket name To is before bucket name From")
 	}
+	if opts.PrefixDepth < 0 {
+		return ErrInvalidRequest.New("PrefixDepth is negative")
+	}
+	if opts.MaxPrefixes < 0 {
+		return ErrInvalidRequest.New("MaxPrefixes is negative")
+	}
 	return nil
 }
 
@@ -73,36 +91,64 @@ func (db *DB) CollectBucketTallies(ctx context.Context, opts CollectBucketTallie
 		return a.BucketLocation.Compare(b.BucketLocation)
 	})
 
+	if opts.MaxPrefixes > 0 {
+		for i := range result {
+			result[i].Prefixes = limitPrefixTallies(result[i].Prefixes, opts.PrefixDepth, opts.MaxPrefixes)
+		}
+	}
+
 	return result, nil
 }
 
 // CollectBucketTallies collect limited bucket tallies from given bucket locations.
 func (p *PostgresAdapter) CollectBucketTallies(ctx context.Context, opts CollectBucketTallies) (result []BucketTally, err error) {
+	args := []any{opts.From.ProjectID, opts.From.BucketName, opts.To.ProjectID, opts.To.BucketName, opts.Now}
+
+	// the object key is converted to text, because regular expressions are not
+	// supported on bytes. The escape format keeps the delimiter as is.
+	prefixColumn, groupBy := "", "project_id, bucket_name"
+	if opts.PrefixDepth > 0 {
+		prefixColumn = "decode(substring(encode(object_key, 'escape') from $6), 'escape')"
+		groupBy += ", " + prefixColumn
+		prefixColumn = ", " + prefixColumn
+		args = append(args, prefixPattern(opts.PrefixDepth))
+	}
+
 	err = withRows(p.db.QueryContext(ctx, `
 			SELECT
 				project_id, bucket_name,
 				SUM(total_encrypted_size), SUM(segment_count), COALESCE(SUM(length(encrypted_metadata)), 0),
 				count(*), count(*) FILTER (WHERE status = `+statusPending+`)
+				`+prefixColumn+`
 			FROM objects
 			`+LimitedAsOfSystemTime(p.impl, time.Now(), opts.AsOfSystemTime, opts.AsOfSystemInterval)+`
 			WHERE (project_id, bucket_name) BETWEEN ($1, $2) AND ($3, $4) AND
 			(expires_at IS NULL OR expires_at > $5)
-			GROUP BY (project_id, bucket_name)
-			ORDER BY (project_id, bucket_name) ASC
-		`, opts.From.ProjectID, opts.From.BucketName, opts.To.ProjectID, opts.To.BucketName, opts.Now))(func(rows tagsql.Rows) error {
+			GROUP BY `+groupBy+`
+			ORDER BY `+groupBy+` ASC
+		`, args...))(func(rows tagsql.Rows) error {
 		for rows.Next() {
 			var bucketTally BucketTally
+			var prefix []byte
 
-			if err = rows.Scan(
+			dest := []any{
 				&bucketTally.ProjectID, &bucketTally.BucketName,
 				&bucketTally.TotalBytes, &bucketTally.TotalSegments,
 				&bucketTally.MetadataSize, &bucketTally.ObjectCount,
 				&bucketTally.PendingObjectCount,
-			); err != nil {
+			}
+			if opts.PrefixDepth > 0 {
+				dest = append(dest, &prefix)
+			}
+			if err = rows.Scan(dest...); err != nil {
 				return Error.New("unable to query bucket tally: %w", err)
 			}
 
-			result = append(result, bucketTally)
+			if opts.PrefixDepth > 0 {
+				result = addPrefixTally(result, bucketTally, ObjectKey(prefix), opts.Now)
+			} else {
+				result = append(result, bucketTally)
+			}
 		}
 
 		return nil
@@ -125,35 +171,65 @@ func (s *SpannerAdapter) CollectBucketTallies(ctx context.Context, opts CollectB
 		return nil, Error.Wrap(err)
 	}
 
+	params := map[string]any{
+		"from_project_id":  opts.From.ProjectID,
+		"from_bucket_name": opts.From.BucketName,
+		"to_project_id":    opts.To.ProjectID,
+		"to_bucket_name":   opts.To.BucketName,
+		"when":             opts.Now,
+	}
+
+	prefixColumn, groupBy := "", "project_id, bucket_name"
+	if opts.PrefixDepth > 0 {
+		prefixColumn = ", REGEXP_EXTRACT(object_key, @prefix_pattern) AS prefix"
+		groupBy += ", prefix"
+		params["prefix_pattern"] = []byte(prefixPattern(opts.PrefixDepth))
+	}
+
 	txn := s.client.Single().WithTimestampBound(spannerutil.MaxStalenessFromAOSI(opts.AsOfSystemInterval))
-	return spannerutil.CollectRows(txn.QueryWithOptions(ctx, spanner.Statement{
+	err = txn.QueryWithOptions(ctx, spanner.Statement{
 		SQL: `
 			SELECT
 				project_id, bucket_name,
 				SUM(total_encrypted_size), SUM(segment_count), COALESCE(SUM(length(encrypted_metadata)), 0),
 				count(*) AS total_objects_count, COUNTIF(status = ` + statusPending + `) AS pending_objects_count
+				` + prefixColumn + `
 			FROM objects
 			WHERE ` + fromTuple + `
 				AND ` + toTuple + `
 				AND (expires_at IS NULL OR expires_at > @when)
-			GROUP BY project_id, bucket_name
-			ORDER BY project_id ASC, bucket_name ASC
+			GROUP BY ` + groupBy + `
+			ORDER BY ` + groupBy + `
 		`,
-		Params: map[string]any{
-			"from_project_id":  opts.From.ProjectID,
-			"from_bucket_name": opts.From.BucketName,
-			"to_project_id":    opts.To.ProjectID,
-			"to_bucket_name":   opts.To.BucketName,
-			"when":             opts.Now,
-		},
+		Params: params,
 	}, spanner.QueryOptions{
 		Priority: spannerpb.RequestOptions_PRIORITY_LOW,
-	}), func(row *spanner.Row, bucketTally *BucketTally) error {
-		return row.Columns(
+	}).Do(func(row *spanner.Row) error {
+		var bucketTally BucketTally
+		var prefix []byte
+
+		dest := []any{
 			&bucketTally.ProjectID, &bucketTally.BucketName,
 			&bucketTally.TotalBytes, &bucketTally.TotalSegments,
 			&bucketTally.MetadataSize, &bucketTally.ObjectCount,
 			&bucketTally.PendingObjectCount,
-		)
+		}
+		if opts.PrefixDepth > 0 {
+			dest = append(dest, &prefix)
+		}
+		if err := row.Columns(dest...); err != nil {
+			return err
+		}
+
+		if opts.PrefixDepth > 0 {
+			result = addPrefixTally(result, bucketTally, ObjectKey(prefix), opts.Now)
+		} else {
+			result = append(result, bucketTally)
+		}
+		return nil
 	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return result, nil
 }
@@ -52,6 +52,9 @@ type Adapter interface {
 	WithTx(ctx context.Context, f func(context.Context, TransactionAdapter) error) error
 
 	CollectBucketTallies(ctx context.Context, opts CollectBucketTallies) (result []BucketTally, err error)
+	ReplacePrefixTallies(ctx context.Context, opts ReplacePrefixTallies) (err error)
+	GetPrefixTallies(ctx context.Context, opts GetPrefixTallies) (result []PrefixTally, err error)
+	DeleteAllPrefixTallies(ctx context.Context) (err error)
 
 	GetSegmentByPosition(ctx context.Context, opts GetSegmentByPosition) (segment Segment, aliasPieces AliasPieces, err error)
 	GetObjectExactVersion(ctx context.Context, opts GetObjectExactVersion) (_ Object, err error)
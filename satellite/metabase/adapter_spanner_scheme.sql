@@ -48,3 +48,14 @@ CREATE TABLE IF NOT EXISTS node_aliases
 ) PRIMARY KEY (node_id);
 
 CREATE UNIQUE INDEX IF NOT EXISTS node_aliases_node_alias_key ON node_aliases(node_alias);
+
+CREATE TABLE IF NOT EXISTS prefix_tallies
+(
+    project_id           BYTES(16)   NOT NULL,
+    bucket_name          STRING(MAX) NOT NULL,
+    prefix               BYTES(MAX)  NOT NULL,
+    object_count         INT64       NOT NULL DEFAULT (0),
+    total_encrypted_size INT64       NOT NULL DEFAULT (0),
+    segment_count        INT64       NOT NULL DEFAULT (0),
+    updated_at           TIMESTAMP   NOT NULL DEFAULT (CURRENT_TIMESTAMP()),
+) PRIMARY KEY (project_id, bucket_name, prefix);
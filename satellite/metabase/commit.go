@@ -961,6 +961,9 @@ type CommitObject struct {
 
 	// Versioned indicates whether an object is allowed to have multiple versions.
 	Versioned bool
+
+	// Condition must hold for the latest committed object at the location.
+	Condition WriteCondition
 }
 
 // Verify verifies request fields.
@@ -980,7 +983,7 @@ func (c *CommitObject) Verify() error {
 			return ErrInvalidRequest.New("EncryptedMetadataNonce and EncryptedMetadataEncryptedKey must be set if EncryptedMetadata is set")
 		}
 	}
-	return nil
+	return c.Condition.Verify()
 }
 
 // WithTx provides a TransactionAdapter for the context of a database transaction.
@@ -1052,6 +1055,7 @@ func (db *DB) CommitObject(ctx context.Context, opts CommitObject) (object Objec
 			Location:       opts.Location(),
 			Versioned:      opts.Versioned,
 			DisallowDelete: opts.DisallowDelete,
+			Condition:      opts.Condition,
 		}, adapter)
 		if err != nil {
 			return err
@@ -1356,6 +1360,9 @@ type CommitInlineObject struct {
 
 	// Versioned indicates whether an object is allowed to have multiple versions.
 	Versioned bool
+
+	// Condition must hold for the latest committed object at the location.
+	Condition WriteCondition
 }
 
 // Verify verifies reqest fields.
@@ -1391,7 +1398,7 @@ func (c *CommitInlineObject) Verify() error {
 		}
 	}
 
-	return nil
+	return c.Condition.Verify()
 }
 
 // CommitInlineObject adds full inline object to the database. If another committed object is under target location
@@ -1409,6 +1416,7 @@ func (db *DB) CommitInlineObject(ctx context.Context, opts CommitInlineObject) (
 			Location:       opts.Location(),
 			Versioned:      opts.Versioned,
 			DisallowDelete: opts.DisallowDelete,
+			Condition:      opts.Condition,
 		}, adapter)
 		if err != nil {
 			return err
@@ -0,0 +1,144 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"cloud.google.com/go/spanner"
+	"google.golang.org/api/iterator"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/shared/dbutil/spannerutil"
+)
+
+// WriteCondition is a precondition on the latest committed version at the target location.
+// It is checked in the same transaction as the write, which makes it suitable for
+// optimistic concurrency control.
+type WriteCondition struct {
+	// IfNoneMatch requires that no committed object exists at the location.
+	// A delete marker as the latest version is treated as a missing object.
+	IfNoneMatch bool
+	// IfMatch requires that the latest committed object at the location has
+	// the specified version and stream ID suffix.
+	IfMatch StreamVersionID
+}
+
+// IsZero returns whether the condition doesn't restrict the write.
+func (c WriteCondition) IsZero() bool {
+	return !c.IfNoneMatch && c.IfMatch.IsZero()
+}
+
+// Verify verifies condition fields.
+func (c WriteCondition) Verify() error {
+	if c.IfNoneMatch && !c.IfMatch.IsZero() {
+		return ErrInvalidRequest.New("IfNoneMatch and IfMatch cannot be used together")
+	}
+	if !c.IfMatch.IsZero() && c.IfMatch.Version() <= 0 {
+		return ErrInvalidRequest.New("IfMatch has invalid version: %v", c.IfMatch.Version())
+	}
+	return nil
+}
+
+// check verifies the condition against the latest committed object at loc.
+func (c WriteCondition) check(ctx context.Context, adapter precommitTransactionAdapter, loc ObjectLocation) (err error) {
+	if c.IsZero() {
+		return nil
+	}
+	defer mon.Task()(&ctx)(&err)
+
+	latest, found, err := adapter.precommitQueryLatestCommitted(ctx, loc)
+	if err != nil {
+		return err
+	}
+
+	if c.IfNoneMatch && found {
+		return ErrFailedPrecondition.New("object already exists")
+	}
+
+	if !c.IfMatch.IsZero() {
+		if !found {
+			return ErrFailedPrecondition.New("object does not exist")
+		}
+		if latest.Version != c.IfMatch.Version() || latest.StreamIDSuffix() != c.IfMatch.StreamIDSuffix() {
+			return ErrFailedPrecondition.New("latest object version does not match")
+		}
+	}
+
+	return nil
+}
+
+// precommitQueryLatestCommitted returns the stream of the latest non-pending object at loc.
+// found is false when there's no such object or when the latest version is a delete marker.
+func (ptx *postgresTransactionAdapter) precommitQueryLatestCommitted(ctx context.Context, loc ObjectLocation) (latest ObjectStream, found bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var status ObjectStatus
+	latest.ProjectID, latest.BucketName, latest.ObjectKey = loc.ProjectID, loc.BucketName, loc.ObjectKey
+	err = ptx.tx.QueryRowContext(ctx, `
+		SELECT version, stream_id, status
+		FROM objects
+		WHERE
+			(project_id, bucket_name, object_key) = ($1, $2, $3) AND
+			status <> `+statusPending+` AND
+			(expires_at IS NULL OR expires_at > now())
+		ORDER BY version DESC
+		LIMIT 1
+	`, loc.ProjectID, loc.BucketName, loc.ObjectKey).Scan(&latest.Version, &latest.StreamID, &status)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ObjectStream{}, false, nil
+	}
+	if err != nil {
+		return ObjectStream{}, false, Error.Wrap(err)
+	}
+
+	return latest, !status.IsDeleteMarker(), nil
+}
+
+func (stx *spannerTransactionAdapter) precommitQueryLatestCommitted(ctx context.Context, loc ObjectLocation) (latest ObjectStream, found bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	type latestRow struct {
+		version  Version
+		streamID uuid.UUID
+		status   ObjectStatus
+	}
+
+	row, err := spannerutil.CollectRow(stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT version, stream_id, status
+			FROM objects
+			WHERE
+				(project_id, bucket_name, object_key) = (@project_id, @bucket_name, @object_key) AND
+				status <> ` + statusPending + ` AND
+				(expires_at IS NULL OR expires_at > CURRENT_TIMESTAMP())
+			ORDER BY version DESC
+			LIMIT 1
+		`,
+		Params: map[string]interface{}{
+			"project_id":  loc.ProjectID,
+			"bucket_name": loc.BucketName,
+			"object_key":  loc.ObjectKey,
+		},
+	}), func(row *spanner.Row, item *latestRow) error {
+		return Error.Wrap(row.Columns(&item.version, &item.streamID, &item.status))
+	})
+	if err != nil {
+		if errors.Is(err, iterator.Done) {
+			return ObjectStream{}, false, nil
+		}
+		return ObjectStream{}, false, Error.Wrap(err)
+	}
+
+	latest = ObjectStream{
+		ProjectID:  loc.ProjectID,
+		BucketName: loc.BucketName,
+		ObjectKey:  loc.ObjectKey,
+		Version:    row.version,
+		StreamID:   row.streamID,
+	}
+	return latest, !row.status.IsDeleteMarker(), nil
+}
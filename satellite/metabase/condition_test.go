@@ -0,0 +1,282 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestCommitObjectWithCondition(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		beginNext := func(t *testing.T, obj metabase.ObjectStream) metabase.ObjectStream {
+			next := obj
+			next.StreamID = testrand.UUID()
+			next.Version = obj.Version + 1
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: next,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+			}.Check(ctx, t, db)
+			return next
+		}
+
+		t.Run("invalid condition", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: obj,
+					Condition: metabase.WriteCondition{
+						IfNoneMatch: true,
+						IfMatch:     metabase.NewStreamVersionID(1, testrand.UUID()),
+					},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "IfNoneMatch and IfMatch cannot be used together",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("if none match", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+			}.Check(ctx, t, db)
+			first := metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: obj,
+					Condition:    metabase.WriteCondition{IfNoneMatch: true},
+				},
+			}.Check(ctx, t, db)
+
+			next := beginNext(t, obj)
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: next,
+					Condition:    metabase.WriteCondition{IfNoneMatch: true},
+				},
+				ErrClass: &metabase.ErrFailedPrecondition,
+				ErrText:  "object already exists",
+			}.Check(ctx, t, db)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 2)
+			require.Equal(t, first.StreamID, objects[0].StreamID)
+			require.Equal(t, metabase.CommittedUnversioned, objects[0].Status)
+			require.Equal(t, metabase.Pending, objects[1].Status)
+		})
+
+		t.Run("if none match with delete marker", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.CreateObjectVersioned(ctx, t, db, obj, 0)
+
+			_, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: obj.Location(),
+				Versioned:      true,
+			})
+			require.NoError(t, err)
+
+			next := beginNext(t, obj)
+			next.Version++
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: next,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: next,
+					Versioned:    true,
+					Condition:    metabase.WriteCondition{IfNoneMatch: true},
+				},
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("if match", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			first := metabasetest.CreateObject(ctx, t, db, obj, 0)
+
+			next := beginNext(t, obj)
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: next,
+					Condition: metabase.WriteCondition{
+						IfMatch: metabase.NewStreamVersionID(first.Version, testrand.UUID()),
+					},
+				},
+				ErrClass: &metabase.ErrFailedPrecondition,
+				ErrText:  "latest object version does not match",
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: next,
+					Condition: metabase.WriteCondition{
+						IfMatch: first.StreamVersionID(),
+					},
+				},
+			}.Check(ctx, t, db)
+
+			objects, err := db.TestingAllObjects(ctx)
+			require.NoError(t, err)
+			require.Len(t, objects, 1)
+			require.Equal(t, next.StreamID, objects[0].StreamID)
+		})
+
+		t.Run("if match missing object", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.BeginObjectExactVersion{
+				Opts: metabase.BeginObjectExactVersion{
+					ObjectStream: obj,
+					Encryption:   metabasetest.DefaultEncryption,
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.CommitObject{
+				Opts: metabase.CommitObject{
+					ObjectStream: obj,
+					Condition: metabase.WriteCondition{
+						IfMatch: metabase.NewStreamVersionID(1, testrand.UUID()),
+					},
+				},
+				ErrClass: &metabase.ErrFailedPrecondition,
+				ErrText:  "object does not exist",
+			}.Check(ctx, t, db)
+		})
+	})
+}
+
+func TestFinishCopyObjectWithCondition(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+		source := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 0)
+
+		target := metabasetest.RandObjectStream()
+		target.ProjectID = source.ProjectID
+		target.BucketName = source.BucketName
+		metabasetest.CreateObject(ctx, t, db, target, 0)
+
+		metabasetest.FinishCopyObject{
+			Opts: metabase.FinishCopyObject{
+				ObjectStream:          source.ObjectStream,
+				NewBucket:             target.BucketName,
+				NewEncryptedObjectKey: target.ObjectKey,
+				NewStreamID:           testrand.UUID(),
+				Condition:             metabase.WriteCondition{IfNoneMatch: true},
+			},
+			ErrClass: &metabase.ErrFailedPrecondition,
+			ErrText:  "object already exists",
+		}.Check(ctx, t, db)
+
+		objects, err := db.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 2)
+	})
+}
+
+func TestDeleteObjectLastCommittedWithCondition(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("if none match is not supported", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj := metabasetest.RandObjectStream()
+			metabasetest.DeleteObjectLastCommitted{
+				Opts: metabase.DeleteObjectLastCommitted{
+					ObjectLocation: obj.Location(),
+					Condition:      metabase.WriteCondition{IfNoneMatch: true},
+				},
+				ErrClass: &metabase.ErrInvalidRequest,
+				ErrText:  "IfNoneMatch is not supported for deletes",
+			}.Check(ctx, t, db)
+		})
+
+		t.Run("unversioned", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 0)
+
+			metabasetest.DeleteObjectLastCommitted{
+				Opts: metabase.DeleteObjectLastCommitted{
+					ObjectLocation: object.Location(),
+					Condition: metabase.WriteCondition{
+						IfMatch: metabase.NewStreamVersionID(object.Version+1, object.StreamID),
+					},
+				},
+				ErrClass: &metabase.ErrFailedPrecondition,
+				ErrText:  "latest object version does not match",
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{
+				Objects: []metabase.RawObject{metabase.RawObject(object)},
+			}.Check(ctx, t, db)
+
+			metabasetest.DeleteObjectLastCommitted{
+				Opts: metabase.DeleteObjectLastCommitted{
+					ObjectLocation: object.Location(),
+					Condition: metabase.WriteCondition{
+						IfMatch: object.StreamVersionID(),
+					},
+				},
+				Result: metabase.DeleteObjectResult{
+					Removed: []metabase.Object{object},
+				},
+			}.Check(ctx, t, db)
+
+			metabasetest.Verify{}.Check(ctx, t, db)
+		})
+
+		t.Run("versioned", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			object := metabasetest.CreateObjectVersioned(ctx, t, db, metabasetest.RandObjectStream(), 0)
+
+			result, err := db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: object.Location(),
+				Versioned:      true,
+				Condition: metabase.WriteCondition{
+					IfMatch: object.StreamVersionID(),
+				},
+			})
+			require.NoError(t, err)
+			require.Empty(t, result.Removed)
+			require.Len(t, result.Markers, 1)
+			require.Equal(t, object.Version+1, result.Markers[0].Version)
+			require.Equal(t, metabase.DeleteMarkerVersioned, result.Markers[0].Status)
+
+			// the latest version is a delete marker now
+			_, err = db.DeleteObjectLastCommitted(ctx, metabase.DeleteObjectLastCommitted{
+				ObjectLocation: object.Location(),
+				Versioned:      true,
+				Condition: metabase.WriteCondition{
+					IfMatch: object.StreamVersionID(),
+				},
+			})
+			require.True(t, metabase.ErrFailedPrecondition.Has(err))
+		})
+	})
+}
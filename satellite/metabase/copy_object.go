@@ -72,6 +72,9 @@ type FinishCopyObject struct {
 	// LegalHold indicates whether the object copy is under legal hold.
 	LegalHold bool
 
+	// Condition must hold for the latest committed object at the new location.
+	Condition WriteCondition
+
 	// VerifyLimits holds a callback by which the caller can interrupt the copy
 	// if it turns out completing the copy would exceed a limit.
 	// It will be called only once.
@@ -123,6 +126,10 @@ func (finishCopy FinishCopyObject) Verify() error {
 		return ErrObjectStatus.New(noLockOnUnversionedErrMsg)
 	}
 
+	if err := finishCopy.Condition.Verify(); err != nil {
+		return err
+	}
+
 	return ErrInvalidRequest.Wrap(finishCopy.Retention.Verify())
 }
 
@@ -220,6 +227,7 @@ func (db *DB) FinishCopyObject(ctx context.Context, opts FinishCopyObject) (obje
 			Location:       opts.NewLocation(),
 			Versioned:      opts.NewVersioned,
 			DisallowDelete: opts.NewDisallowDelete,
+			Condition:      opts.Condition,
 		}, adapter)
 		if err != nil {
 			return err
@@ -645,6 +645,29 @@ func (p *PostgresAdapter) PostgresMigration() *migrate.Migration {
 					`DROP TABLE IF EXISTS segment_copies`,
 				},
 			},
+			{
+				DB:          &db,
+				Description: "add prefix_tallies table",
+				Version:     21,
+				Action: migrate.SQL{
+					`CREATE TABLE prefix_tallies (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						prefix      BYTEA NOT NULL,
+
+						object_count         INT8 NOT NULL default 0,
+						total_encrypted_size INT8 NOT NULL default 0,
+						segment_count        INT8 NOT NULL default 0,
+
+						updated_at TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name, prefix)
+					)`,
+					`
+					COMMENT ON TABLE  prefix_tallies        is 'prefix_tallies contains the usage of a bucket grouped by the leading components of the object key. It is maintained by the tally service.';
+					COMMENT ON COLUMN prefix_tallies.prefix is 'prefix is the encrypted key prefix including the trailing delimiter.';
+				`},
+			},
 		},
 	}
 }
@@ -670,6 +693,22 @@ func (s *SpannerAdapter) SpannerMigration() *migrate.Migration {
 				Version:     1,
 				Action:      migrate.SQL(firstStepDDL),
 			},
+			{
+				DB:          &db,
+				Description: "add prefix_tallies table",
+				Version:     2,
+				Action: migrate.SQL{
+					`CREATE TABLE IF NOT EXISTS prefix_tallies (
+						project_id           BYTES(16)   NOT NULL,
+						bucket_name          STRING(MAX) NOT NULL,
+						prefix               BYTES(MAX)  NOT NULL,
+						object_count         INT64       NOT NULL DEFAULT (0),
+						total_encrypted_size INT64       NOT NULL DEFAULT (0),
+						segment_count        INT64       NOT NULL DEFAULT (0),
+						updated_at           TIMESTAMP   NOT NULL DEFAULT (CURRENT_TIMESTAMP()),
+					) PRIMARY KEY (project_id, bucket_name, prefix)`,
+				},
+			},
 		},
 	}
 }
@@ -590,6 +590,10 @@ type DeleteObjectLastCommitted struct {
 	Suspended bool
 
 	ObjectLock ObjectLockDeleteOptions
+
+	// Condition must hold for the latest committed object at the location.
+	// Only IfMatch is supported for deletes.
+	Condition WriteCondition
 }
 
 // Verify delete object last committed fields.
@@ -597,6 +601,12 @@ func (obj *DeleteObjectLastCommitted) Verify() error {
 	if obj.Versioned && obj.Suspended {
 		return ErrInvalidRequest.New("versioned and suspended cannot be enabled at the same time")
 	}
+	if obj.Condition.IfNoneMatch {
+		return ErrInvalidRequest.New("IfNoneMatch is not supported for deletes")
+	}
+	if err := obj.Condition.Verify(); err != nil {
+		return err
+	}
 	return obj.ObjectLocation.Verify()
 }
 
@@ -610,6 +620,10 @@ func (db *DB) DeleteObjectLastCommitted(
 		return DeleteObjectResult{}, err
 	}
 
+	if !opts.Condition.IsZero() {
+		return db.deleteObjectLastCommittedWithCondition(ctx, opts)
+	}
+
 	if opts.Suspended {
 		deleterMarkerStreamID, err := generateDeleteMarkerStreamID()
 		if err != nil {
@@ -641,6 +655,152 @@ func (db *DB) DeleteObjectLastCommitted(
 	return result, nil
 }
 
+// deleteObjectLastCommittedWithCondition deletes an object last committed version
+// in a single transaction with checking opts.Condition.
+func (db *DB) deleteObjectLastCommittedWithCondition(ctx context.Context, opts DeleteObjectLastCommitted) (result DeleteObjectResult, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var markerStreamID uuid.UUID
+	if opts.Versioned || opts.Suspended {
+		markerStreamID, err = generateDeleteMarkerStreamID()
+		if err != nil {
+			return DeleteObjectResult{}, Error.Wrap(err)
+		}
+	}
+
+	var precommit PrecommitConstraintWithNonPendingResult
+	err = db.ChooseAdapter(opts.ProjectID).WithTx(ctx, func(ctx context.Context, adapter TransactionAdapter) error {
+		result = DeleteObjectResult{}
+		precommit = PrecommitConstraintWithNonPendingResult{}
+
+		if err := opts.Condition.check(ctx, adapter, opts.ObjectLocation); err != nil {
+			return err
+		}
+
+		if opts.Versioned {
+			highest, err := adapter.precommitQueryHighest(ctx, opts.ObjectLocation)
+			if err != nil {
+				return err
+			}
+
+			marker, err := adapter.insertDeleteMarker(ctx, opts.ObjectLocation, highest+1, markerStreamID, DeleteMarkerVersioned)
+			if err != nil {
+				return err
+			}
+			result.Markers = []Object{marker}
+			return nil
+		}
+
+		precommit, err = adapter.PrecommitDeleteUnversionedWithNonPending(ctx, PrecommitDeleteUnversionedWithNonPending{
+			ObjectLocation: opts.ObjectLocation,
+			ObjectLock:     opts.ObjectLock,
+		})
+		if err != nil {
+			return err
+		}
+		result.Removed = precommit.Deleted
+		result.DeletedSegmentCount = precommit.DeletedSegmentCount
+
+		if opts.Suspended {
+			marker, err := adapter.insertDeleteMarker(ctx, opts.ObjectLocation, precommit.HighestVersion+1, markerStreamID, DeleteMarkerUnversioned)
+			if err != nil {
+				return err
+			}
+			result.Markers = []Object{marker}
+		}
+		return nil
+	})
+	if err != nil {
+		if ErrFailedPrecondition.Has(err) || ErrObjectLock.Has(err) {
+			return DeleteObjectResult{}, err
+		}
+		return DeleteObjectResult{}, Error.Wrap(err)
+	}
+
+	precommit.submitMetrics()
+	return result, nil
+}
+
+// insertDeleteMarker inserts a delete marker with the specified version and status.
+func (ptx *postgresTransactionAdapter) insertDeleteMarker(ctx context.Context, loc ObjectLocation, version Version, streamID uuid.UUID, status ObjectStatus) (marker Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	marker = Object{
+		ObjectStream: ObjectStream{
+			ProjectID:  loc.ProjectID,
+			BucketName: loc.BucketName,
+			ObjectKey:  loc.ObjectKey,
+			StreamID:   streamID,
+		},
+		Status: status,
+	}
+
+	err = ptx.tx.QueryRowContext(ctx, `
+		INSERT INTO objects (
+			project_id, bucket_name, object_key, version, stream_id,
+			status,
+			zombie_deletion_deadline
+		)
+		SELECT
+			$1, $2, $3, $4, $5,
+			$6,
+			NULL
+		RETURNING
+			version,
+			created_at
+	`, loc.ProjectID, loc.BucketName, loc.ObjectKey, version, streamID, status).Scan(&marker.Version, &marker.CreatedAt)
+	if err != nil {
+		return Object{}, Error.New("could not insert deletion marker: %w", err)
+	}
+	return marker, nil
+}
+
+// insertDeleteMarker inserts a delete marker with the specified version and status.
+func (stx *spannerTransactionAdapter) insertDeleteMarker(ctx context.Context, loc ObjectLocation, version Version, streamID uuid.UUID, status ObjectStatus) (marker Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	marker = Object{
+		ObjectStream: ObjectStream{
+			ProjectID:  loc.ProjectID,
+			BucketName: loc.BucketName,
+			ObjectKey:  loc.ObjectKey,
+			StreamID:   streamID,
+		},
+		Status: status,
+	}
+
+	err = stx.tx.Query(ctx, spanner.Statement{
+		SQL: `
+			INSERT INTO objects (
+				project_id, bucket_name, object_key, version, stream_id,
+				status,
+				zombie_deletion_deadline
+			) VALUES (
+				@project_id, @bucket_name, @object_key, @version, @marker,
+				@status,
+				NULL
+			)
+			THEN RETURN
+				version,
+				created_at
+		`,
+		Params: map[string]interface{}{
+			"project_id":  loc.ProjectID,
+			"bucket_name": loc.BucketName,
+			"object_key":  loc.ObjectKey,
+			"version":     version,
+			"marker":      streamID,
+			"status":      status,
+		},
+	}).Do(func(row *spanner.Row) error {
+		return Error.Wrap(row.Columns(&marker.Version, &marker.CreatedAt))
+	})
+	if err != nil {
+		return Object{}, Error.New("could not insert deletion marker: %w", err)
+	}
+	return marker, nil
+}
+
 // DeleteObjectLastCommittedPlain deletes an object last committed version when
 // opts.Suspended and opts.Versioned are both false.
 func (p *PostgresAdapter) DeleteObjectLastCommittedPlain(ctx context.Context, opts DeleteObjectLastCommitted) (result DeleteObjectResult, err error) {
@@ -922,6 +1082,7 @@ func (s *SpannerAdapter) deleteObjectLastCommittedPlainUsingObjectLock(ctx conte
 
 type deleteTransactionAdapter interface {
 	PrecommitDeleteUnversionedWithNonPending(ctx context.Context, opts PrecommitDeleteUnversionedWithNonPending) (result PrecommitConstraintWithNonPendingResult, err error)
+	insertDeleteMarker(ctx context.Context, loc ObjectLocation, version Version, streamID uuid.UUID, status ObjectStatus) (marker Object, err error)
 }
 
 // PrecommitDeleteUnversionedWithNonPending contains arguments necessary for deleting an unversioned object
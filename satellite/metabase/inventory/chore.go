@@ -0,0 +1,246 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"context"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/uplink"
+)
+
+var (
+	// Error defines the inventory chore errors class.
+	Error = errs.Class("inventory")
+	mon   = monkit.Package()
+)
+
+// Objects is the subset of metabase.DB needed to generate inventory reports.
+type Objects interface {
+	IterateObjectsAllVersionsWithStatus(ctx context.Context, opts metabase.IterateObjectsWithStatus, fn func(context.Context, metabase.ObjectsIterator) error) error
+}
+
+// Uploader writes the report as a new object into the destination bucket.
+type Uploader interface {
+	Upload(ctx context.Context, destination Destination, key string, write func(io.Writer) error) error
+}
+
+// DB stores when the inventory reports were generated, so the reports are not
+// regenerated when the satellite restarts.
+//
+// architecture: Database
+type DB interface {
+	// GetGenerated returns when the reports of the buckets were generated for the last time.
+	GetGenerated(ctx context.Context) (map[metabase.BucketLocation]time.Time, error)
+	// SetGenerated records when the report of the bucket was generated.
+	SetGenerated(ctx context.Context, bucket metabase.BucketLocation, generatedAt time.Time) error
+}
+
+// Chore implements the bucket inventory chore.
+//
+// architecture: Chore
+type Chore struct {
+	log         *zap.Logger
+	config      Config
+	db          DB
+	objects     Objects
+	uploader    Uploader
+	definitions []Definition
+
+	nowFn func() time.Time
+	Loop  *sync2.Cycle
+}
+
+// NewChore creates a new instance of the inventory chore.
+func NewChore(log *zap.Logger, config Config, db DB, objects Objects, uploader Uploader) (*Chore, error) {
+	var definitions []Definition
+	if config.Enabled {
+		var err error
+		definitions, err = LoadDefinitions(config.DefinitionsFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Chore{
+		log:         log,
+		config:      config,
+		db:          db,
+		objects:     objects,
+		uploader:    uploader,
+		definitions: definitions,
+
+		nowFn: time.Now,
+		Loop:  sync2.NewCycle(config.Interval),
+	}, nil
+}
+
+// Run starts the inventory chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !chore.config.Enabled {
+		return nil
+	}
+
+	return chore.Loop.Run(ctx, chore.RunOnce)
+}
+
+// Close stops the inventory chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
+
+// SetNow allows tests to have the chore act as if the current time is whatever they want.
+func (chore *Chore) SetNow(nowFn func() time.Time) {
+	chore.nowFn = nowFn
+}
+
+// SetDefinitions replaces the inventory definitions.
+func (chore *Chore) SetDefinitions(definitions []Definition) {
+	chore.definitions = definitions
+}
+
+// RunOnce generates all reports which are due.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(chore.definitions) == 0 {
+		return nil
+	}
+
+	generated, err := chore.db.GetGenerated(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, definition := range chore.definitions {
+		bucket := definition.Location()
+
+		now := chore.nowFn()
+		if last, ok := generated[bucket]; ok && now.Sub(last) < definition.Frequency {
+			continue
+		}
+
+		count, err := chore.generate(ctx, definition, now)
+		if err != nil {
+			// we don't want to stop generating other reports
+			chore.log.Error("generating inventory report failed",
+				zap.Stringer("Project ID", definition.ProjectID),
+				zap.String("Bucket", definition.Bucket),
+				zap.Error(err))
+			mon.Event("inventory_report_failed")
+			continue
+		}
+
+		if err := chore.db.SetGenerated(ctx, bucket, now); err != nil {
+			// the report will be generated again in the next run
+			chore.log.Error("recording inventory report failed",
+				zap.Stringer("Project ID", definition.ProjectID),
+				zap.String("Bucket", definition.Bucket),
+				zap.Error(err))
+		}
+
+		chore.log.Info("inventory report generated",
+			zap.Stringer("Project ID", definition.ProjectID),
+			zap.String("Bucket", definition.Bucket),
+			zap.Int64("Objects", count))
+		mon.Meter("inventory_report_generated").Mark(1)
+		mon.IntVal("inventory_report_objects").Observe(count)
+	}
+
+	return nil
+}
+
+// ReportKey returns the object key of the report generated at the specified time.
+func ReportKey(definition Definition, generatedAt time.Time) string {
+	return definition.Destination.Prefix + definition.Bucket + "/" + generatedAt.UTC().Format("2006-01-02T15-04-05Z") + "/inventory.csv"
+}
+
+func (chore *Chore) generate(ctx context.Context, definition Definition, now time.Time) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = chore.uploader.Upload(ctx, definition.Destination, ReportKey(definition, now), func(w io.Writer) error {
+		report, err := NewReportWriter(w, definition.Bucket, definition.StorageClass)
+		if err != nil {
+			return err
+		}
+
+		err = chore.objects.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+			ProjectID:             definition.ProjectID,
+			BucketName:            metabase.BucketName(definition.Bucket),
+			Recursive:             true,
+			BatchSize:             chore.config.BatchSize,
+			IncludeSystemMetadata: true,
+			IncludeRetention:      true,
+		}, func(ctx context.Context, it metabase.ObjectsIterator) error {
+			var entry metabase.ObjectEntry
+			var previousKey metabase.ObjectKey
+			first := true
+			for it.Next(ctx, &entry) {
+				// versions are iterated in descending order, so the first one is the latest.
+				entry.IsLatest = first || entry.ObjectKey != previousKey
+				previousKey, first = entry.ObjectKey, false
+
+				if err := report.Write(entry); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		count = report.Count()
+		return report.Flush()
+	})
+	return count, err
+}
+
+// UplinkUploader uploads reports using the access grant of the destination.
+type UplinkUploader struct{}
+
+// Upload implements Uploader.
+func (UplinkUploader) Upload(ctx context.Context, destination Destination, key string, write func(io.Writer) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the access grant is read for every upload, so it can be rotated without a restart.
+	serializedAccess, err := os.ReadFile(destination.AccessGrantFile)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	access, err := uplink.ParseAccess(strings.TrimSpace(string(serializedAccess)))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	project, err := uplink.OpenProject(ctx, access)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, project.Close()) }()
+
+	upload, err := project.UploadObject(ctx, destination.Bucket, key, nil)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	if err := write(upload); err != nil {
+		return errs.Combine(err, upload.Abort())
+	}
+
+	return Error.Wrap(upload.Commit())
+}
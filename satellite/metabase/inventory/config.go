@@ -0,0 +1,118 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Config contains configurable values for the bucket inventory chore.
+type Config struct {
+	Enabled         bool          `help:"set if bucket inventory reports are generated" default:"false"`
+	Interval        time.Duration `help:"how often to check whether any inventory report is due" releaseDefault:"1h" devDefault:"1m" testDefault:"$TESTINTERVAL"`
+	DefinitionsFile string        `help:"path to the YAML file with the inventory definitions of the opted-in buckets" default:""`
+	BatchSize       int           `help:"how many objects to query in a batch" default:"1000"`
+}
+
+// Definition describes the inventory report of a single bucket.
+type Definition struct {
+	ProjectID uuid.UUID `yaml:"project-id"`
+	Bucket    string    `yaml:"bucket"`
+
+	// Frequency is how often the report is generated.
+	Frequency time.Duration `yaml:"frequency"`
+	// StorageClass is reported for every object of the bucket.
+	StorageClass string `yaml:"storage-class"`
+
+	Destination Destination `yaml:"destination"`
+}
+
+// Location returns the location of the bucket.
+func (def *Definition) Location() metabase.BucketLocation {
+	return metabase.BucketLocation{
+		ProjectID:  def.ProjectID,
+		BucketName: metabase.BucketName(def.Bucket),
+	}
+}
+
+// Destination describes where the inventory report is written.
+type Destination struct {
+	// AccessGrantFile is the path of the file with the access grant, which
+	// must allow writing into the destination bucket. The access grant is kept
+	// out of the definitions, so it can be stored as a secret.
+	AccessGrantFile string `yaml:"access-grant-file"`
+	Bucket          string `yaml:"bucket"`
+	Prefix          string `yaml:"prefix"`
+}
+
+// DefaultFrequency is used when the definition doesn't specify the frequency.
+const DefaultFrequency = 24 * time.Hour
+
+// DefaultStorageClass is used when the definition doesn't specify the storage class.
+const DefaultStorageClass = "STANDARD"
+
+// Verify verifies the definition and fills in the defaults.
+func (def *Definition) Verify() error {
+	switch {
+	case def.ProjectID.IsZero():
+		return Error.New("project-id is missing")
+	case def.Bucket == "":
+		return Error.New("bucket is missing")
+	case def.Destination.AccessGrantFile == "":
+		return Error.New("destination access-grant-file is missing for %s/%s", def.ProjectID, def.Bucket)
+	case def.Destination.Bucket == "":
+		return Error.New("destination bucket is missing for %s/%s", def.ProjectID, def.Bucket)
+	case def.Frequency < 0:
+		return Error.New("frequency must not be negative for %s/%s", def.ProjectID, def.Bucket)
+	}
+
+	if def.Frequency == 0 {
+		def.Frequency = DefaultFrequency
+	}
+	if def.StorageClass == "" {
+		def.StorageClass = DefaultStorageClass
+	}
+	return nil
+}
+
+// ParseDefinitions parses the inventory definitions in YAML format.
+func ParseDefinitions(data []byte) ([]Definition, error) {
+	var definitions []Definition
+	if err := yaml.Unmarshal(data, &definitions); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	seen := map[metabase.BucketLocation]struct{}{}
+	for i := range definitions {
+		if err := definitions[i].Verify(); err != nil {
+			return nil, err
+		}
+
+		key := definitions[i].Location()
+		if _, ok := seen[key]; ok {
+			return nil, Error.New("duplicate definition for %s/%s", key.ProjectID, key.BucketName)
+		}
+		seen[key] = struct{}{}
+	}
+	return definitions, nil
+}
+
+// LoadDefinitions loads the inventory definitions from a YAML file.
+func LoadDefinitions(path string) ([]Definition, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return ParseDefinitions(data)
+}
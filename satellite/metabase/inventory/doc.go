@@ -0,0 +1,14 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+/*
+Package inventory contains the chore which generates bucket inventory reports.
+
+Buckets are opted in by listing them in the inventory definitions file. For every
+definition the chore periodically iterates all object versions of the bucket and
+writes a CSV report, with one line per object version, as a new object into the
+destination bucket, using the access grant from the file referenced by the
+definition. The time of the last report of every bucket is stored in the
+database, so the reports are not regenerated when the satellite restarts.
+*/
+package inventory
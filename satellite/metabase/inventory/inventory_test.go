@@ -0,0 +1,235 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/inventory"
+)
+
+func TestParseDefinitions(t *testing.T) {
+	projectID := testrand.UUID()
+
+	definitions, err := inventory.ParseDefinitions([]byte(`
+- project-id: ` + projectID.String() + `
+  bucket: photos
+  destination:
+    access-grant-file: /secrets/grant
+    bucket: reports
+    prefix: inventory/
+- project-id: ` + projectID.String() + `
+  bucket: videos
+  frequency: 168h
+  storage-class: ARCHIVE
+  destination:
+    access-grant-file: /secrets/grant
+    bucket: reports
+`))
+	require.NoError(t, err)
+	require.Equal(t, []inventory.Definition{
+		{
+			ProjectID:    projectID,
+			Bucket:       "photos",
+			Frequency:    inventory.DefaultFrequency,
+			StorageClass: inventory.DefaultStorageClass,
+			Destination: inventory.Destination{
+				AccessGrantFile: "/secrets/grant",
+				Bucket:          "reports",
+				Prefix:          "inventory/",
+			},
+		},
+		{
+			ProjectID:    projectID,
+			Bucket:       "videos",
+			Frequency:    168 * time.Hour,
+			StorageClass: "ARCHIVE",
+			Destination: inventory.Destination{
+				AccessGrantFile: "/secrets/grant",
+				Bucket:          "reports",
+			},
+		},
+	}, definitions)
+
+	_, err = inventory.ParseDefinitions([]byte(`
+- project-id: ` + projectID.String() + `
+  bucket: photos
+`))
+	require.Error(t, err)
+
+	_, err = inventory.ParseDefinitions([]byte(`
+- project-id: ` + projectID.String() + `
+  bucket: photos
+  destination: {access-grant-file: /secrets/grant, bucket: reports}
+- project-id: ` + projectID.String() + `
+  bucket: photos
+  destination: {access-grant-file: /secrets/grant, bucket: reports}
+`))
+	require.Error(t, err)
+}
+
+func TestChore(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	projectID := testrand.UUID()
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	retainUntil := now.Add(24 * time.Hour)
+
+	objects := &fakeObjects{
+		entries: []metabase.ObjectEntry{
+			{
+				ObjectKey:          "a/b",
+				Version:            2,
+				StreamID:           testrand.UUID(),
+				Status:             metabase.CommittedVersioned,
+				CreatedAt:          now.Add(-time.Hour),
+				SegmentCount:       1,
+				TotalPlainSize:     100,
+				TotalEncryptedSize: 128,
+				Retention: metabase.Retention{
+					Mode:        storj.ComplianceMode,
+					RetainUntil: retainUntil,
+				},
+			},
+			{
+				ObjectKey: "a/b",
+				Version:   1,
+				StreamID:  testrand.UUID(),
+				Status:    metabase.DeleteMarkerVersioned,
+				CreatedAt: now.Add(-2 * time.Hour),
+			},
+		},
+	}
+	uploader := &fakeUploader{reports: map[string][]byte{}}
+
+	db := &fakeDB{generated: map[metabase.BucketLocation]time.Time{}}
+	config := inventory.Config{
+		Interval:  time.Hour,
+		BatchSize: 10,
+	}
+
+	chore, err := inventory.NewChore(zaptest.NewLogger(t), config, db, objects, uploader)
+	require.NoError(t, err)
+	defer ctx.Check(chore.Close)
+
+	definition := inventory.Definition{
+		ProjectID:    projectID,
+		Bucket:       "photos",
+		Frequency:    24 * time.Hour,
+		StorageClass: inventory.DefaultStorageClass,
+		Destination: inventory.Destination{
+			AccessGrantFile: "/secrets/grant",
+			Bucket:          "reports",
+			Prefix:          "inventory/",
+		},
+	}
+	chore.SetDefinitions([]inventory.Definition{definition})
+	chore.SetNow(func() time.Time { return now })
+
+	require.NoError(t, chore.RunOnce(ctx))
+	require.Equal(t, metabase.BucketName("photos"), objects.lastOpts.BucketName)
+	require.Equal(t, projectID, objects.lastOpts.ProjectID)
+	require.True(t, objects.lastOpts.IncludeRetention)
+
+	report, ok := uploader.reports["reports/"+inventory.ReportKey(definition, now)]
+	require.True(t, ok)
+
+	lines, err := csv.NewReader(bytes.NewReader(report)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, lines, 3)
+	require.Equal(t, inventory.ReportHeader, lines[0])
+	require.Equal(t, []string{
+		"photos", "a%2Fb", lines[1][2], "true", "false", "100", "128", "1",
+		now.Add(-time.Hour).Format(time.RFC3339), "",
+		"COMPLIANCE", retainUntil.Format(time.RFC3339), "false", "STANDARD",
+	}, lines[1])
+	require.Equal(t, "false", lines[2][3])
+	require.Equal(t, "true", lines[2][4])
+
+	// the report isn't due yet
+	chore.SetNow(func() time.Time { return now.Add(time.Hour) })
+	require.NoError(t, chore.RunOnce(ctx))
+	require.Len(t, uploader.reports, 1)
+
+	// the report isn't due after a restart either
+	restarted, err := inventory.NewChore(zaptest.NewLogger(t), config, db, objects, uploader)
+	require.NoError(t, err)
+	defer ctx.Check(restarted.Close)
+
+	restarted.SetDefinitions([]inventory.Definition{definition})
+	restarted.SetNow(func() time.Time { return now.Add(2 * time.Hour) })
+	require.NoError(t, restarted.RunOnce(ctx))
+	require.Len(t, uploader.reports, 1)
+
+	// the report is due again
+	restarted.SetNow(func() time.Time { return now.Add(25 * time.Hour) })
+	require.NoError(t, restarted.RunOnce(ctx))
+	require.Len(t, uploader.reports, 2)
+	require.Equal(t, now.Add(25*time.Hour), db.generated[definition.Location()])
+}
+
+type fakeDB struct {
+	generated map[metabase.BucketLocation]time.Time
+}
+
+func (db *fakeDB) GetGenerated(ctx context.Context) (map[metabase.BucketLocation]time.Time, error) {
+	generated := make(map[metabase.BucketLocation]time.Time, len(db.generated))
+	for bucket, at := range db.generated {
+		generated[bucket] = at
+	}
+	return generated, nil
+}
+
+func (db *fakeDB) SetGenerated(ctx context.Context, bucket metabase.BucketLocation, generatedAt time.Time) error {
+	db.generated[bucket] = generatedAt
+	return nil
+}
+
+type fakeObjects struct {
+	entries  []metabase.ObjectEntry
+	lastOpts metabase.IterateObjectsWithStatus
+}
+
+func (objects *fakeObjects) IterateObjectsAllVersionsWithStatus(ctx context.Context, opts metabase.IterateObjectsWithStatus, fn func(context.Context, metabase.ObjectsIterator) error) error {
+	objects.lastOpts = opts
+	return fn(ctx, &fakeIterator{entries: objects.entries})
+}
+
+type fakeIterator struct {
+	entries []metabase.ObjectEntry
+}
+
+func (it *fakeIterator) Next(ctx context.Context, item *metabase.ObjectEntry) bool {
+	if len(it.entries) == 0 {
+		return false
+	}
+	*item = it.entries[0]
+	it.entries = it.entries[1:]
+	return true
+}
+
+type fakeUploader struct {
+	reports map[string][]byte
+}
+
+func (uploader *fakeUploader) Upload(ctx context.Context, destination inventory.Destination, key string, write func(io.Writer) error) error {
+	var buf bytes.Buffer
+	if err := write(&buf); err != nil {
+		return err
+	}
+	uploader.reports[destination.Bucket+"/"+key] = buf.Bytes()
+	return nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package inventory
+
+import (
+	"encoding/csv"
+	"encoding/hex"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ReportHeader is the header line of the inventory report.
+var ReportHeader = []string{
+	"bucket",
+	"key",
+	"version_id",
+	"is_latest",
+	"is_delete_marker",
+	"size",
+	"encrypted_size",
+	"segment_count",
+	"created_at",
+	"expires_at",
+	"retention_mode",
+	"retain_until",
+	"legal_hold",
+	"storage_class",
+}
+
+// ReportWriter writes object entries into CSV inventory report.
+type ReportWriter struct {
+	bucket       string
+	storageClass string
+
+	csv   *csv.Writer
+	count int64
+}
+
+// NewReportWriter creates a new report writer and writes the report header.
+func NewReportWriter(w io.Writer, bucket, storageClass string) (*ReportWriter, error) {
+	writer := &ReportWriter{
+		bucket:       bucket,
+		storageClass: storageClass,
+		csv:          csv.NewWriter(w),
+	}
+	if err := writer.csv.Write(ReportHeader); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return writer, nil
+}
+
+// Write writes a single object entry into the report.
+//
+// Object keys are encrypted, unless the bucket uses unencrypted paths, so they
+// are URL-encoded in the same way as S3 inventory reports do.
+func (writer *ReportWriter) Write(entry metabase.ObjectEntry) error {
+	writer.count++
+	return Error.Wrap(writer.csv.Write([]string{
+		writer.bucket,
+		url.PathEscape(string(entry.ObjectKey)),
+		hex.EncodeToString(entry.StreamVersionID().Bytes()),
+		strconv.FormatBool(entry.IsLatest),
+		strconv.FormatBool(entry.Status.IsDeleteMarker()),
+		strconv.FormatInt(entry.TotalPlainSize, 10),
+		strconv.FormatInt(entry.TotalEncryptedSize, 10),
+		strconv.FormatInt(int64(entry.SegmentCount), 10),
+		formatTime(entry.CreatedAt),
+		formatTimePtr(entry.ExpiresAt),
+		formatRetentionMode(entry.Retention.Mode),
+		formatTime(entry.Retention.RetainUntil),
+		strconv.FormatBool(entry.LegalHold),
+		writer.storageClass,
+	}))
+}
+
+// Count returns the number of written object entries.
+func (writer *ReportWriter) Count() int64 { return writer.count }
+
+// Flush flushes the buffered lines into the underlying writer.
+func (writer *ReportWriter) Flush() error {
+	writer.csv.Flush()
+	return Error.Wrap(writer.csv.Error())
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatTimePtr(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return formatTime(*t)
+}
+
+func formatRetentionMode(mode storj.RetentionMode) string {
+	switch mode {
+	case storj.ComplianceMode:
+		return "COMPLIANCE"
+	case storj.GovernanceMode:
+		return "GOVERNANCE"
+	default:
+		return ""
+	}
+}
@@ -29,6 +29,7 @@ type objectsIterator struct {
 	recursive             bool
 	includeCustomMetadata bool
 	includeSystemMetadata bool
+	includeRetention      bool
 
 	curIndex int
 	curRows  tagsql.Rows
@@ -64,6 +65,7 @@ func iterateAllVersionsWithStatusDescending(ctx context.Context, adapter Adapter
 		recursive:             opts.Recursive,
 		includeCustomMetadata: opts.IncludeCustomMetadata,
 		includeSystemMetadata: opts.IncludeSystemMetadata,
+		includeRetention:      opts.IncludeRetention,
 
 		curIndex: 0,
 		cursor:   FirstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
@@ -96,6 +98,7 @@ func iterateAllVersionsWithStatusAscending(ctx context.Context, adapter Adapter,
 		recursive:             opts.Recursive,
 		includeCustomMetadata: opts.IncludeCustomMetadata,
 		includeSystemMetadata: opts.IncludeSystemMetadata,
+		includeRetention:      opts.IncludeRetention,
 
 		curIndex: 0,
 		cursor:   FirstIterateCursor(opts.Recursive, opts.Cursor, opts.Prefix),
@@ -568,6 +571,12 @@ func querySelectorFields(objectKeyColumn string, it *objectsIterator) string {
 			,encrypted_metadata_encrypted_key`
 	}
 
+	if it.includeRetention {
+		querySelectFields += `
+			,retention_mode
+			,retain_until`
+	}
+
 	return querySelectFields
 }
 
@@ -662,6 +671,13 @@ func (it *objectsIterator) scanItem(item *ObjectEntry) (err error) {
 		)
 	}
 
+	if it.includeRetention {
+		fields = append(fields,
+			lockModeWrapper{retentionMode: &item.Retention.Mode, legalHold: &item.LegalHold},
+			timeWrapper{&item.Retention.RetainUntil},
+		)
+	}
+
 	err = it.curRows.Scan(fields...)
 
 	if err != nil {
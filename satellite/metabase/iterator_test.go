@@ -15,6 +15,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
@@ -687,6 +688,32 @@ func TestIterateObjectsWithStatus(t *testing.T) {
 			require.NoError(t, err)
 		})
 
+		t.Run("include retention", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			obj1 := metabasetest.RandObjectStream()
+			retention := metabase.Retention{
+				Mode:        storj.ComplianceMode,
+				RetainUntil: time.Now().Add(time.Hour).Truncate(time.Minute),
+			}
+			metabasetest.CreateObjectWithRetention(ctx, t, db, obj1, 1, retention)
+
+			var collector metabasetest.IterateCollector
+			err := db.IterateObjectsAllVersionsWithStatus(ctx, metabase.IterateObjectsWithStatus{
+				ProjectID:             obj1.ProjectID,
+				BucketName:            obj1.BucketName,
+				Recursive:             true,
+				IncludeSystemMetadata: true,
+				IncludeRetention:      true,
+			}, collector.Add)
+			require.NoError(t, err)
+
+			require.Len(t, collector, 1)
+			require.Equal(t, retention.Mode, collector[0].Retention.Mode)
+			require.WithinDuration(t, retention.RetainUntil, collector[0].Retention.RetainUntil, time.Second)
+			require.False(t, collector[0].LegalHold)
+		})
+
 		t.Run("verify-cursor-continuation", func(t *testing.T) {
 			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
 			projectID, bucketName := uuid.UUID{1}, metabase.BucketName("bucky")
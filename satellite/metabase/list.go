@@ -35,6 +35,10 @@ type ObjectEntry struct {
 	FixedSegmentSize   int32
 
 	Encryption storj.EncryptionParameters
+
+	// Retention and LegalHold are set only when the iterator includes them.
+	Retention Retention
+	LegalHold bool
 }
 
 // StreamVersionID returns byte representation of object stream version id.
@@ -97,6 +101,7 @@ type IterateObjectsWithStatus struct {
 	Pending               bool
 	IncludeCustomMetadata bool
 	IncludeSystemMetadata bool
+	IncludeRetention      bool
 }
 
 // IterateObjectsAllVersionsWithStatus iterates through all versions of all objects with specified status.
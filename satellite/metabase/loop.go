@@ -63,6 +63,10 @@ type IterateLoopSegments struct {
 	AsOfSystemInterval   time.Duration
 	SpannerReadTimestamp time.Time
 	SpannerQueryType     string
+
+	// StartPosition continues the iteration after the segment at
+	// (StartStreamID, StartPosition) instead of after the whole StartStreamID stream.
+	StartPosition *SegmentPosition
 }
 
 // Verify verifies segments request fields.
@@ -78,6 +82,9 @@ func (opts *IterateLoopSegments) Verify() error {
 			return ErrInvalidRequest.New("StartStreamID and EndStreamID must be different")
 		}
 	}
+	if opts.StartPosition != nil && opts.StartStreamID.IsZero() {
+		return ErrInvalidRequest.New("StartPosition requires StartStreamID")
+	}
 	return nil
 }
 
@@ -138,6 +145,9 @@ func tagsqlIterateLoopSegments(ctx context.Context, db tagsqlAdapter, aliasCache
 		// uses MaxInt32 instead of MaxUint32 because position is an int8 in db.
 		it.cursor.StartPosition = SegmentPosition{math.MaxInt32, math.MaxInt32}
 	}
+	if opts.StartPosition != nil {
+		it.cursor.StartPosition = *opts.StartPosition
+	}
 	if it.cursor.EndStreamID.IsZero() {
 		it.cursor.EndStreamID = uuid.Max()
 	}
@@ -447,6 +457,9 @@ func (s *SpannerAdapter) IterateLoopSegments(ctx context.Context, aliasCache *No
 		// uses MaxInt32 instead of MaxUint32 because position is an int8 in db.
 		cursor.StartPosition = SegmentPosition{math.MaxInt32, math.MaxInt32}
 	}
+	if opts.StartPosition != nil {
+		cursor.StartPosition = *opts.StartPosition
+	}
 	if cursor.EndStreamID.IsZero() {
 		cursor.EndStreamID = uuid.Max()
 	}
@@ -78,6 +78,15 @@ func TestIterateLoopSegments(t *testing.T) {
 						Result: nil,
 					}.Check(ctx, t, db)
 
+					metabasetest.IterateLoopSegments{
+						Opts: metabase.IterateLoopSegments{
+							StartPosition:    &metabase.SegmentPosition{},
+							SpannerQueryType: spannerQueryType,
+						},
+						ErrClass: &metabase.ErrInvalidRequest,
+						ErrText:  "StartPosition requires StartStreamID",
+					}.Check(ctx, t, db)
+
 					metabasetest.Verify{}.Check(ctx, t, db)
 				})
 
@@ -325,6 +334,28 @@ func TestIterateLoopSegments(t *testing.T) {
 						}.Check(ctx, t, db)
 					}
 
+					{ // StartStreamID and StartPosition set
+						metabasetest.IterateLoopSegments{
+							Opts: metabase.IterateLoopSegments{
+								StartStreamID:    expectedObjects[1].StreamID,
+								StartPosition:    &metabase.SegmentPosition{Index: 0},
+								SpannerQueryType: spannerQueryType,
+							},
+							Result: expected[numberOfSegmentsPerObject+1:],
+						}.Check(ctx, t, db)
+
+						metabasetest.IterateLoopSegments{
+							Opts: metabase.IterateLoopSegments{
+								StartStreamID:    expectedObjects[1].StreamID,
+								StartPosition:    &metabase.SegmentPosition{Index: 1},
+								EndStreamID:      expectedObjects[5].StreamID,
+								BatchSize:        1,
+								SpannerQueryType: spannerQueryType,
+							},
+							Result: expected[numberOfSegmentsPerObject+2 : 6*numberOfSegmentsPerObject],
+						}.Check(ctx, t, db)
+					}
+
 					{ // StartStreamID and EndStreamID set
 						metabasetest.IterateLoopSegments{
 							Opts: metabase.IterateLoopSegments{
@@ -199,6 +199,9 @@ type FinishMoveObject struct {
 	// LegalHold indicates legal hold settings of the moved object
 	// version.
 	LegalHold bool
+
+	// Condition must hold for the latest committed object at the new location.
+	Condition WriteCondition
 }
 
 // NewLocation returns the new object location.
@@ -227,6 +230,10 @@ func (finishMove FinishMoveObject) Verify() error {
 		return ErrObjectStatus.New(noLockOnUnversionedErrMsg)
 	}
 
+	if err := finishMove.Condition.Verify(); err != nil {
+		return err
+	}
+
 	return ErrInvalidRequest.Wrap(finishMove.Retention.Verify())
 }
 
@@ -244,6 +251,7 @@ func (db *DB) FinishMoveObject(ctx context.Context, opts FinishMoveObject) (err
 			Location:       opts.NewLocation(),
 			Versioned:      opts.NewVersioned,
 			DisallowDelete: opts.NewDisallowDelete,
+			Condition:      opts.Condition,
 		}, adapter)
 		if err != nil {
 			return err
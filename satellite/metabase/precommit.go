@@ -24,6 +24,7 @@ type precommitTransactionAdapter interface {
 	precommitQueryHighest(ctx context.Context, loc ObjectLocation) (highest Version, err error)
 	precommitQueryHighestAndUnversioned(ctx context.Context, loc ObjectLocation) (highest Version, unversionedExists bool, err error)
 	precommitDeleteUnversioned(ctx context.Context, loc ObjectLocation) (result PrecommitConstraintResult, err error)
+	precommitQueryLatestCommitted(ctx context.Context, loc ObjectLocation) (latest ObjectStream, found bool, err error)
 }
 
 // PrecommitConstraint is arguments to ensure that a single unversioned object or delete marker exists in the
@@ -33,6 +34,9 @@ type PrecommitConstraint struct {
 
 	Versioned      bool
 	DisallowDelete bool
+
+	// Condition is checked before any existing object is replaced.
+	Condition WriteCondition
 }
 
 // PrecommitConstraintResult returns the result of enforcing precommit constraint.
@@ -62,6 +66,10 @@ func (db *DB) PrecommitConstraint(ctx context.Context, opts PrecommitConstraint,
 		return result, Error.Wrap(err)
 	}
 
+	if err := opts.Condition.check(ctx, adapter, opts.Location); err != nil {
+		return PrecommitConstraintResult{}, err
+	}
+
 	if opts.Versioned {
 		highest, err := adapter.precommitQueryHighest(ctx, opts.Location)
 		if err != nil {
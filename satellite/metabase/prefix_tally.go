@@ -0,0 +1,345 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/spanner"
+
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/dbutil/spannerutil"
+	"storj.io/storj/shared/dbutil/txutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+// PrefixTally contains information about aggregate data stored under a prefix of a bucket.
+//
+// Pending objects are not counted, because their size and segments are only
+// known after they are committed.
+type PrefixTally struct {
+	BucketLocation
+
+	// Prefix is the encrypted prefix including the trailing delimiter.
+	// Objects that are not nested deep enough are accounted under their
+	// deepest parent prefix, which is empty for the bucket root.
+	Prefix ObjectKey
+
+	ObjectCount        int64
+	TotalEncryptedSize int64
+	TotalSegments      int64
+
+	UpdatedAt time.Time
+}
+
+// PrefixOfDepth returns the first depth components of the key, including the
+// trailing delimiter. When the key has fewer components, the prefix of its
+// parent is returned.
+func PrefixOfDepth(key ObjectKey, depth int) ObjectKey {
+	end := 0
+	for i := 0; i < depth; i++ {
+		next := strings.IndexByte(string(key[end:]), Delimiter)
+		if next < 0 {
+			break
+		}
+		end += next + 1
+	}
+	return key[:end]
+}
+
+// prefixPattern returns the regular expression, which matches the same prefix
+// of a key as PrefixOfDepth.
+func prefixPattern(depth int) string {
+	return fmt.Sprintf("^(?:[^%c]*%c){0,%d}", Delimiter, Delimiter, depth)
+}
+
+// addPrefixTally adds the tally of a single prefix to the last bucket tally of
+// the result, or appends a new bucket tally when the prefix belongs to a
+// different bucket. The tallies must be added in bucket location order.
+func addPrefixTally(result []BucketTally, tally BucketTally, prefix ObjectKey, now time.Time) []BucketTally {
+	prefixTally := PrefixTally{
+		BucketLocation:     tally.BucketLocation,
+		Prefix:             prefix,
+		ObjectCount:        tally.ObjectCount - tally.PendingObjectCount,
+		TotalEncryptedSize: tally.TotalBytes,
+		TotalSegments:      tally.TotalSegments,
+		UpdatedAt:          now,
+	}
+
+	if len(result) == 0 || result[len(result)-1].BucketLocation != tally.BucketLocation {
+		result = append(result, tally)
+	} else {
+		last := &result[len(result)-1]
+		last.ObjectCount += tally.ObjectCount
+		last.PendingObjectCount += tally.PendingObjectCount
+		last.TotalSegments += tally.TotalSegments
+		last.TotalBytes += tally.TotalBytes
+		last.MetadataSize += tally.MetadataSize
+	}
+
+	// prefixes with only pending objects are left out.
+	if prefixTally.ObjectCount > 0 {
+		last := &result[len(result)-1]
+		last.Prefixes = append(last.Prefixes, prefixTally)
+	}
+	return result
+}
+
+// limitPrefixTallies merges the prefix tallies of a bucket, which are grouped
+// by depth path components and ordered by prefix, into the prefixes of fewer
+// path components until there are at most limit of them.
+func limitPrefixTallies(tallies []PrefixTally, depth, limit int) []PrefixTally {
+	for depth > 0 && len(tallies) > limit {
+		depth--
+
+		// prefixes with the same parent are next to each other in the order.
+		merged := make([]PrefixTally, 0, len(tallies))
+		for _, tally := range tallies {
+			tally.Prefix = PrefixOfDepth(tally.Prefix, depth)
+			if n := len(merged); n > 0 && merged[n-1].Prefix == tally.Prefix {
+				merged[n-1].ObjectCount += tally.ObjectCount
+				merged[n-1].TotalEncryptedSize += tally.TotalEncryptedSize
+				merged[n-1].TotalSegments += tally.TotalSegments
+				continue
+			}
+			merged = append(merged, tally)
+		}
+		tallies = merged
+	}
+	return tallies
+}
+
+// ReplacePrefixTallies contains arguments necessary for storing prefix tallies of a bucket.
+type ReplacePrefixTallies struct {
+	BucketLocation
+
+	Tallies []PrefixTally
+}
+
+// Verify verifies ReplacePrefixTallies request fields.
+func (opts *ReplacePrefixTallies) Verify() error {
+	if err := opts.BucketLocation.Verify(); err != nil {
+		return err
+	}
+	for _, tally := range opts.Tallies {
+		if tally.BucketLocation != opts.BucketLocation {
+			return ErrInvalidRequest.New("tally for prefix %q belongs to a different bucket", tally.Prefix)
+		}
+	}
+	return nil
+}
+
+// ReplacePrefixTallies replaces all stored prefix tallies of the bucket with the given ones.
+func (db *DB) ReplacePrefixTallies(ctx context.Context, opts ReplacePrefixTallies) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return err
+	}
+
+	return db.ChooseAdapter(opts.ProjectID).ReplacePrefixTallies(ctx, opts)
+}
+
+// ReplacePrefixTallies implements Adapter.
+func (p *PostgresAdapter) ReplacePrefixTallies(ctx context.Context, opts ReplacePrefixTallies) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	prefixes := make([][]byte, len(opts.Tallies))
+	objectCounts := make([]int64, len(opts.Tallies))
+	totalEncryptedSizes := make([]int64, len(opts.Tallies))
+	totalSegments := make([]int64, len(opts.Tallies))
+	updatedAts := make([]time.Time, len(opts.Tallies))
+	for i, tally := range opts.Tallies {
+		prefixes[i] = []byte(tally.Prefix)
+		objectCounts[i] = tally.ObjectCount
+		totalEncryptedSizes[i] = tally.TotalEncryptedSize
+		totalSegments[i] = tally.TotalSegments
+		updatedAts[i] = tally.UpdatedAt
+	}
+
+	return Error.Wrap(txutil.WithTx(ctx, p.db, nil, func(ctx context.Context, tx tagsql.Tx) error {
+		_, err := tx.ExecContext(ctx, `
+			DELETE FROM prefix_tallies
+			WHERE (project_id, bucket_name) = ($1, $2)
+		`, opts.ProjectID, opts.BucketName)
+		if err != nil {
+			return err
+		}
+
+		if len(opts.Tallies) == 0 {
+			return nil
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO prefix_tallies (
+				project_id, bucket_name, prefix,
+				object_count, total_encrypted_size, segment_count,
+				updated_at
+			)
+			SELECT
+				$1, $2, unnest($3::BYTEA[]),
+				unnest($4::INT8[]), unnest($5::INT8[]), unnest($6::INT8[]),
+				unnest($7::TIMESTAMPTZ[])
+		`, opts.ProjectID, opts.BucketName, pgutil.ByteaArray(prefixes),
+			pgutil.Int8Array(objectCounts), pgutil.Int8Array(totalEncryptedSizes), pgutil.Int8Array(totalSegments),
+			pgutil.TimestampTZArray(updatedAts))
+		return err
+	}))
+}
+
+// spannerPrefixTallyBatchSize is the number of prefix tallies inserted in a
+// single transaction, which keeps the mutations of a transaction well below the
+// Spanner limit of 80000.
+const spannerPrefixTallyBatchSize = 5000
+
+// ReplacePrefixTallies implements Adapter.
+//
+// The tallies are inserted in batches of separate transactions, so while a
+// bucket with many prefixes is being replaced, only some of its prefix tallies
+// may be visible.
+func (s *SpannerAdapter) ReplacePrefixTallies(ctx context.Context, opts ReplacePrefixTallies) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tallies := opts.Tallies
+	deleted := false
+	for !deleted || len(tallies) > 0 {
+		batch := tallies[:min(len(tallies), spannerPrefixTallyBatchSize)]
+		tallies = tallies[len(batch):]
+
+		_, err = s.client.ReadWriteTransaction(ctx, func(ctx context.Context, tx *spanner.ReadWriteTransaction) error {
+			if !deleted {
+				_, err := tx.Update(ctx, spanner.Statement{
+					SQL: `
+						DELETE FROM prefix_tallies
+						WHERE project_id = @project_id AND bucket_name = @bucket_name
+					`,
+					Params: map[string]any{
+						"project_id":  opts.ProjectID,
+						"bucket_name": opts.BucketName,
+					},
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			mutations := make([]*spanner.Mutation, len(batch))
+			for i, tally := range batch {
+				mutations[i] = spanner.Insert("prefix_tallies",
+					[]string{
+						"project_id", "bucket_name", "prefix",
+						"object_count", "total_encrypted_size", "segment_count",
+						"updated_at",
+					},
+					[]any{
+						opts.ProjectID, opts.BucketName, tally.Prefix,
+						tally.ObjectCount, tally.TotalEncryptedSize, tally.TotalSegments,
+						tally.UpdatedAt,
+					},
+				)
+			}
+			return tx.BufferWrite(mutations)
+		})
+		if err != nil {
+			return Error.Wrap(err)
+		}
+		deleted = true
+	}
+	return nil
+}
+
+// GetPrefixTallies contains arguments necessary for fetching stored prefix tallies of a bucket.
+type GetPrefixTallies struct {
+	BucketLocation
+}
+
+// GetPrefixTallies returns the stored prefix tallies of the bucket ordered by prefix.
+func (db *DB) GetPrefixTallies(ctx context.Context, opts GetPrefixTallies) (result []PrefixTally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if err := opts.Verify(); err != nil {
+		return nil, err
+	}
+
+	return db.ChooseAdapter(opts.ProjectID).GetPrefixTallies(ctx, opts)
+}
+
+// GetPrefixTallies implements Adapter.
+func (p *PostgresAdapter) GetPrefixTallies(ctx context.Context, opts GetPrefixTallies) (result []PrefixTally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(p.db.QueryContext(ctx, `
+		SELECT prefix, object_count, total_encrypted_size, segment_count, updated_at
+		FROM prefix_tallies
+		WHERE (project_id, bucket_name) = ($1, $2)
+		ORDER BY prefix ASC
+	`, opts.ProjectID, opts.BucketName))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			tally := PrefixTally{BucketLocation: opts.BucketLocation}
+			if err := rows.Scan(
+				&tally.Prefix, &tally.ObjectCount, &tally.TotalEncryptedSize, &tally.TotalSegments, &tally.UpdatedAt,
+			); err != nil {
+				return Error.New("unable to query prefix tally: %w", err)
+			}
+			result = append(result, tally)
+		}
+		return nil
+	})
+	return result, Error.Wrap(err)
+}
+
+// GetPrefixTallies implements Adapter.
+func (s *SpannerAdapter) GetPrefixTallies(ctx context.Context, opts GetPrefixTallies) (result []PrefixTally, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err = spannerutil.CollectRows(s.client.Single().Query(ctx, spanner.Statement{
+		SQL: `
+			SELECT prefix, object_count, total_encrypted_size, segment_count, updated_at
+			FROM prefix_tallies
+			WHERE project_id = @project_id AND bucket_name = @bucket_name
+			ORDER BY prefix ASC
+		`,
+		Params: map[string]any{
+			"project_id":  opts.ProjectID,
+			"bucket_name": opts.BucketName,
+		},
+	}), func(row *spanner.Row, tally *PrefixTally) error {
+		tally.BucketLocation = opts.BucketLocation
+		return row.Columns(&tally.Prefix, &tally.ObjectCount, &tally.TotalEncryptedSize, &tally.TotalSegments, &tally.UpdatedAt)
+	})
+	return result, Error.Wrap(err)
+}
+
+// DeleteAllPrefixTallies deletes the stored prefix tallies of all buckets.
+func (db *DB) DeleteAllPrefixTallies(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for _, adapter := range db.adapters {
+		if err := adapter.DeleteAllPrefixTallies(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteAllPrefixTallies implements Adapter.
+func (p *PostgresAdapter) DeleteAllPrefixTallies(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = p.db.ExecContext(ctx, `DELETE FROM prefix_tallies`)
+	return Error.Wrap(err)
+}
+
+// DeleteAllPrefixTallies implements Adapter.
+func (s *SpannerAdapter) DeleteAllPrefixTallies(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = s.client.PartitionedUpdate(ctx, spanner.Statement{
+		SQL: `DELETE FROM prefix_tallies WHERE true`,
+	})
+	return Error.Wrap(err)
+}
@@ -0,0 +1,225 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metabase_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/metabasetest"
+)
+
+func TestPrefixOfDepth(t *testing.T) {
+	for _, tc := range []struct {
+		key    metabase.ObjectKey
+		depth  int
+		prefix metabase.ObjectKey
+	}{
+		{key: "a", depth: 1, prefix: ""},
+		{key: "a/b", depth: 1, prefix: "a/"},
+		{key: "a/b/c", depth: 1, prefix: "a/"},
+		{key: "a/b/c", depth: 2, prefix: "a/b/"},
+		{key: "a/b/c", depth: 3, prefix: "a/b/"},
+		{key: "a/b/", depth: 2, prefix: "a/b/"},
+		{key: "/a", depth: 1, prefix: "/"},
+		{key: "a//b", depth: 2, prefix: "a//"},
+	} {
+		require.Equal(t, tc.prefix, metabase.PrefixOfDepth(tc.key, tc.depth), "%q depth %d", tc.key, tc.depth)
+	}
+}
+
+func TestPrefixTallies(t *testing.T) {
+	metabasetest.Run(t, func(ctx *testcontext.Context, t *testing.T, db *metabase.DB) {
+		t.Run("invalid request", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			_, err := db.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+				PrefixDepth: -1,
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+			require.ErrorContains(t, err, "PrefixDepth is negative")
+
+			_, err = db.GetPrefixTallies(ctx, metabase.GetPrefixTallies{})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+
+			err = db.ReplacePrefixTallies(ctx, metabase.ReplacePrefixTallies{
+				BucketLocation: metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "bucket"},
+				Tallies: []metabase.PrefixTally{{
+					BucketLocation: metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "other"},
+				}},
+			})
+			require.True(t, metabase.ErrInvalidRequest.Has(err))
+		})
+
+		t.Run("collect, replace and delete", func(t *testing.T) {
+			defer metabasetest.DeleteAll{}.Check(ctx, t, db)
+
+			now := time.Now().Truncate(time.Millisecond)
+			location := metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "bucket"}
+
+			// keys are encrypted, so they may contain any bytes
+			keys := []metabase.ObjectKey{"root", "a/1", "a/b/2", "a/b/3", "c/4", "\x00\xff\\/5", "\xff/\x00/6"}
+
+			var objects []metabase.Object
+			for _, key := range keys {
+				stream := metabasetest.RandObjectStream()
+				stream.ProjectID = location.ProjectID
+				stream.BucketName = location.BucketName
+				stream.ObjectKey = key
+
+				object, _ := metabasetest.CreateTestObject{}.Run(ctx, t, db, stream, 2)
+				objects = append(objects, object)
+			}
+
+			// pending objects are not counted, and prefixes with only pending objects are left out
+			for _, key := range []metabase.ObjectKey{"a/pending", "pending/1"} {
+				stream := metabasetest.RandObjectStream()
+				stream.ProjectID = location.ProjectID
+				stream.BucketName = location.BucketName
+				stream.ObjectKey = key
+				metabasetest.CreatePendingObject(ctx, t, db, stream, 0)
+			}
+
+			// other buckets are not included
+			metabasetest.CreateObject(ctx, t, db, metabasetest.RandObjectStream(), 1)
+
+			bucketTallies, err := db.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+				From:        location,
+				To:          location,
+				Now:         now,
+				PrefixDepth: 1,
+			})
+			require.NoError(t, err)
+			require.Len(t, bucketTallies, 1)
+
+			tally := func(prefix metabase.ObjectKey, objects ...metabase.Object) metabase.PrefixTally {
+				result := metabase.PrefixTally{
+					BucketLocation: location,
+					Prefix:         prefix,
+					UpdatedAt:      now,
+				}
+				for _, object := range objects {
+					require.Equal(t, prefix, metabase.PrefixOfDepth(object.ObjectKey, 1))
+					result.ObjectCount++
+					result.TotalEncryptedSize += object.TotalEncryptedSize
+					result.TotalSegments += int64(object.SegmentCount)
+				}
+				return result
+			}
+
+			expected := []metabase.PrefixTally{
+				tally("", objects[0]),
+				tally("\x00\xff\\/", objects[5]),
+				tally("a/", objects[1], objects[2], objects[3]),
+				tally("c/", objects[4]),
+				tally("\xff/", objects[6]),
+			}
+			tallies := bucketTallies[0].Prefixes
+			require.Equal(t, expected, tallies)
+
+			// the prefix tallies add up to the committed objects of the bucket tally
+			require.EqualValues(t, 2, bucketTallies[0].PendingObjectCount)
+			var objectCount, totalBytes, totalSegments int64
+			for _, prefix := range tallies {
+				objectCount += prefix.ObjectCount
+				totalBytes += prefix.TotalEncryptedSize
+				totalSegments += prefix.TotalSegments
+			}
+			require.Equal(t, bucketTallies[0].ObjectCount-bucketTallies[0].PendingObjectCount, objectCount)
+			require.Equal(t, bucketTallies[0].TotalBytes, totalBytes)
+			require.Equal(t, bucketTallies[0].TotalSegments, totalSegments)
+
+			withoutPrefixes, err := db.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+				From: location,
+				To:   location,
+				Now:  now,
+			})
+			require.NoError(t, err)
+			bucketTallies[0].Prefixes = nil
+			require.Equal(t, withoutPrefixes, bucketTallies)
+
+			// buckets with too many prefixes are grouped by fewer path components
+			deeper, err := db.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+				From:        location,
+				To:          location,
+				Now:         now,
+				PrefixDepth: 2,
+			})
+			require.NoError(t, err)
+			require.Len(t, deeper, 1)
+			require.Len(t, deeper[0].Prefixes, len(expected)+1)
+
+			limited, err := db.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+				From:        location,
+				To:          location,
+				Now:         now,
+				PrefixDepth: 2,
+				MaxPrefixes: len(expected),
+			})
+			require.NoError(t, err)
+			require.Len(t, limited, 1)
+			require.Equal(t, expected, limited[0].Prefixes)
+
+			limited, err = db.CollectBucketTallies(ctx, metabase.CollectBucketTallies{
+				From:        location,
+				To:          location,
+				Now:         now,
+				PrefixDepth: 2,
+				MaxPrefixes: 1,
+			})
+			require.NoError(t, err)
+			require.Len(t, limited, 1)
+			require.Equal(t, []metabase.PrefixTally{{
+				BucketLocation:     location,
+				Prefix:             "",
+				ObjectCount:        objectCount,
+				TotalEncryptedSize: totalBytes,
+				TotalSegments:      totalSegments,
+				UpdatedAt:          now,
+			}}, limited[0].Prefixes)
+
+			stored, err := db.GetPrefixTallies(ctx, metabase.GetPrefixTallies{BucketLocation: location})
+			require.NoError(t, err)
+			require.Empty(t, stored)
+
+			require.NoError(t, db.ReplacePrefixTallies(ctx, metabase.ReplacePrefixTallies{
+				BucketLocation: location,
+				Tallies:        tallies,
+			}))
+
+			stored, err = db.GetPrefixTallies(ctx, metabase.GetPrefixTallies{BucketLocation: location})
+			require.NoError(t, err)
+			require.Len(t, stored, len(expected))
+			for i := range expected {
+				require.Equal(t, expected[i].Prefix, stored[i].Prefix)
+				require.Equal(t, expected[i].ObjectCount, stored[i].ObjectCount)
+				require.Equal(t, expected[i].TotalEncryptedSize, stored[i].TotalEncryptedSize)
+				require.Equal(t, expected[i].TotalSegments, stored[i].TotalSegments)
+				require.WithinDuration(t, now, stored[i].UpdatedAt, time.Second)
+			}
+
+			// replacing removes prefixes that are gone
+			require.NoError(t, db.ReplacePrefixTallies(ctx, metabase.ReplacePrefixTallies{
+				BucketLocation: location,
+				Tallies:        tallies[2:3],
+			}))
+
+			stored, err = db.GetPrefixTallies(ctx, metabase.GetPrefixTallies{BucketLocation: location})
+			require.NoError(t, err)
+			require.Len(t, stored, 1)
+			require.Equal(t, metabase.ObjectKey("a/"), stored[0].Prefix)
+
+			require.NoError(t, db.DeleteAllPrefixTallies(ctx))
+
+			stored, err = db.GetPrefixTallies(ctx, metabase.GetPrefixTallies{BucketLocation: location})
+			require.NoError(t, err)
+			require.Empty(t, stored)
+		})
+	})
+}
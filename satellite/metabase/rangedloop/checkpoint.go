@@ -0,0 +1,242 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrCheckpoint is the error class for persisting the loop progress.
+var ErrCheckpoint = errs.Class("ranged loop checkpoint")
+
+// Checkpoint is the persisted progress of a loop run. It's used to resume
+// a run which was interrupted, e.g. by a restart of the process.
+type Checkpoint struct {
+	// StartedAt is the time the interrupted run was started. Observers are
+	// started with this time when the run is resumed.
+	StartedAt   time.Time         `json:"startedAt"`
+	Parallelism int               `json:"parallelism"`
+	Ranges      []RangeCheckpoint `json:"ranges"`
+}
+
+// RangeCheckpoint is the progress of a single range.
+type RangeCheckpoint struct {
+	Range UUIDRange `json:"range"`
+
+	// LastStreamID and LastPosition identify the last processed segment.
+	// LastStreamID is zero when the range has no progress yet.
+	LastStreamID uuid.UUID                `json:"lastStreamId"`
+	LastPosition metabase.SegmentPosition `json:"lastPosition"`
+	// Done is set when all segments of the range have been processed.
+	Done bool `json:"done"`
+
+	// Partials contains the state of resumable observers, keyed by the
+	// observer name. An observer without a state can't be resumed.
+	Partials map[string][]byte `json:"partials,omitempty"`
+}
+
+// started returns whether any segments of the range have been processed.
+func (checkpoint *RangeCheckpoint) started() bool {
+	return checkpoint.Done || !checkpoint.LastStreamID.IsZero()
+}
+
+// CheckpointStore persists the progress of the loop.
+type CheckpointStore interface {
+	// Load returns the last saved checkpoint or nil when there's none.
+	Load(ctx context.Context) (*Checkpoint, error)
+	// Save replaces the saved checkpoint.
+	Save(ctx context.Context, checkpoint *Checkpoint) error
+	// Delete removes the saved checkpoint.
+	Delete(ctx context.Context) error
+}
+
+var _ CheckpointStore = (*FileCheckpointStore)(nil)
+
+// FileCheckpointStore persists checkpoints in a local file.
+type FileCheckpointStore struct {
+	path string
+}
+
+// NewFileCheckpointStore creates a checkpoint store which uses the file at path.
+func NewFileCheckpointStore(path string) *FileCheckpointStore {
+	return &FileCheckpointStore{path: path}
+}
+
+// Load implements CheckpointStore.
+func (store *FileCheckpointStore) Load(ctx context.Context) (_ *Checkpoint, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := os.ReadFile(store.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, ErrCheckpoint.Wrap(err)
+	}
+
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(data, &checkpoint); err != nil {
+		return nil, ErrCheckpoint.Wrap(err)
+	}
+	return &checkpoint, nil
+}
+
+// Save implements CheckpointStore. The file is replaced atomically, so an
+// interruption while saving doesn't corrupt the previous checkpoint.
+func (store *FileCheckpointStore) Save(ctx context.Context, checkpoint *Checkpoint) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	data, err := json.Marshal(checkpoint)
+	if err != nil {
+		return ErrCheckpoint.Wrap(err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(store.path), filepath.Base(store.path)+".tmp*")
+	if err != nil {
+		return ErrCheckpoint.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			_ = os.Remove(tmp.Name())
+		}
+	}()
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	err = errs.Combine(err, tmp.Close())
+	if err != nil {
+		return ErrCheckpoint.Wrap(err)
+	}
+
+	return ErrCheckpoint.Wrap(os.Rename(tmp.Name(), store.path))
+}
+
+// Delete implements CheckpointStore.
+func (store *FileCheckpointStore) Delete(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = os.Remove(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return ErrCheckpoint.Wrap(err)
+}
+
+// resumed returns whether any of the ranges made progress.
+func (checkpoint *Checkpoint) resumed() bool {
+	for i := range checkpoint.Ranges {
+		if checkpoint.Ranges[i].started() {
+			return true
+		}
+	}
+	return false
+}
+
+// checkpointTracker collects the progress of the ranges and saves it.
+// Failing to save the progress doesn't fail the run, it's only logged.
+type checkpointTracker struct {
+	log      *zap.Logger
+	store    CheckpointStore
+	interval time.Duration
+
+	mu         sync.Mutex
+	checkpoint *Checkpoint
+}
+
+// save stores the progress and the state of the resumable observers of the
+// range at index. It must not be called concurrently with processing the range.
+func (tracker *checkpointTracker) save(ctx context.Context, index int, progress RangeCheckpoint, states []*rangeObserverState) {
+	progress.Partials = map[string][]byte{}
+	for _, state := range states {
+		if state.resumable == nil || state.err != nil {
+			// the observer already failed in this run, so it fails on resume too.
+			continue
+		}
+		data, err := state.resumable.SavePartial(ctx, state.rangeObserver)
+		if err != nil {
+			// keep the previous progress of the range, otherwise the observer
+			// would miss the segments processed since then.
+			tracker.log.Warn("unable to save observer state",
+				zap.String("observer", state.name),
+				zap.Error(err))
+			return
+		}
+		progress.Partials[state.name] = data
+	}
+
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	tracker.checkpoint.Ranges[index] = progress
+	if err := tracker.store.Save(ctx, tracker.checkpoint); err != nil {
+		tracker.log.Warn("unable to save ranged loop checkpoint", zap.Error(err))
+	}
+}
+
+// finish removes the checkpoint after the run completed.
+func (tracker *checkpointTracker) finish(ctx context.Context) {
+	if tracker == nil {
+		return
+	}
+	if err := tracker.store.Delete(ctx); err != nil {
+		tracker.log.Warn("unable to delete ranged loop checkpoint", zap.Error(err))
+	}
+}
+
+// observerNames returns the names under which the state of the observers is
+// saved. Names need to be stable between runs, so observers of the same type
+// are distinguished by their order.
+func observerNames(observers []Observer) []string {
+	names := make([]string, 0, len(observers))
+	seen := map[string]int{}
+	for _, observer := range observers {
+		name := fmt.Sprintf("%T", observer)
+		if n := seen[name]; n > 0 {
+			seen[name]++
+			name = fmt.Sprintf("%s#%d", name, n)
+		} else {
+			seen[name] = 1
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// matchesRanges checks whether the checkpoint was created for the same ranges.
+func (checkpoint *Checkpoint) matchesRanges(providers []SegmentProvider) bool {
+	if len(checkpoint.Ranges) != len(providers) {
+		return false
+	}
+	for i, provider := range providers {
+		if !equalUUIDRange(checkpoint.Ranges[i].Range, provider.Range()) {
+			return false
+		}
+	}
+	return true
+}
+
+func equalUUIDRange(a, b UUIDRange) bool {
+	equal := func(x, y *uuid.UUID) bool {
+		if x == nil || y == nil {
+			return x == nil && y == nil
+		}
+		return *x == *y
+	}
+	return equal(a.Start, b.Start) && equal(a.End, b.End)
+}
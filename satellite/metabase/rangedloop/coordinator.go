@@ -0,0 +1,326 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/internalpb"
+)
+
+// DistributedConfig configures processing the ranges by worker processes.
+//
+// The coordinator is served on the private DRPC server of the modular
+// satellite; the ranged loop process of the non-modular satellite refuses to
+// start when it's enabled.
+type DistributedConfig struct {
+	Enabled          bool          `help:"hand out the ranges to worker processes instead of processing them locally (requires the modular satellite)" default:"false"`
+	LeaseTimeout     time.Duration `help:"how long a range stays assigned to a worker, which doesn't send heartbeats, and how long the loop run waits while no worker asks for ranges" default:"5m"`
+	MaxRangeAttempts int           `help:"how many times a range is assigned to workers before the loop run fails" default:"3"`
+	ComeBackIn       time.Duration `help:"how long workers wait before asking again when no range is available" default:"10s"`
+}
+
+var _ internalpb.DRPCRangedLoopCoordinatorServer = (*Coordinator)(nil)
+
+// Coordinator hands out the ranges of a loop run to workers and collects the
+// state of the partials they produce. Only observers that implement
+// ResumableObserver can be processed by workers, so a run with any other
+// observer is processed locally.
+//
+// architecture: Endpoint
+type Coordinator struct {
+	log    *zap.Logger
+	config DistributedConfig
+
+	mu  sync.Mutex
+	run *coordinatedRun
+}
+
+// coordinatedRun is a loop run whose ranges are processed by workers.
+type coordinatedRun struct {
+	id        uuid.UUID
+	startTime time.Time
+	batchSize int
+	observers []string
+	ranges    []*coordinatedRange
+
+	// lastContact is when a worker called the coordinator the last time.
+	lastContact time.Time
+}
+
+type coordinatedRange struct {
+	index     int
+	uuidRange UUIDRange
+
+	// pending is set when the range is waiting for a worker.
+	pending      bool
+	lease        uuid.UUID
+	worker       string
+	leaseExpires time.Time
+	attempts     int
+
+	result chan coordinatedResult
+}
+
+// coordinatedResult is the outcome of a range.
+type coordinatedResult struct {
+	partials map[string]*internalpb.ObserverPartial
+	err      error
+}
+
+// NewCoordinator creates a new coordinator of distributed loop runs.
+func NewCoordinator(log *zap.Logger, config DistributedConfig) *Coordinator {
+	return &Coordinator{
+		log:    log,
+		config: config,
+	}
+}
+
+// startRun makes the ranges available to workers.
+func (coordinator *Coordinator) startRun(startTime time.Time, batchSize int, observers []string, rangeProviders []SegmentProvider, pending []bool) (*coordinatedRun, error) {
+	id, err := uuid.New()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	run := &coordinatedRun{
+		id:          id,
+		startTime:   startTime,
+		batchSize:   batchSize,
+		observers:   observers,
+		lastContact: time.Now(),
+	}
+	for index, rangeProvider := range rangeProviders {
+		run.ranges = append(run.ranges, &coordinatedRange{
+			index:     index,
+			uuidRange: rangeProvider.Range(),
+			pending:   pending[index],
+			result:    make(chan coordinatedResult, 1),
+		})
+	}
+
+	coordinator.mu.Lock()
+	defer coordinator.mu.Unlock()
+
+	if coordinator.run != nil {
+		return nil, Error.New("a distributed loop run is already in progress")
+	}
+	coordinator.run = run
+	return run, nil
+}
+
+// finishRun stops handing out the ranges of the run.
+func (coordinator *Coordinator) finishRun(run *coordinatedRun) {
+	coordinator.mu.Lock()
+	defer coordinator.mu.Unlock()
+
+	if coordinator.run == run {
+		coordinator.run = nil
+	}
+}
+
+// waitRange waits until a worker has processed the range at index. The
+// leases are expired meanwhile, so the run doesn't wait forever for workers
+// which died.
+func (coordinator *Coordinator) waitRange(ctx context.Context, run *coordinatedRun, index int) (map[string]*internalpb.ObserverPartial, error) {
+	ticker := time.NewTicker(coordinator.leaseCheckInterval())
+	defer ticker.Stop()
+
+	for {
+		select {
+		case result := <-run.ranges[index].result:
+			return result.partials, result.err
+		case now := <-ticker.C:
+			coordinator.mu.Lock()
+			coordinator.expireLeases(run, now)
+			coordinator.mu.Unlock()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// leaseCheckInterval returns how often the leases are checked while waiting for the ranges.
+func (coordinator *Coordinator) leaseCheckInterval() time.Duration {
+	interval := coordinator.config.LeaseTimeout / 4
+	if interval <= 0 {
+		interval = time.Second
+	}
+	return interval
+}
+
+// NextRange assigns a range to the worker.
+func (coordinator *Coordinator) NextRange(ctx context.Context, req *internalpb.NextRangeRequest) (_ *internalpb.NextRangeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	coordinator.mu.Lock()
+	defer coordinator.mu.Unlock()
+
+	comeBackIn := &internalpb.NextRangeResponse{
+		ComeBackInMillis: int32(coordinator.config.ComeBackIn.Milliseconds()),
+	}
+
+	run := coordinator.run
+	if run == nil {
+		return comeBackIn, nil
+	}
+
+	now := time.Now()
+	run.lastContact = now
+	coordinator.expireLeases(run, now)
+
+	for _, r := range run.ranges {
+		if !r.pending {
+			continue
+		}
+
+		lease, err := uuid.New()
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		r.pending = false
+		r.lease = lease
+		r.worker = req.WorkerId
+		r.leaseExpires = now.Add(coordinator.config.LeaseTimeout)
+		r.attempts++
+
+		coordinator.log.Debug("assigned range to worker",
+			zap.String("worker", req.WorkerId),
+			zap.Int("index", r.index),
+			zap.Int("attempt", r.attempts))
+
+		response := &internalpb.NextRangeResponse{
+			LeaseId:              lease.Bytes(),
+			RunId:                run.id.Bytes(),
+			RunStartTimeUnixNano: run.startTime.UnixNano(),
+			NumRanges:            int32(len(run.ranges)),
+			BatchSize:            int32(run.batchSize),
+			RangeIndex:           int32(r.index),
+			Observers:            run.observers,
+		}
+		if r.uuidRange.Start != nil {
+			response.RangeStart = r.uuidRange.Start.Bytes()
+		}
+		if r.uuidRange.End != nil {
+			response.RangeEnd = r.uuidRange.End.Bytes()
+		}
+		return response, nil
+	}
+
+	return comeBackIn, nil
+}
+
+// RangeHeartbeat extends the lease of a range.
+func (coordinator *Coordinator) RangeHeartbeat(ctx context.Context, req *internalpb.RangeHeartbeatRequest) (_ *internalpb.RangeHeartbeatResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	coordinator.mu.Lock()
+	defer coordinator.mu.Unlock()
+
+	if coordinator.run != nil {
+		coordinator.run.lastContact = time.Now()
+	}
+
+	r := coordinator.findLease(req.LeaseId)
+	if r == nil || time.Now().After(r.leaseExpires) {
+		return &internalpb.RangeHeartbeatResponse{LeaseExpired: true}, nil
+	}
+
+	r.leaseExpires = time.Now().Add(coordinator.config.LeaseTimeout)
+	return &internalpb.RangeHeartbeatResponse{}, nil
+}
+
+// FinishRange receives the result of a range.
+func (coordinator *Coordinator) FinishRange(ctx context.Context, req *internalpb.FinishRangeRequest) (_ *internalpb.FinishRangeResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	coordinator.mu.Lock()
+	defer coordinator.mu.Unlock()
+
+	if coordinator.run != nil {
+		coordinator.run.lastContact = time.Now()
+	}
+
+	r := coordinator.findLease(req.LeaseId)
+	if r == nil {
+		// the range was reassigned, the result of the other worker is used.
+		return &internalpb.FinishRangeResponse{}, nil
+	}
+	r.lease = uuid.UUID{}
+
+	if req.Error != "" {
+		coordinator.log.Warn("worker failed to process range",
+			zap.String("worker", r.worker),
+			zap.Int("index", r.index),
+			zap.String("error", req.Error))
+		coordinator.retry(r, Error.New("worker %s failed to process range %d: %s", r.worker, r.index, req.Error))
+		return &internalpb.FinishRangeResponse{}, nil
+	}
+
+	partials := make(map[string]*internalpb.ObserverPartial, len(req.Partials))
+	for _, partial := range req.Partials {
+		partials[partial.Observer] = partial
+	}
+	r.result <- coordinatedResult{partials: partials}
+
+	return &internalpb.FinishRangeResponse{}, nil
+}
+
+// findLease returns the range with the active lease. It must be called with mu held.
+func (coordinator *Coordinator) findLease(leaseID []byte) *coordinatedRange {
+	if coordinator.run == nil {
+		return nil
+	}
+	lease, err := uuid.FromBytes(leaseID)
+	if err != nil || lease.IsZero() {
+		return nil
+	}
+	for _, r := range coordinator.run.ranges {
+		if r.lease == lease {
+			return r
+		}
+	}
+	return nil
+}
+
+// expireLeases makes the ranges of workers, which stopped sending heartbeats,
+// available again. When no worker called the coordinator for the lease
+// timeout, the ranges waiting for a worker fail. It must be called with mu
+// held.
+func (coordinator *Coordinator) expireLeases(run *coordinatedRun, now time.Time) {
+	noWorkers := now.Sub(run.lastContact) > coordinator.config.LeaseTimeout
+	for _, r := range run.ranges {
+		if r.pending && noWorkers {
+			r.pending = false
+			r.result <- coordinatedResult{err: Error.New("no worker asked for range %d in %s", r.index, coordinator.config.LeaseTimeout)}
+			continue
+		}
+
+		if r.lease.IsZero() || !now.After(r.leaseExpires) {
+			continue
+		}
+
+		coordinator.log.Warn("worker lease expired, reassigning range",
+			zap.String("worker", r.worker),
+			zap.Int("index", r.index))
+		r.lease = uuid.UUID{}
+		coordinator.retry(r, Error.New("worker %s lease of range %d expired", r.worker, r.index))
+	}
+}
+
+// retry makes the range available again or fails it when it was attempted
+// too many times. It must be called with mu held.
+func (coordinator *Coordinator) retry(r *coordinatedRange, err error) {
+	if r.attempts >= coordinator.config.MaxRangeAttempts {
+		r.result <- coordinatedResult{err: err}
+		return
+	}
+	r.pending = true
+}
@@ -0,0 +1,203 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop_test
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+	"golang.org/x/sync/errgroup"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/drpc"
+	"storj.io/drpc/drpcconn"
+	"storj.io/drpc/drpcmux"
+	"storj.io/drpc/drpcserver"
+	"storj.io/storj/satellite/internalpb"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+)
+
+func TestDistributedLoop(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	segments := make([]rangedloop.Segment, 30)
+	for i := range segments {
+		segments[i].StreamID = testrand.UUID()
+	}
+	splitter := &rangedlooptest.RangeSplitter{Segments: segments}
+
+	coordinatorCount := &rangedlooptest.CountObserver{}
+	service, newWorker := startCoordinator(ctx, t, splitter, coordinatorCount)
+
+	var durations []rangedloop.ObserverDuration
+	var run errgroup.Group
+	run.Go(func() (err error) {
+		durations, err = service.RunOnce(ctx)
+		return err
+	})
+
+	stallWorker(ctx, t, newWorker, splitter)
+
+	workersCtx, workersCancel := context.WithCancel(ctx)
+	var workers errgroup.Group
+	for i := 0; i < 3; i++ {
+		worker := newWorker(splitter)
+		workers.Go(func() error {
+			return worker.Run(workersCtx)
+		})
+	}
+
+	require.NoError(t, run.Wait())
+	workersCancel()
+	require.ErrorIs(t, workers.Wait(), context.Canceled)
+
+	require.Equal(t, len(segments), coordinatorCount.NumSegments)
+	require.Len(t, durations, 1)
+}
+
+func TestDistributedLoopNonResumableObserver(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	segments := make([]rangedloop.Segment, 30)
+	for i := range segments {
+		segments[i].StreamID = testrand.UUID()
+	}
+	splitter := &rangedlooptest.RangeSplitter{Segments: segments}
+
+	count := &rangedlooptest.CountObserver{}
+	var processed atomic.Int64
+	callback := &rangedlooptest.CallbackObserver{
+		OnProcess: func(ctx context.Context, segments []rangedloop.Segment) error {
+			processed.Add(int64(len(segments)))
+			return nil
+		},
+	}
+	service, _ := startCoordinator(ctx, t, splitter, count, callback)
+
+	// without any workers the ranges are processed locally.
+	durations, err := service.RunOnce(ctx)
+	require.NoError(t, err)
+	require.Len(t, durations, 2)
+	for _, duration := range durations {
+		require.GreaterOrEqual(t, duration.Duration, time.Duration(0))
+	}
+	require.Equal(t, len(segments), count.NumSegments)
+	require.EqualValues(t, len(segments), processed.Load())
+}
+
+func TestDistributedLoopWorkersGone(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	segments := make([]rangedloop.Segment, 30)
+	for i := range segments {
+		segments[i].StreamID = testrand.UUID()
+	}
+	splitter := &rangedlooptest.RangeSplitter{Segments: segments}
+
+	service, newWorker := startCoordinator(ctx, t, splitter, &rangedlooptest.CountObserver{})
+
+	var run errgroup.Group
+	run.Go(func() (err error) {
+		_, err = service.RunOnce(ctx)
+		return err
+	})
+
+	// the only worker dies, and nobody asks for the ranges anymore.
+	stallWorker(ctx, t, newWorker, splitter)
+
+	require.ErrorContains(t, run.Wait(), "no worker asked for range")
+}
+
+// startCoordinator creates a distributed ranged loop service with the
+// coordinator served over DRPC, and returns a function to create workers.
+func startCoordinator(ctx *testcontext.Context, t *testing.T, splitter rangedloop.RangeSplitter, observers ...rangedloop.Observer) (*rangedloop.Service, func(rangedloop.RangeSplitter) *rangedloop.Worker) {
+	service := rangedloop.NewService(zaptest.NewLogger(t), rangedloop.Config{
+		BatchSize:   2,
+		Parallelism: 4,
+		Distributed: rangedloop.DistributedConfig{
+			Enabled:          true,
+			LeaseTimeout:     500 * time.Millisecond,
+			MaxRangeAttempts: 3,
+			ComeBackIn:       10 * time.Millisecond,
+		},
+	}, splitter, observers)
+
+	mux := drpcmux.New()
+	require.NoError(t, internalpb.DRPCRegisterRangedLoopCoordinator(mux, service.Coordinator()))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	serverCtx, serverCancel := context.WithCancel(ctx)
+	t.Cleanup(serverCancel)
+	ctx.Go(func() error {
+		return drpcserver.New(mux).Serve(serverCtx, listener)
+	})
+
+	dial := func(ctx context.Context) (drpc.Conn, error) {
+		conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", listener.Addr().String())
+		if err != nil {
+			return nil, err
+		}
+		return drpcconn.New(conn), nil
+	}
+
+	return service, func(splitter rangedloop.RangeSplitter) *rangedloop.Worker {
+		return rangedloop.NewWorker(zaptest.NewLogger(t), rangedloop.WorkerConfig{
+			HeartbeatInterval: 20 * time.Millisecond,
+			RetryInterval:     10 * time.Millisecond,
+		}, dial, splitter, []rangedloop.Observer{
+			&rangedlooptest.CountObserver{},
+		})
+	}
+}
+
+// stallWorker makes a worker take a range and die in the middle of it.
+func stallWorker(ctx context.Context, t *testing.T, newWorker func(rangedloop.RangeSplitter) *rangedloop.Worker, splitter rangedloop.RangeSplitter) {
+	stalledCtx, stall := context.WithCancel(ctx)
+	worker := newWorker(&stallingSplitter{RangeSplitter: splitter, stall: stall})
+	for {
+		comeBackIn, err := worker.ProcessNext(stalledCtx)
+		if err != nil {
+			require.ErrorIs(t, err, context.Canceled)
+			return
+		}
+		time.Sleep(comeBackIn)
+	}
+}
+
+// stallingSplitter creates ranges, which block until the context is canceled.
+type stallingSplitter struct {
+	rangedloop.RangeSplitter
+	stall func()
+}
+
+func (splitter *stallingSplitter) CreateRanges(nRanges int, batchSize int) ([]rangedloop.SegmentProvider, error) {
+	providers, err := splitter.RangeSplitter.CreateRanges(nRanges, batchSize)
+	if err != nil {
+		return nil, err
+	}
+	for i, provider := range providers {
+		providers[i] = &stallingProvider{SegmentProvider: provider, stall: splitter.stall}
+	}
+	return providers, nil
+}
+
+type stallingProvider struct {
+	rangedloop.SegmentProvider
+	stall func()
+}
+
+func (provider *stallingProvider) Iterate(ctx context.Context, fn func([]rangedloop.Segment) error) error {
+	provider.stall()
+	<-ctx.Done()
+	return ctx.Err()
+}
@@ -4,6 +4,9 @@
 package rangedloop
 
 import (
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/shared/modular/config"
 	"storj.io/storj/shared/mud"
@@ -18,6 +21,10 @@ func Module(ball *mud.Ball) {
 		return NewLiveCountObserver(db, cfg.SuspiciousProcessedRatio, cfg.AsOfSystemInterval)
 	})
 	mud.Provide[*RunOnce](ball, NewRunOnce)
+	mud.Provide[*Worker](ball, func(log *zap.Logger, dialer rpc.Dialer, provider RangeSplitter, observers []Observer, cfg WorkerConfig) *Worker {
+		return NewWorker(log, cfg, DialNodeURL(dialer, cfg.CoordinatorAddress), provider, observers)
+	})
+	config.RegisterConfig[WorkerConfig](ball, "ranged-loop-worker")
 	config.RegisterConfig[Config](ball, "ranged-loop")
 	mud.RegisterImplementation[[]Observer](ball)
 	mud.Implementation[[]Observer, *LiveCountObserver](ball)
@@ -56,6 +56,37 @@ type Observer interface {
 	Finish(context.Context) error
 }
 
+// ResumableObserver is an Observer whose partial state can be persisted, so
+// that an interrupted loop run continues where it stopped instead of starting
+// over. When a run is resumed, Start is called with the start time of the
+// interrupted run.
+type ResumableObserver interface {
+	Observer
+
+	// SavePartial serializes the state of a Partial returned by Fork or
+	// RestorePartial. It is called between the calls to Process of the
+	// partial and not concurrently with them.
+	SavePartial(context.Context, Partial) ([]byte, error)
+
+	// RestorePartial recreates a Partial from the state returned by
+	// SavePartial. It is called after Start instead of Fork for the ranges
+	// which made progress before the run was interrupted, and for the ranges
+	// processed by workers. It is not called concurrently with Fork or with
+	// other calls of RestorePartial.
+	RestorePartial(context.Context, []byte) (Partial, error)
+}
+
+// LocalObserver is a ResumableObserver whose partials can only be created by
+// the process which called Start, e.g. because they depend on parameters
+// chosen randomly in Start. The partials can be checkpointed, but the ranges
+// of such observers aren't processed by the workers.
+type LocalObserver interface {
+	ResumableObserver
+
+	// LocalOnly marks the observer as local.
+	LocalOnly()
+}
+
 // Partial processes a part of the total range of segments.
 type Partial interface {
 	// Process is called repeatedly with batches of segments.
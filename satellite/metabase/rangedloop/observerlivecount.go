@@ -5,6 +5,7 @@ package rangedloop
 
 import (
 	"context"
+	"strconv"
 	"sync/atomic"
 	"time"
 
@@ -14,8 +15,8 @@ import (
 )
 
 var _ monkit.StatSource = (*LiveCountObserver)(nil)
-var _ Observer = (*LiveCountObserver)(nil)
-var _ Partial = (*LiveCountObserver)(nil)
+var _ ResumableObserver = (*LiveCountObserver)(nil)
+var _ Partial = (*liveCountFork)(nil)
 
 // LiveCountObserver reports a count of segments during loop execution.
 // This can be used to report the rate and progress of the loop.
@@ -60,22 +61,35 @@ func (o *LiveCountObserver) Start(ctx context.Context, startTime time.Time) (err
 	return nil
 }
 
-// Fork returns a shared instance so we have a view of all loop ranges.
+// Fork returns a partial, which updates the shared count, so we have a view
+// of all loop ranges.
 func (o *LiveCountObserver) Fork(ctx context.Context) (Partial, error) {
-	return o, nil
+	return &liveCountFork{observer: o}, nil
 }
 
-// Join does nothing because the instance is shared across ranges.
+// Join does nothing because the count is shared across ranges.
 func (o *LiveCountObserver) Join(ctx context.Context, partial Partial) error {
 	return nil
 }
 
-// Process increments the counter.
-func (o *LiveCountObserver) Process(ctx context.Context, segments []Segment) error {
-	processed := atomic.AddInt64(&o.segmentsProcessed, int64(len(segments)))
+// SavePartial implements ResumableObserver.
+func (o *LiveCountObserver) SavePartial(ctx context.Context, partial Partial) ([]byte, error) {
+	fork, ok := partial.(*liveCountFork)
+	if !ok {
+		return nil, Error.New("expected %T but got %T", fork, partial)
+	}
+	return strconv.AppendInt(nil, fork.segmentsProcessed, 10), nil
+}
 
-	mon.IntVal("segmentsProcessed").Observe(processed)
-	return nil
+// RestorePartial implements ResumableObserver. The segments processed by the
+// restored partial are added to the shared count.
+func (o *LiveCountObserver) RestorePartial(ctx context.Context, data []byte) (Partial, error) {
+	processed, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	atomic.AddInt64(&o.segmentsProcessed, processed)
+	return &liveCountFork{observer: o, segmentsProcessed: processed}, nil
 }
 
 // Finish gets segments count after range execution and verifies them against
@@ -99,6 +113,21 @@ func (o *LiveCountObserver) Stats(cb func(key monkit.SeriesKey, field string, va
 	cb(monkit.NewSeriesKey("rangedloop_live"), "num_segments", float64(atomic.LoadInt64(&o.segmentsProcessed)))
 }
 
+// liveCountFork counts the segments of a range, and updates the shared count.
+type liveCountFork struct {
+	observer          *LiveCountObserver
+	segmentsProcessed int64
+}
+
+// Process increments the counter.
+func (fork *liveCountFork) Process(ctx context.Context, segments []Segment) error {
+	fork.segmentsProcessed += int64(len(segments))
+	processed := atomic.AddInt64(&fork.observer.segmentsProcessed, int64(len(segments)))
+
+	mon.IntVal("segmentsProcessed").Observe(processed)
+	return nil
+}
+
 func (o *LiveCountObserver) verifyCount(before, after, processed int64) error {
 	low, high := before, after
 	if low > high {
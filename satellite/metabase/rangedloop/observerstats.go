@@ -5,6 +5,7 @@ package rangedloop
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 	"time"
@@ -76,7 +77,7 @@ func observerName(o Observer) string {
 	return name
 }
 
-var _ Observer = (*segmentsCountValidation)(nil)
+var _ ResumableObserver = (*segmentsCountValidation)(nil)
 var _ Partial = (*segmentsCountValidationFork)(nil)
 
 type segmentsCountValidation struct {
@@ -147,6 +148,21 @@ func (s *segmentsCountValidation) Finish(ctx context.Context) error {
 	return nil
 }
 
+func (s *segmentsCountValidation) SavePartial(ctx context.Context, partial Partial) ([]byte, error) {
+	return json.Marshal(partial.(*segmentsCountValidationFork).count)
+}
+
+func (s *segmentsCountValidation) RestorePartial(ctx context.Context, data []byte) (Partial, error) {
+	fork := &segmentsCountValidationFork{}
+	if err := json.Unmarshal(data, &fork.count); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if fork.count == nil {
+		fork.count = make(map[string]int64)
+	}
+	return fork, nil
+}
+
 type segmentsCountValidationFork struct {
 	count map[string]int64
 }
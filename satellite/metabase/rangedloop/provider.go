@@ -5,6 +5,9 @@ package rangedloop
 
 import (
 	"context"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
 )
 
 // RangeSplitter splits a source of segments into ranges,
@@ -20,3 +23,12 @@ type SegmentProvider interface {
 	Range() UUIDRange
 	Iterate(ctx context.Context, fn func([]Segment) error) error
 }
+
+// ResumableSegmentProvider is a SegmentProvider which can continue the
+// iteration of an interrupted loop run.
+type ResumableSegmentProvider interface {
+	SegmentProvider
+	// IterateAfter iterates through the segments of the range which come
+	// after the segment with the specified stream ID and position.
+	IterateAfter(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, fn func([]Segment) error) error
+}
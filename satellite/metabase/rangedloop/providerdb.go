@@ -22,6 +22,8 @@ type MetabaseRangeSplitter struct {
 	overrideSpannerReadTimestamp time.Time
 }
 
+var _ ResumableSegmentProvider = (*MetabaseSegmentProvider)(nil)
+
 // MetabaseSegmentProvider implements SegmentProvider.
 type MetabaseSegmentProvider struct {
 	db *metabase.DB
@@ -85,11 +87,20 @@ func (provider *MetabaseSegmentProvider) Range() UUIDRange {
 // Iterate loops over a part of the segment table.
 func (provider *MetabaseSegmentProvider) Iterate(ctx context.Context, fn func([]Segment) error) error {
 	var startStreamID uuid.UUID
-	var endStreamID uuid.UUID
-
 	if provider.uuidRange.Start != nil {
 		startStreamID = *provider.uuidRange.Start
 	}
+
+	return provider.iterate(ctx, startStreamID, nil, fn)
+}
+
+// IterateAfter loops over the part of the segment table which comes after the specified segment.
+func (provider *MetabaseSegmentProvider) IterateAfter(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, fn func([]Segment) error) error {
+	return provider.iterate(ctx, streamID, &position, fn)
+}
+
+func (provider *MetabaseSegmentProvider) iterate(ctx context.Context, startStreamID uuid.UUID, startPosition *metabase.SegmentPosition, fn func([]Segment) error) error {
+	var endStreamID uuid.UUID
 	if provider.uuidRange.End != nil {
 		endStreamID = *provider.uuidRange.End
 	}
@@ -98,6 +109,7 @@ func (provider *MetabaseSegmentProvider) Iterate(ctx context.Context, fn func([]
 		BatchSize:            provider.batchSize,
 		AsOfSystemInterval:   provider.asOfSystemInterval,
 		StartStreamID:        startStreamID,
+		StartPosition:        startPosition,
 		EndStreamID:          endStreamID,
 		SpannerReadTimestamp: provider.spannerReadTimestamp,
 		SpannerQueryType:     provider.spannerQueryType,
@@ -0,0 +1,459 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+)
+
+// ErrSnapshot is the error class for segment snapshot files.
+var ErrSnapshot = errs.Class("segment snapshot")
+
+// snapshotMagic starts every snapshot file.
+const snapshotMagic = "RLSNAPv1"
+
+// SnapshotSource is the segment source of segments read from a snapshot.
+const SnapshotSource = "snapshot"
+
+// Record types of the snapshot file.
+const (
+	snapshotRecordEnd       = byte('e')
+	snapshotRecordNodeAlias = byte('n')
+	snapshotRecordSegment   = byte('s')
+)
+
+const (
+	snapshotFlagExpiresAt = 1 << iota
+	snapshotFlagRepairedAt
+)
+
+// SnapshotWriter writes segments to a snapshot file. The snapshot contains
+// the node aliases used by the segments, so that the pieces can be resolved
+// without the metabase.
+//
+// Segments should be written in the order of IterateLoopSegments.
+type SnapshotWriter struct {
+	w       *bufio.Writer
+	aliases map[metabase.NodeAlias]struct{}
+	buf     []byte
+}
+
+// NewSnapshotWriter starts a new snapshot.
+func NewSnapshotWriter(w io.Writer) (*SnapshotWriter, error) {
+	writer := &SnapshotWriter{
+		w:       bufio.NewWriter(w),
+		aliases: map[metabase.NodeAlias]struct{}{},
+	}
+	if _, err := writer.w.WriteString(snapshotMagic); err != nil {
+		return nil, ErrSnapshot.Wrap(err)
+	}
+	return writer, nil
+}
+
+// WriteNodeAlias adds the node alias to the snapshot, when it wasn't added yet.
+func (writer *SnapshotWriter) WriteNodeAlias(entry metabase.NodeAliasEntry) error {
+	if _, ok := writer.aliases[entry.Alias]; ok {
+		return nil
+	}
+	writer.aliases[entry.Alias] = struct{}{}
+
+	buf := append(writer.buf[:0], snapshotRecordNodeAlias)
+	buf = binary.AppendUvarint(buf, uint64(entry.Alias))
+	buf = append(buf, entry.ID.Bytes()...)
+	writer.buf = buf
+
+	_, err := writer.w.Write(buf)
+	return ErrSnapshot.Wrap(err)
+}
+
+// WriteSegment adds the segment to the snapshot.
+func (writer *SnapshotWriter) WriteSegment(segment *metabase.LoopSegmentEntry) error {
+	if len(segment.Pieces) != len(segment.AliasPieces) {
+		return ErrSnapshot.New("segment %s/%d pieces don't match alias pieces", segment.StreamID, segment.Position.Encode())
+	}
+	for i, piece := range segment.AliasPieces {
+		err := writer.WriteNodeAlias(metabase.NodeAliasEntry{
+			ID:    segment.Pieces[i].StorageNode,
+			Alias: piece.Alias,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	var flags byte
+	if segment.ExpiresAt != nil {
+		flags |= snapshotFlagExpiresAt
+	}
+	if segment.RepairedAt != nil {
+		flags |= snapshotFlagRepairedAt
+	}
+
+	buf := append(writer.buf[:0], snapshotRecordSegment)
+	buf = append(buf, segment.StreamID[:]...)
+	buf = binary.AppendUvarint(buf, segment.Position.Encode())
+	buf = binary.AppendVarint(buf, segment.CreatedAt.UnixNano())
+	buf = append(buf, flags)
+	if segment.ExpiresAt != nil {
+		buf = binary.AppendVarint(buf, segment.ExpiresAt.UnixNano())
+	}
+	if segment.RepairedAt != nil {
+		buf = binary.AppendVarint(buf, segment.RepairedAt.UnixNano())
+	}
+	buf = append(buf, segment.RootPieceID[:]...)
+	buf = binary.AppendVarint(buf, int64(segment.EncryptedSize))
+	buf = binary.AppendVarint(buf, segment.PlainOffset)
+	buf = binary.AppendVarint(buf, int64(segment.PlainSize))
+
+	buf = append(buf, byte(segment.Redundancy.Algorithm))
+	buf = binary.AppendVarint(buf, int64(segment.Redundancy.ShareSize))
+	buf = binary.AppendVarint(buf, int64(segment.Redundancy.RequiredShares))
+	buf = binary.AppendVarint(buf, int64(segment.Redundancy.RepairShares))
+	buf = binary.AppendVarint(buf, int64(segment.Redundancy.OptimalShares))
+	buf = binary.AppendVarint(buf, int64(segment.Redundancy.TotalShares))
+
+	buf = binary.AppendUvarint(buf, uint64(segment.Placement))
+
+	buf = binary.AppendUvarint(buf, uint64(len(segment.AliasPieces)))
+	for _, piece := range segment.AliasPieces {
+		buf = binary.AppendUvarint(buf, uint64(piece.Number))
+		buf = binary.AppendUvarint(buf, uint64(piece.Alias))
+	}
+	writer.buf = buf
+
+	_, err := writer.w.Write(buf)
+	return ErrSnapshot.Wrap(err)
+}
+
+// Close finishes the snapshot. It doesn't close the underlying writer.
+func (writer *SnapshotWriter) Close() error {
+	if err := writer.w.WriteByte(snapshotRecordEnd); err != nil {
+		return ErrSnapshot.Wrap(err)
+	}
+	return ErrSnapshot.Wrap(writer.w.Flush())
+}
+
+// SnapshotReader reads segments from a snapshot file.
+type SnapshotReader struct {
+	r       *bufio.Reader
+	aliases map[metabase.NodeAlias]storj.NodeID
+}
+
+// NewSnapshotReader starts reading a snapshot.
+func NewSnapshotReader(r io.Reader) (*SnapshotReader, error) {
+	reader := &SnapshotReader{
+		r:       bufio.NewReader(r),
+		aliases: map[metabase.NodeAlias]storj.NodeID{},
+	}
+
+	var magic [len(snapshotMagic)]byte
+	if _, err := io.ReadFull(reader.r, magic[:]); err != nil {
+		return nil, ErrSnapshot.Wrap(err)
+	}
+	if string(magic[:]) != snapshotMagic {
+		return nil, ErrSnapshot.New("not a segment snapshot")
+	}
+	return reader, nil
+}
+
+// NodeAliases returns the node aliases read so far.
+func (reader *SnapshotReader) NodeAliases() []metabase.NodeAliasEntry {
+	entries := make([]metabase.NodeAliasEntry, 0, len(reader.aliases))
+	for alias, id := range reader.aliases {
+		entries = append(entries, metabase.NodeAliasEntry{ID: id, Alias: alias})
+	}
+	return entries
+}
+
+// Next reads the next segment. It returns false at the end of the snapshot.
+func (reader *SnapshotReader) Next(segment *Segment) (_ bool, err error) {
+	for {
+		recordType, err := reader.r.ReadByte()
+		if err != nil {
+			if errs.Is(err, io.EOF) {
+				return false, ErrSnapshot.New("snapshot is truncated")
+			}
+			return false, ErrSnapshot.Wrap(err)
+		}
+
+		switch recordType {
+		case snapshotRecordEnd:
+			return false, nil
+		case snapshotRecordNodeAlias:
+			if err := reader.readNodeAlias(); err != nil {
+				return false, ErrSnapshot.Wrap(err)
+			}
+		case snapshotRecordSegment:
+			if err := reader.readSegment(segment); err != nil {
+				return false, ErrSnapshot.Wrap(err)
+			}
+			return true, nil
+		default:
+			return false, ErrSnapshot.New("unknown record type %q", recordType)
+		}
+	}
+}
+
+func (reader *SnapshotReader) readNodeAlias() error {
+	alias, err := binary.ReadUvarint(reader.r)
+	if err != nil {
+		return err
+	}
+	var id storj.NodeID
+	if _, err := io.ReadFull(reader.r, id[:]); err != nil {
+		return err
+	}
+	reader.aliases[metabase.NodeAlias(alias)] = id
+	return nil
+}
+
+func (reader *SnapshotReader) readSegment(segment *Segment) (err error) {
+	*segment = Segment{Source: SnapshotSource}
+
+	// errors are checked once at the end, every read after a failed one fails too.
+	readUvarint := func() uint64 {
+		if err != nil {
+			return 0
+		}
+		var v uint64
+		v, err = binary.ReadUvarint(reader.r)
+		return v
+	}
+	readVarint := func() int64 {
+		if err != nil {
+			return 0
+		}
+		var v int64
+		v, err = binary.ReadVarint(reader.r)
+		return v
+	}
+	readBytes := func(p []byte) {
+		if err != nil {
+			return
+		}
+		_, err = io.ReadFull(reader.r, p)
+	}
+	readByte := func() byte {
+		var b [1]byte
+		readBytes(b[:])
+		return b[0]
+	}
+	readTime := func() time.Time {
+		return time.Unix(0, readVarint()).UTC()
+	}
+
+	readBytes(segment.StreamID[:])
+	segment.Position = metabase.SegmentPositionFromEncoded(readUvarint())
+	segment.CreatedAt = readTime()
+	flags := readByte()
+	if flags&snapshotFlagExpiresAt != 0 {
+		expiresAt := readTime()
+		segment.ExpiresAt = &expiresAt
+	}
+	if flags&snapshotFlagRepairedAt != 0 {
+		repairedAt := readTime()
+		segment.RepairedAt = &repairedAt
+	}
+	readBytes(segment.RootPieceID[:])
+	segment.EncryptedSize = int32(readVarint())
+	segment.PlainOffset = readVarint()
+	segment.PlainSize = int32(readVarint())
+
+	segment.Redundancy.Algorithm = storj.RedundancyAlgorithm(readByte())
+	segment.Redundancy.ShareSize = int32(readVarint())
+	segment.Redundancy.RequiredShares = int16(readVarint())
+	segment.Redundancy.RepairShares = int16(readVarint())
+	segment.Redundancy.OptimalShares = int16(readVarint())
+	segment.Redundancy.TotalShares = int16(readVarint())
+
+	segment.Placement = storj.PlacementConstraint(readUvarint())
+
+	count := readUvarint()
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		segment.AliasPieces = make(metabase.AliasPieces, count)
+		segment.Pieces = make(metabase.Pieces, count)
+	}
+	for i := range segment.AliasPieces {
+		number := uint16(readUvarint())
+		alias := metabase.NodeAlias(readUvarint())
+		if err != nil {
+			return err
+		}
+
+		id, ok := reader.aliases[alias]
+		if !ok {
+			return errs.New("unknown node alias %d", alias)
+		}
+		segment.AliasPieces[i] = metabase.AliasPiece{Number: number, Alias: alias}
+		segment.Pieces[i] = metabase.Piece{Number: number, StorageNode: id}
+	}
+	return err
+}
+
+var _ RangeSplitter = (*SnapshotRangeSplitter)(nil)
+
+// SnapshotRangeSplitter implements RangeSplitter for a snapshot file. It's
+// meant to replay the segments of a satellite offline.
+//
+// Every range reads the whole file and skips the segments of other ranges.
+// IterateAfter relies on the segments being sorted by stream ID and position,
+// as written from IterateLoopSegments.
+type SnapshotRangeSplitter struct {
+	path string
+}
+
+// NewSnapshotRangeSplitter creates a range splitter for the snapshot at path.
+func NewSnapshotRangeSplitter(path string) *SnapshotRangeSplitter {
+	return &SnapshotRangeSplitter{path: path}
+}
+
+// CreateRanges splits the snapshot into ranges of stream IDs.
+func (splitter *SnapshotRangeSplitter) CreateRanges(nRanges int, batchSize int) ([]SegmentProvider, error) {
+	uuidRanges, err := CreateUUIDRanges(uint32(nRanges))
+	if err != nil {
+		return nil, err
+	}
+
+	rangeProviders := []SegmentProvider{}
+	for _, uuidRange := range uuidRanges {
+		rangeProviders = append(rangeProviders, &SnapshotSegmentProvider{
+			path:      splitter.path,
+			uuidRange: uuidRange,
+			batchSize: batchSize,
+		})
+	}
+	return rangeProviders, nil
+}
+
+// NodeAliases returns all node aliases of the snapshot, e.g. to create a
+// metabase.NodeAliasMap for observers which use alias pieces.
+func (splitter *SnapshotRangeSplitter) NodeAliases(ctx context.Context) (_ []metabase.NodeAliasEntry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	file, err := os.Open(splitter.path)
+	if err != nil {
+		return nil, ErrSnapshot.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	reader, err := NewSnapshotReader(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var segment Segment
+	for {
+		ok, err := reader.Next(&segment)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return reader.NodeAliases(), nil
+		}
+	}
+}
+
+var _ ResumableSegmentProvider = (*SnapshotSegmentProvider)(nil)
+
+// SnapshotSegmentProvider implements SegmentProvider for a range of a snapshot file.
+type SnapshotSegmentProvider struct {
+	path      string
+	uuidRange UUIDRange
+	batchSize int
+}
+
+// Range returns range which is processed by this provider.
+func (provider *SnapshotSegmentProvider) Range() UUIDRange {
+	return provider.uuidRange
+}
+
+// Iterate loops over the segments of the range.
+func (provider *SnapshotSegmentProvider) Iterate(ctx context.Context, fn func([]Segment) error) error {
+	return provider.iterate(ctx, func(segment *Segment) bool {
+		return provider.uuidRange.Start == nil || provider.uuidRange.Start.Less(segment.StreamID)
+	}, fn)
+}
+
+// IterateAfter loops over the segments of the range which come after the specified segment.
+func (provider *SnapshotSegmentProvider) IterateAfter(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, fn func([]Segment) error) error {
+	return provider.iterate(ctx, func(segment *Segment) bool {
+		switch segment.StreamID.Compare(streamID) {
+		case 0:
+			return position.Less(segment.Position)
+		default:
+			return streamID.Less(segment.StreamID)
+		}
+	}, fn)
+}
+
+func (provider *SnapshotSegmentProvider) iterate(ctx context.Context, afterStart func(*Segment) bool, fn func([]Segment) error) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	file, err := os.Open(provider.path)
+	if err != nil {
+		return ErrSnapshot.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, file.Close()) }()
+
+	reader, err := NewSnapshotReader(file)
+	if err != nil {
+		return err
+	}
+
+	batchSize := provider.batchSize
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+
+	segments := make([]Segment, 0, batchSize)
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		segment := Segment{}
+		ok, err := reader.Next(&segment)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			break
+		}
+
+		if !afterStart(&segment) {
+			continue
+		}
+		if provider.uuidRange.End != nil && provider.uuidRange.End.Less(segment.StreamID) {
+			continue
+		}
+
+		segments = append(segments, segment)
+		if len(segments) >= batchSize {
+			if err := fn(segments); err != nil {
+				return err
+			}
+			segments = segments[:0]
+		}
+	}
+
+	// send last batch
+	if len(segments) > 0 {
+		return fn(segments)
+	}
+	return nil
+}
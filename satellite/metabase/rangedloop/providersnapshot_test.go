@@ -0,0 +1,170 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metabase/rangedloop/rangedlooptest"
+)
+
+func TestSnapshotSegmentProvider(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	nodes := []storj.NodeID{testrand.NodeID(), testrand.NodeID(), testrand.NodeID()}
+	now := time.Now().UTC()
+	expiresAt := now.Add(time.Hour)
+
+	var segments []metabase.LoopSegmentEntry
+	for i := 0; i < 20; i++ {
+		streamID := testrand.UUID()
+		for part := uint32(0); part < 2; part++ {
+			segment := metabase.LoopSegmentEntry{
+				StreamID:      streamID,
+				Position:      metabase.SegmentPosition{Part: part, Index: uint32(i)},
+				CreatedAt:     now,
+				RootPieceID:   testrand.PieceID(),
+				EncryptedSize: 1024,
+				PlainOffset:   int64(part) * 1000,
+				PlainSize:     1000,
+				Placement:     storj.PlacementConstraint(i % 3),
+				Source:        rangedloop.SnapshotSource,
+			}
+			if i%2 == 0 {
+				segment.ExpiresAt = &expiresAt
+				segment.RepairedAt = &now
+				segment.Redundancy = storj.RedundancyScheme{
+					Algorithm:      storj.ReedSolomon,
+					ShareSize:      256,
+					RequiredShares: 1,
+					RepairShares:   2,
+					OptimalShares:  2,
+					TotalShares:    3,
+				}
+				for number, node := range nodes {
+					segment.AliasPieces = append(segment.AliasPieces, metabase.AliasPiece{
+						Number: uint16(number),
+						Alias:  metabase.NodeAlias(number + 1),
+					})
+					segment.Pieces = append(segment.Pieces, metabase.Piece{
+						Number:      uint16(number),
+						StorageNode: node,
+					})
+				}
+			}
+			segments = append(segments, segment)
+		}
+	}
+	sort.Slice(segments, func(i, k int) bool {
+		if segments[i].StreamID == segments[k].StreamID {
+			return segments[i].Position.Less(segments[k].Position)
+		}
+		return segments[i].StreamID.Less(segments[k].StreamID)
+	})
+
+	var buf bytes.Buffer
+	writer, err := rangedloop.NewSnapshotWriter(&buf)
+	require.NoError(t, err)
+	for i := range segments {
+		require.NoError(t, writer.WriteSegment(&segments[i]))
+	}
+	require.NoError(t, writer.Close())
+
+	path := ctx.File("segments.snapshot")
+	require.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+
+	splitter := rangedloop.NewSnapshotRangeSplitter(path)
+
+	aliases, err := splitter.NodeAliases(ctx)
+	require.NoError(t, err)
+	require.Len(t, aliases, len(nodes))
+
+	t.Run("ranges", func(t *testing.T) {
+		providers, err := splitter.CreateRanges(3, 4)
+		require.NoError(t, err)
+
+		var read []metabase.LoopSegmentEntry
+		for _, provider := range providers {
+			err := provider.Iterate(ctx, func(batch []rangedloop.Segment) error {
+				require.LessOrEqual(t, len(batch), 4)
+				for _, segment := range batch {
+					uuidRange := provider.Range()
+					if uuidRange.Start != nil {
+						require.True(t, uuidRange.Start.Less(segment.StreamID))
+					}
+					if uuidRange.End != nil {
+						require.False(t, uuidRange.End.Less(segment.StreamID))
+					}
+					read = append(read, metabase.LoopSegmentEntry(segment))
+				}
+				return nil
+			})
+			require.NoError(t, err)
+		}
+		require.Equal(t, segments, read)
+	})
+
+	t.Run("after", func(t *testing.T) {
+		providers, err := splitter.CreateRanges(1, 100)
+		require.NoError(t, err)
+
+		after := segments[10]
+		var read []metabase.LoopSegmentEntry
+		err = providers[0].(rangedloop.ResumableSegmentProvider).IterateAfter(ctx, after.StreamID, after.Position, func(batch []rangedloop.Segment) error {
+			for _, segment := range batch {
+				read = append(read, metabase.LoopSegmentEntry(segment))
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, segments[11:], read)
+	})
+
+	t.Run("loop", func(t *testing.T) {
+		observer := &rangedlooptest.CountObserver{}
+		service := rangedloop.NewService(zaptest.NewLogger(t), rangedloop.Config{
+			BatchSize:   3,
+			Parallelism: 4,
+		}, splitter, []rangedloop.Observer{observer})
+
+		_, err := service.RunOnce(ctx)
+		require.NoError(t, err)
+		require.Equal(t, len(segments), observer.NumSegments)
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		truncated := ctx.File("truncated.snapshot")
+		require.NoError(t, os.WriteFile(truncated, buf.Bytes()[:buf.Len()/2], 0644))
+
+		providers, err := rangedloop.NewSnapshotRangeSplitter(truncated).CreateRanges(1, 10)
+		require.NoError(t, err)
+
+		err = providers[0].Iterate(ctx, func([]rangedloop.Segment) error { return nil })
+		require.Error(t, err)
+		require.True(t, rangedloop.ErrSnapshot.Has(err))
+	})
+
+	t.Run("canceled", func(t *testing.T) {
+		providers, err := splitter.CreateRanges(1, 10)
+		require.NoError(t, err)
+
+		canceledCtx, cancel := context.WithCancel(ctx)
+		cancel()
+		err = providers[0].Iterate(canceledCtx, func([]rangedloop.Segment) error { return nil })
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
@@ -5,12 +5,13 @@ package rangedlooptest
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"storj.io/storj/satellite/metabase/rangedloop"
 )
 
-var _ rangedloop.Observer = (*CountObserver)(nil)
+var _ rangedloop.ResumableObserver = (*CountObserver)(nil)
 var _ rangedloop.Partial = (*CountObserver)(nil)
 
 // CountObserver is a subscriber to the ranged segment  loop which counts the number of segments.
@@ -48,3 +49,17 @@ func (c *CountObserver) Process(ctx context.Context, segments []rangedloop.Segme
 	c.NumSegments += len(segments)
 	return nil
 }
+
+// SavePartial serializes the count of a range.
+func (c *CountObserver) SavePartial(ctx context.Context, partial rangedloop.Partial) ([]byte, error) {
+	return strconv.AppendInt(nil, int64(partial.(*CountObserver).NumSegments), 10), nil
+}
+
+// RestorePartial recreates the count of a range.
+func (c *CountObserver) RestorePartial(ctx context.Context, data []byte) (rangedloop.Partial, error) {
+	numSegments, err := strconv.Atoi(string(data))
+	if err != nil {
+		return nil, err
+	}
+	return &CountObserver{NumSegments: numSegments}, nil
+}
@@ -8,6 +8,8 @@ import (
 	"math"
 	"sort"
 
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
 )
 
@@ -18,7 +20,7 @@ type RangeSplitter struct {
 	Segments []rangedloop.Segment
 }
 
-var _ rangedloop.SegmentProvider = (*SegmentProvider)(nil)
+var _ rangedloop.ResumableSegmentProvider = (*SegmentProvider)(nil)
 
 // SegmentProvider allows to iterate over segments from an in-memory source.
 type SegmentProvider struct {
@@ -67,6 +69,27 @@ func (m *SegmentProvider) Iterate(ctx context.Context, fn func([]rangedloop.Segm
 	return nil
 }
 
+// IterateAfter allows to loop over the segments stored in the provider which
+// come after the specified segment.
+func (m *SegmentProvider) IterateAfter(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, fn func([]rangedloop.Segment) error) error {
+	offset := sort.Search(len(m.Segments), func(i int) bool {
+		switch m.Segments[i].StreamID.Compare(streamID) {
+		case -1:
+			return false
+		case 0:
+			return position.Less(m.Segments[i].Position)
+		default:
+			return true
+		}
+	})
+
+	remaining := &SegmentProvider{
+		Segments:  m.Segments[offset:],
+		batchSize: m.batchSize,
+	}
+	return remaining.Iterate(ctx, fn)
+}
+
 func min(x, y int) int {
 	if x < y {
 		return x
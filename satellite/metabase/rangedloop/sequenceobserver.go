@@ -5,16 +5,23 @@ package rangedloop
 
 import (
 	"context"
+	"encoding/json"
 	"time"
 )
 
-var _ Observer = (*SequenceObserver)(nil)
+var _ ResumableObserver = (*SequenceObserver)(nil)
 
 // SequenceObserver provides ability to run observers from the list sequentially through next loop iterations.
+// It can be resumed, when all the observers of the list are resumable.
 // TODO find better name.
 type SequenceObserver struct {
 	observers       []Observer
 	currentObserver int
+
+	// startTime and forked are used to switch to the observer of an
+	// interrupted run, when it's resumed.
+	startTime time.Time
+	forked    bool
 }
 
 // NewSequenceObserver creates new SequenceObserver instance.
@@ -26,12 +33,17 @@ func NewSequenceObserver(observers ...Observer) *SequenceObserver {
 
 // Start passes Start operation to current observer.
 func (o *SequenceObserver) Start(ctx context.Context, startTime time.Time) (err error) {
+	o.startTime = startTime
+	o.forked = false
+
 	observer := o.observers[o.currentObserver]
 	return observer.Start(ctx, startTime)
 }
 
 // Fork passes Fork operation to current observer.
 func (o *SequenceObserver) Fork(ctx context.Context) (Partial, error) {
+	o.forked = true
+
 	observer := o.observers[o.currentObserver]
 	return observer.Fork(ctx)
 }
@@ -52,3 +64,96 @@ func (o *SequenceObserver) Finish(ctx context.Context) (err error) {
 	o.currentObserver = (o.currentObserver + 1) % len(o.observers)
 	return nil
 }
+
+// sequencePartialState is the saved state of a partial of the current observer.
+type sequencePartialState struct {
+	Observer int    `json:"observer"`
+	State    []byte `json:"state"`
+}
+
+// SavePartial passes SavePartial operation to current observer.
+func (o *SequenceObserver) SavePartial(ctx context.Context, partial Partial) ([]byte, error) {
+	observer, err := o.resumable(o.currentObserver)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := observer.SavePartial(ctx, partial)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(sequencePartialState{Observer: o.currentObserver, State: state})
+}
+
+// RestorePartial passes RestorePartial operation to the observer, which was
+// current when the partial was saved. The order of the observers may change
+// between process restarts, so the observer of the interrupted run is
+// started and selected again, when no partial was forked from the current one yet.
+func (o *SequenceObserver) RestorePartial(ctx context.Context, data []byte) (Partial, error) {
+	var saved sequencePartialState
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	observer, err := o.resumable(saved.Observer)
+	if err != nil {
+		return nil, err
+	}
+
+	if saved.Observer != o.currentObserver {
+		if o.forked {
+			return nil, Error.New("partial belongs to observer %d of the sequence instead of %d", saved.Observer, o.currentObserver)
+		}
+		if err := observer.Start(ctx, o.startTime); err != nil {
+			return nil, err
+		}
+		o.currentObserver = saved.Observer
+	}
+	o.forked = true
+
+	return observer.RestorePartial(ctx, saved.State)
+}
+
+// resumable returns the observer at index, if it's resumable.
+func (o *SequenceObserver) resumable(index int) (ResumableObserver, error) {
+	if index < 0 || index >= len(o.observers) {
+		return nil, Error.New("invalid observer index %d", index)
+	}
+	observer, ok := o.observers[index].(ResumableObserver)
+	if !ok {
+		return nil, Error.New("observer %T doesn't support resuming", o.observers[index])
+	}
+	return observer, nil
+}
+
+// isResumable checks whether the observer can save and restore its state.
+func isResumable(observer Observer) bool {
+	if sequence, ok := observer.(*SequenceObserver); ok {
+		for _, observer := range sequence.observers {
+			if !isResumable(observer) {
+				return false
+			}
+		}
+		return true
+	}
+	_, ok := observer.(ResumableObserver)
+	return ok
+}
+
+// isDistributable checks whether the ranges of the observer can be processed
+// by the workers.
+func isDistributable(observer Observer) bool {
+	if sequence, ok := observer.(*SequenceObserver); ok {
+		for _, observer := range sequence.observers {
+			if !isDistributable(observer) {
+				return false
+			}
+		}
+		return true
+	}
+	if _, ok := observer.(LocalObserver); ok {
+		return false
+	}
+	_, ok := observer.(ResumableObserver)
+	return ok
+}
@@ -6,6 +6,7 @@ package rangedloop
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -36,6 +37,11 @@ type Config struct {
 	TestingSpannerQueryType string `help:"use to select query type which will be used to execute ranged loop (sql|read)" default:"" testDefault:"read" hidden:"true"`
 
 	SuspiciousProcessedRatio float64 `help:"ratio where to consider processed count as supicious" default:"0.03"`
+
+	CheckpointPath     string        `help:"path to a file where the progress of the loop is saved, so an interrupted run can be resumed (disabled when empty)" default:""`
+	CheckpointInterval time.Duration `help:"how often to save the progress of a range" default:"5m"`
+
+	Distributed DistributedConfig
 }
 
 // Service iterates through all segments and calls the attached observers for every segment
@@ -47,18 +53,34 @@ type Service struct {
 	provider  RangeSplitter
 	observers []Observer
 
+	checkpoints CheckpointStore
+	coordinator *Coordinator
+
 	Loop *sync2.Cycle
 }
 
 // NewService creates a new instance of the ranged loop service.
 func NewService(log *zap.Logger, config Config, provider RangeSplitter, observers []Observer) *Service {
-	return &Service{
+	service := &Service{
 		log:       log,
 		config:    config,
 		provider:  provider,
 		observers: observers,
 		Loop:      sync2.NewCycle(config.Interval),
 	}
+	if config.CheckpointPath != "" {
+		service.checkpoints = NewFileCheckpointStore(config.CheckpointPath)
+	}
+	if config.Distributed.Enabled {
+		service.coordinator = NewCoordinator(log.Named("coordinator"), config.Distributed)
+	}
+	return service
+}
+
+// Coordinator returns the coordinator of the workers, when the ranges are
+// processed by workers, or nil otherwise.
+func (service *Service) Coordinator() *Coordinator {
+	return service.coordinator
 }
 
 // observerState contains information to manage an observer during a loop iteration.
@@ -72,7 +94,10 @@ type observerState struct {
 
 type rangeObserverState struct {
 	rangeObserver Partial
-	duration      time.Duration
+	// name and resumable are used to save the state of resumable observers.
+	name      string
+	resumable ResumableObserver
+	duration  time.Duration
 	// err is the error that is returned by the observer's Fork or Process method.
 	// If err is set, the range observer will be skipped during the loop iteration.
 	err error
@@ -139,38 +164,120 @@ func (service *Service) RunOnce(ctx context.Context) (observerDurations []Observ
 		}
 	}()
 
-	observerStates, err := startObservers(ctx, service.log, service.observers)
+	checkpoint := service.loadCheckpoint(ctx)
+
+	rangeProviders, err := service.provider.CreateRanges(service.config.Parallelism, service.config.BatchSize)
 	if err != nil {
 		return nil, err
 	}
 
-	rangeProviders, err := service.provider.CreateRanges(service.config.Parallelism, service.config.BatchSize)
+	if checkpoint != nil {
+		var reason string
+		switch {
+		case !canResume(checkpoint, rangeProviders):
+			reason = "checkpoint doesn't match the current ranges"
+		case checkpoint.resumed() && !allResumable(service.log, service.observers):
+			// observers would miss the segments processed before the
+			// interruption, so all of them have to start over.
+			reason = "not all observers support resuming"
+		}
+
+		if reason != "" {
+			service.log.Warn("unable to resume ranged loop, starting over", zap.String("reason", reason))
+			checkpoint = nil
+		}
+	}
+
+	startTime := time.Now()
+	if checkpoint != nil {
+		startTime = checkpoint.StartedAt
+	}
+
+	observerStates, err := startObservers(ctx, service.log, service.observers, startTime)
 	if err != nil {
 		return nil, err
 	}
 
-	group := errs2.Group{}
+	tracker := service.startCheckpoint(ctx, checkpoint, startTime, rangeProviders)
+	if checkpoint != nil && checkpoint.resumed() {
+		service.log.Info("resuming interrupted ranged loop", zap.Time("started_at", startTime))
+		mon.Event("rangedloop_resumed")
+	}
+
+	names := observerNames(service.observers)
+
+	var run *coordinatedRun
+	if service.coordinator != nil && !allDistributable(service.log, service.observers) {
+		// workers can only send back the state of the resumable, non-local
+		// observers.
+		service.log.Warn("not all observers support distributed execution, processing the ranges locally")
+		mon.Event("rangedloop_distributed_fallback")
+	} else if service.coordinator != nil {
+		run, err = service.startCoordinatedRun(startTime, names, observerStates, checkpoint, rangeProviders)
+		if err != nil {
+			return nil, err
+		}
+		defer service.coordinator.finishRun(run)
+	}
+
+	// partials of the workers are restored concurrently, when the ranges
+	// are finished, but observers don't expect concurrent RestorePartial.
+	var partialsMu sync.Mutex
+
+	progresses := make([]RangeCheckpoint, len(rangeProviders))
+	rangeObservers := make([][]*rangeObserverState, len(rangeProviders))
 	for index, rangeProvider := range rangeProviders {
 		uuidRange := rangeProvider.Range()
 		service.log.Debug("creating range", zap.Int("index", index), zap.Stringer("start", uuidRange.Start), zap.Stringer("end", uuidRange.End))
 
-		rangeObservers := []*rangeObserverState{}
+		progresses[index] = RangeCheckpoint{Range: uuidRange}
+		if checkpoint != nil && (run == nil || checkpoint.Ranges[index].Done) {
+			// workers can't continue a range, so only completed ranges are
+			// taken from the checkpoint in the distributed mode.
+			progresses[index] = checkpoint.Ranges[index]
+		}
+
 		for i, observerState := range observerStates {
 			if observerState.err != nil {
 				service.log.Debug("observer returned error", zap.Error(observerState.err))
 				continue
 			}
-			rangeObserver, err := observerState.observer.Fork(ctx)
-			rangeState := &rangeObserverState{
-				rangeObserver: rangeObserver,
-				err:           err,
-			}
-			rangeObservers = append(rangeObservers, rangeState)
+			rangeState := &rangeObserverState{name: names[i]}
+			rangeState.resumable, _ = observerState.observer.(ResumableObserver)
+			rangeObservers[index] = append(rangeObservers[index], rangeState)
 			observerStates[i].rangeObservers = append(observerStates[i].rangeObservers, rangeState)
 		}
+	}
 
-		// Create closure to capture loop variables.
-		group.Go(createGoroutineClosure(ctx, rangeProvider, rangeObservers))
+	// the partials of the interrupted run are restored before the new ones are
+	// forked, so the observers can continue with the parameters of the
+	// interrupted run (e.g. the seed of the bloom filters).
+	for _, restore := range []bool{true, false} {
+		for index, progress := range progresses {
+			if progress.started() != restore {
+				continue
+			}
+			if run != nil && !progress.Done {
+				// partials of the workers are restored after the range is processed.
+				continue
+			}
+			for i, observerState := range observerStates {
+				if observerState.err != nil {
+					continue
+				}
+				rangeState := observerState.rangeObservers[index]
+				rangeState.rangeObserver, rangeState.err = forkObserver(ctx, observerState.observer, names[i], progress)
+			}
+		}
+	}
+
+	group := errs2.Group{}
+	for index, rangeProvider := range rangeProviders {
+		if run != nil {
+			group.Go(createCoordinatedClosure(ctx, service.coordinator, run, &partialsMu, rangeObservers[index], tracker, index, progresses[index]))
+		} else {
+			group.Go(createGoroutineClosure(ctx, rangeProvider, rangeObservers[index], tracker, index, progresses[index]))
+		}
 	}
 
 	// Improvement: stop all ranges when one has an error.
@@ -179,28 +286,234 @@ func (service *Service) RunOnce(ctx context.Context) (observerDurations []Observ
 		return nil, errs.Combine(errList...)
 	}
 
-	return finishObservers(ctx, service.log, observerStates), nil
+	observerDurations = finishObservers(ctx, service.log, observerStates)
+	tracker.finish(ctx)
+
+	return observerDurations, nil
+}
+
+// loadCheckpoint returns the checkpoint of an interrupted run, which can be
+// resumed with the current configuration, or nil.
+func (service *Service) loadCheckpoint(ctx context.Context) *Checkpoint {
+	if service.checkpoints == nil {
+		return nil
+	}
+
+	checkpoint, err := service.checkpoints.Load(ctx)
+	if err != nil {
+		service.log.Warn("unable to load ranged loop checkpoint, starting over", zap.Error(err))
+		return nil
+	}
+	if checkpoint == nil {
+		return nil
+	}
+
+	if checkpoint.Parallelism != service.config.Parallelism {
+		service.log.Info("ranged loop parallelism changed since the checkpoint, starting over",
+			zap.Int("checkpoint_parallelism", checkpoint.Parallelism))
+		return nil
+	}
+	return checkpoint
+}
+
+// startCheckpoint returns the tracker for the progress of the run. It's nil when checkpoints are disabled.
+func (service *Service) startCheckpoint(ctx context.Context, checkpoint *Checkpoint, startTime time.Time, rangeProviders []SegmentProvider) *checkpointTracker {
+	if service.checkpoints == nil {
+		return nil
+	}
+
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{
+			StartedAt:   startTime,
+			Parallelism: service.config.Parallelism,
+		}
+		for _, rangeProvider := range rangeProviders {
+			checkpoint.Ranges = append(checkpoint.Ranges, RangeCheckpoint{Range: rangeProvider.Range()})
+		}
+
+		// save the start time, so a resumed run uses the same one.
+		if err := service.checkpoints.Save(ctx, checkpoint); err != nil {
+			service.log.Warn("unable to save ranged loop checkpoint, the run won't be resumable", zap.Error(err))
+			return nil
+		}
+	}
+
+	return &checkpointTracker{
+		log:        service.log,
+		store:      service.checkpoints,
+		interval:   service.config.CheckpointInterval,
+		checkpoint: checkpoint,
+	}
+}
+
+// canResume checks whether the ranges of the checkpoint can be continued.
+func canResume(checkpoint *Checkpoint, rangeProviders []SegmentProvider) bool {
+	if !checkpoint.matchesRanges(rangeProviders) {
+		return false
+	}
+	for index, rangeProvider := range rangeProviders {
+		progress := checkpoint.Ranges[index]
+		if !progress.started() || progress.Done {
+			continue
+		}
+		if _, ok := rangeProvider.(ResumableSegmentProvider); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// startCoordinatedRun makes the ranges, which weren't completed yet, available to the workers.
+func (service *Service) startCoordinatedRun(startTime time.Time, names []string, observerStates []observerState, checkpoint *Checkpoint, rangeProviders []SegmentProvider) (*coordinatedRun, error) {
+	var observers []string
+	for i, state := range observerStates {
+		if state.err == nil {
+			observers = append(observers, names[i])
+		}
+	}
+
+	pending := make([]bool, len(rangeProviders))
+	for index := range rangeProviders {
+		pending[index] = checkpoint == nil || !checkpoint.Ranges[index].Done
+	}
+
+	return service.coordinator.startRun(startTime, service.config.BatchSize, observers, rangeProviders, pending)
 }
 
-func createGoroutineClosure(ctx context.Context, rangeProvider SegmentProvider, states []*rangeObserverState) func() error {
+// allResumable checks whether all the observers can save and restore their state.
+func allResumable(log *zap.Logger, observers []Observer) bool {
+	resumable := true
+	for _, observer := range observers {
+		if !isResumable(observer) {
+			log.Info("observer doesn't implement ResumableObserver",
+				zap.String("observer", fmt.Sprintf("%T", observer)))
+			resumable = false
+		}
+	}
+	return resumable
+}
+
+// allDistributable checks whether the ranges of all the observers can be
+// processed by the workers.
+func allDistributable(log *zap.Logger, observers []Observer) bool {
+	distributable := true
+	for _, observer := range observers {
+		if !isDistributable(observer) {
+			log.Info("observer doesn't support distributed execution",
+				zap.String("observer", fmt.Sprintf("%T", observer)))
+			distributable = false
+		}
+	}
+	return distributable
+}
+
+// forkObserver creates the partial of the observer for a range. The partial
+// is restored from the checkpoint when the range has made progress already.
+func forkObserver(ctx context.Context, observer Observer, name string, progress RangeCheckpoint) (Partial, error) {
+	if !progress.started() {
+		return observer.Fork(ctx)
+	}
+
+	resumable, ok := observer.(ResumableObserver)
+	if !ok {
+		return nil, Error.New("observer doesn't support resuming")
+	}
+	state, ok := progress.Partials[name]
+	if !ok {
+		return nil, Error.New("observer state missing from the checkpoint")
+	}
+	return resumable.RestorePartial(ctx, state)
+}
+
+func createGoroutineClosure(ctx context.Context, rangeProvider SegmentProvider, states []*rangeObserverState, tracker *checkpointTracker, index int, progress RangeCheckpoint) func() error {
 	return func() (err error) {
 		defer mon.Task()(&ctx)(&err)
 
-		return rangeProvider.Iterate(ctx, func(segments []Segment) error {
+		if progress.Done {
+			return nil
+		}
+
+		lastSaved := time.Now()
+		process := func(segments []Segment) error {
 			// check for cancellation every segment batch
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
 			default:
-				return processBatch(ctx, states, segments)
 			}
-		})
+
+			if err := processBatch(ctx, states, segments); err != nil {
+				return err
+			}
+
+			if tracker != nil && len(segments) > 0 {
+				last := segments[len(segments)-1]
+				progress.LastStreamID, progress.LastPosition = last.StreamID, last.Position
+
+				if time.Since(lastSaved) >= tracker.interval {
+					tracker.save(ctx, index, progress, states)
+					lastSaved = time.Now()
+				}
+			}
+			return nil
+		}
+
+		if progress.started() {
+			err = rangeProvider.(ResumableSegmentProvider).IterateAfter(ctx, progress.LastStreamID, progress.LastPosition, process)
+		} else {
+			err = rangeProvider.Iterate(ctx, process)
+		}
+		if err != nil {
+			return err
+		}
+
+		if tracker != nil {
+			progress.Done = true
+			tracker.save(ctx, index, progress, states)
+		}
+		return nil
 	}
 }
 
-func startObservers(ctx context.Context, log *zap.Logger, observers []Observer) (observerStates []observerState, err error) {
-	startTime := time.Now()
+func createCoordinatedClosure(ctx context.Context, coordinator *Coordinator, run *coordinatedRun, partialsMu *sync.Mutex, states []*rangeObserverState, tracker *checkpointTracker, index int, progress RangeCheckpoint) func() error {
+	return func() (err error) {
+		defer mon.Task()(&ctx)(&err)
+
+		if progress.Done {
+			return nil
+		}
+
+		partials, err := coordinator.waitRange(ctx, run, index)
+		if err != nil {
+			return err
+		}
+
+		partialsMu.Lock()
+		for _, state := range states {
+			if state.err != nil {
+				continue
+			}
+			partial, ok := partials[state.name]
+			switch {
+			case !ok:
+				state.err = Error.New("worker didn't return the observer state")
+			case partial.Error != "":
+				state.err = Error.New("worker failed: %s", partial.Error)
+			default:
+				state.rangeObserver, state.err = state.resumable.RestorePartial(ctx, partial.State)
+			}
+		}
+		partialsMu.Unlock()
+
+		if tracker != nil {
+			progress.Done = true
+			tracker.save(ctx, index, progress, states)
+		}
+		return nil
+	}
+}
 
+func startObservers(ctx context.Context, log *zap.Logger, observers []Observer, startTime time.Time) (observerStates []observerState, err error) {
 	for _, obs := range observers {
 		observerStates = append(observerStates, startObserver(ctx, log, startTime, obs))
 	}
@@ -166,6 +166,104 @@ func TestLoopCancellation(t *testing.T) {
 	require.ErrorIs(t, err, context.Canceled)
 }
 
+func TestLoopResume(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	segments := make([]rangedloop.Segment, 20)
+	for i := range segments {
+		segments[i].StreamID = testrand.UUID()
+	}
+
+	config := rangedloop.Config{
+		BatchSize:      2,
+		Parallelism:    2,
+		CheckpointPath: ctx.File("checkpoint.json"),
+	}
+	splitter := &rangedlooptest.RangeSplitter{Segments: segments}
+
+	var startTimes []time.Time
+	var processedBatches int32
+
+	interrupt := func(t *testing.T) {
+		processedBatches = 0
+		interruptedCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		_, err := rangedloop.NewService(zaptest.NewLogger(t), config, splitter, []rangedloop.Observer{
+			&startTimeObserver{CountObserver: &rangedlooptest.CountObserver{}, startTimes: &startTimes},
+			&rangedlooptest.CallbackObserver{
+				OnProcess: func(ctx context.Context, segments []rangedloop.Segment) error {
+					if atomic.AddInt32(&processedBatches, 1) == 3 {
+						cancel()
+					}
+					return nil
+				},
+			},
+		}).RunOnce(interruptedCtx)
+		require.ErrorIs(t, err, context.Canceled)
+		require.FileExists(t, config.CheckpointPath)
+	}
+
+	t.Run("resumable observers continue", func(t *testing.T) {
+		startTimes = nil
+		interrupt(t)
+
+		resumed := &startTimeObserver{CountObserver: &rangedlooptest.CountObserver{}, startTimes: &startTimes}
+		observerDurations, err := rangedloop.NewService(zaptest.NewLogger(t), config, splitter, []rangedloop.Observer{
+			resumed,
+		}).RunOnce(ctx)
+		require.NoError(t, err)
+		require.NoFileExists(t, config.CheckpointPath)
+
+		// the resumable observer processed every segment exactly once
+		require.Equal(t, len(segments), resumed.NumSegments)
+		require.GreaterOrEqual(t, observerDurations[0].Duration, time.Duration(0))
+
+		require.Len(t, startTimes, 2)
+		require.True(t, startTimes[0].Equal(startTimes[1]))
+	})
+
+	t.Run("non-resumable observer starts over", func(t *testing.T) {
+		startTimes = nil
+		interrupt(t)
+
+		var processed int64
+		restarted := &startTimeObserver{CountObserver: &rangedlooptest.CountObserver{}, startTimes: &startTimes}
+		observerDurations, err := rangedloop.NewService(zaptest.NewLogger(t), config, splitter, []rangedloop.Observer{
+			restarted,
+			&rangedlooptest.CallbackObserver{
+				OnProcess: func(ctx context.Context, segments []rangedloop.Segment) error {
+					atomic.AddInt64(&processed, int64(len(segments)))
+					return nil
+				},
+			},
+		}).RunOnce(ctx)
+		require.NoError(t, err)
+		require.NoFileExists(t, config.CheckpointPath)
+
+		// no observer is excluded, all of them see all the segments
+		require.Equal(t, len(segments), restarted.NumSegments)
+		require.EqualValues(t, len(segments), processed)
+		for _, duration := range observerDurations {
+			require.GreaterOrEqual(t, duration.Duration, time.Duration(0))
+		}
+
+		require.Len(t, startTimes, 2)
+		require.True(t, startTimes[1].After(startTimes[0]))
+	})
+}
+
+// startTimeObserver is a resumable observer, which records the start times.
+type startTimeObserver struct {
+	*rangedlooptest.CountObserver
+	startTimes *[]time.Time
+}
+
+func (observer *startTimeObserver) Start(ctx context.Context, startTime time.Time) error {
+	*observer.startTimes = append(*observer.startTimes, startTime)
+	return observer.CountObserver.Start(ctx, startTime)
+}
+
 func TestLoopContinuesAfterObserverError(t *testing.T) {
 	parallelism := 2
 	batchSize := 1
@@ -426,6 +524,7 @@ func TestAllInOne(t *testing.T) {
 				satellite.DB.RepairQueue(),
 				satellite.Overlay.Service,
 				nodeselection.TestPlacementDefinitions(),
+				satellite.Config.Metainfo.RS,
 				satellite.Config.Checker,
 			),
 		})
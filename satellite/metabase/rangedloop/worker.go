@@ -0,0 +1,306 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package rangedloop
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/errs2"
+	"storj.io/common/rpc"
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+	"storj.io/common/uuid"
+	"storj.io/drpc"
+	"storj.io/storj/satellite/internalpb"
+)
+
+// WorkerConfig configures a worker of a distributed loop.
+type WorkerConfig struct {
+	CoordinatorAddress string        `help:"node URL of the ranged loop coordinator" default:""`
+	HeartbeatInterval  time.Duration `help:"how often to extend the lease of the processed range" default:"1m"`
+	RetryInterval      time.Duration `help:"how long to wait after failing to reach the coordinator" default:"30s"`
+}
+
+// DialCoordinatorFunc opens a connection to the coordinator.
+type DialCoordinatorFunc func(ctx context.Context) (drpc.Conn, error)
+
+// DialNodeURL returns a function which dials the coordinator at the node URL.
+func DialNodeURL(dialer rpc.Dialer, address string) DialCoordinatorFunc {
+	return func(ctx context.Context) (drpc.Conn, error) {
+		nodeURL, err := storj.ParseNodeURL(address)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		return dialer.DialNodeURL(ctx, nodeURL)
+	}
+}
+
+// Worker processes the ranges handed out by a Coordinator, and sends back the
+// state of the partials. The worker calls Start of the observers once per
+// loop run and Fork once per range; Join and Finish are called by the
+// coordinator on its own instances of the observers.
+//
+// The worker needs to be configured with the same observers, in the same
+// order, and the same RangeSplitter as the coordinator.
+//
+// architecture: Worker
+type Worker struct {
+	log       *zap.Logger
+	config    WorkerConfig
+	dial      DialCoordinatorFunc
+	provider  RangeSplitter
+	observers map[string]Observer
+	id        string
+
+	run *workerRun
+}
+
+// workerRun is the state of the loop run the worker is processing ranges of.
+type workerRun struct {
+	id        uuid.UUID
+	ranges    []SegmentProvider
+	observers map[string]error
+}
+
+// NewWorker creates a new worker of a distributed loop.
+func NewWorker(log *zap.Logger, config WorkerConfig, dial DialCoordinatorFunc, provider RangeSplitter, observers []Observer) *Worker {
+	hostname, _ := os.Hostname()
+
+	byName := map[string]Observer{}
+	for i, name := range observerNames(observers) {
+		byName[name] = observers[i]
+	}
+
+	return &Worker{
+		log:       log,
+		config:    config,
+		dial:      dial,
+		provider:  provider,
+		observers: byName,
+		id:        fmt.Sprintf("%s-%d", hostname, os.Getpid()),
+	}
+}
+
+// Run processes ranges until the context is canceled.
+func (worker *Worker) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		comeBackIn, err := worker.ProcessNext(ctx)
+		if err != nil {
+			if errs2.IsCanceled(err) || ctx.Err() != nil {
+				return ctx.Err()
+			}
+			worker.log.Warn("failed to process range", zap.Error(err))
+			comeBackIn = worker.config.RetryInterval
+		}
+
+		if !sync2.Sleep(ctx, comeBackIn) {
+			return ctx.Err()
+		}
+	}
+}
+
+// ProcessNext asks the coordinator for a range and processes it. It returns
+// how long to wait before asking again, when no range was available.
+func (worker *Worker) ProcessNext(ctx context.Context) (comeBackIn time.Duration, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := worker.dial(ctx)
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	client := internalpb.NewDRPCRangedLoopCoordinatorClient(conn)
+
+	assignment, err := client.NextRange(ctx, &internalpb.NextRangeRequest{
+		WorkerId: worker.id,
+	})
+	if err != nil {
+		return 0, Error.Wrap(err)
+	}
+	if len(assignment.LeaseId) == 0 {
+		return time.Duration(assignment.ComeBackInMillis) * time.Millisecond, nil
+	}
+
+	result := worker.processRange(ctx, client, assignment)
+	if ctx.Err() != nil {
+		return 0, ctx.Err()
+	}
+
+	_, err = client.FinishRange(ctx, result)
+	return 0, Error.Wrap(err)
+}
+
+// processRange processes the assigned range and returns the result for the coordinator.
+func (worker *Worker) processRange(ctx context.Context, client internalpb.DRPCRangedLoopCoordinatorClient, assignment *internalpb.NextRangeResponse) *internalpb.FinishRangeRequest {
+	result := &internalpb.FinishRangeRequest{LeaseId: assignment.LeaseId}
+
+	rangeProvider, states, err := worker.prepareRange(ctx, assignment)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	worker.log.Debug("processing range", zap.Int32("index", assignment.RangeIndex))
+
+	rangeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		worker.sendHeartbeats(rangeCtx, client, assignment.LeaseId, cancel)
+	}()
+
+	err = rangeProvider.Iterate(rangeCtx, func(segments []Segment) error {
+		// check for cancellation every segment batch
+		select {
+		case <-rangeCtx.Done():
+			return rangeCtx.Err()
+		default:
+			return processBatch(rangeCtx, states, segments)
+		}
+	})
+
+	cancel()
+	<-heartbeatDone
+
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	for _, state := range states {
+		partial := &internalpb.ObserverPartial{Observer: state.name}
+		if state.err == nil {
+			partial.State, state.err = state.resumable.SavePartial(ctx, state.rangeObserver)
+		}
+		if state.err != nil {
+			partial.Error = state.err.Error()
+		}
+		result.Partials = append(result.Partials, partial)
+	}
+	return result
+}
+
+// prepareRange starts the observers when the assignment belongs to a new run
+// and forks them for the range.
+func (worker *Worker) prepareRange(ctx context.Context, assignment *internalpb.NextRangeResponse) (SegmentProvider, []*rangeObserverState, error) {
+	runID, err := uuid.FromBytes(assignment.RunId)
+	if err != nil {
+		return nil, nil, Error.Wrap(err)
+	}
+
+	if worker.run == nil || worker.run.id != runID {
+		worker.run = nil
+
+		ranges, err := worker.provider.CreateRanges(int(assignment.NumRanges), int(assignment.BatchSize))
+		if err != nil {
+			return nil, nil, Error.Wrap(err)
+		}
+
+		run := &workerRun{
+			id:        runID,
+			ranges:    ranges,
+			observers: map[string]error{},
+		}
+		startTime := time.Unix(0, assignment.RunStartTimeUnixNano)
+		for _, name := range assignment.Observers {
+			run.observers[name] = worker.startObserver(ctx, name, startTime)
+		}
+		worker.run = run
+	}
+
+	index := int(assignment.RangeIndex)
+	if index < 0 || index >= len(worker.run.ranges) {
+		return nil, nil, Error.New("range index %d out of bounds", index)
+	}
+	rangeProvider := worker.run.ranges[index]
+
+	expected, err := uuidRangeFromBytes(assignment.RangeStart, assignment.RangeEnd)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !equalUUIDRange(expected, rangeProvider.Range()) {
+		return nil, nil, Error.New("range %d doesn't match the range of the coordinator", index)
+	}
+
+	var states []*rangeObserverState
+	for _, name := range assignment.Observers {
+		state := &rangeObserverState{
+			name: name,
+			err:  worker.run.observers[name],
+		}
+		if state.err == nil {
+			state.resumable = worker.observers[name].(ResumableObserver)
+			state.rangeObserver, state.err = state.resumable.Fork(ctx)
+		}
+		states = append(states, state)
+	}
+	return rangeProvider, states, nil
+}
+
+// startObserver starts the observer for a new run.
+func (worker *Worker) startObserver(ctx context.Context, name string, startTime time.Time) error {
+	observer, ok := worker.observers[name]
+	if !ok {
+		return Error.New("observer %s isn't configured on the worker", name)
+	}
+	if !isDistributable(observer) {
+		return Error.New("observer %s doesn't support distributed execution", name)
+	}
+
+	if err := observer.Start(ctx, startTime); err != nil {
+		worker.log.Error("Starting observer failed. This observer will be excluded from this run of the ranged segment loop.",
+			zap.String("observer", name),
+			zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// sendHeartbeats extends the lease until the context is canceled. It calls
+// cancel when the lease has expired.
+func (worker *Worker) sendHeartbeats(ctx context.Context, client internalpb.DRPCRangedLoopCoordinatorClient, leaseID []byte, cancel func()) {
+	for sync2.Sleep(ctx, worker.config.HeartbeatInterval) {
+		response, err := client.RangeHeartbeat(ctx, &internalpb.RangeHeartbeatRequest{LeaseId: leaseID})
+		if err != nil {
+			if ctx.Err() == nil {
+				worker.log.Warn("failed to send heartbeat", zap.Error(err))
+			}
+			continue
+		}
+		if response.LeaseExpired {
+			worker.log.Warn("range lease expired, the range was reassigned")
+			cancel()
+			return
+		}
+	}
+}
+
+func uuidRangeFromBytes(start, end []byte) (uuidRange UUIDRange, err error) {
+	if len(start) > 0 {
+		id, err := uuid.FromBytes(start)
+		if err != nil {
+			return UUIDRange{}, Error.Wrap(err)
+		}
+		uuidRange.Start = &id
+	}
+	if len(end) > 0 {
+		id, err := uuid.FromBytes(end)
+		if err != nil {
+			return UUIDRange{}, Error.Wrap(err)
+		}
+		uuidRange.End = &id
+	}
+	return uuidRange, nil
+}
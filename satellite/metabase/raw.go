@@ -148,6 +148,7 @@ func (p *PostgresAdapter) TestingDeleteAll(ctx context.Context) (err error) {
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM objects;
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM segments;
 		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM node_aliases;
+		WITH ignore_full_scan_for_test AS (SELECT 1) DELETE FROM prefix_tallies;
 		WITH ignore_full_scan_for_test AS (SELECT 1) SELECT setval('node_alias_seq', 1, false);
 	`)
 	return Error.Wrap(err)
@@ -159,6 +160,7 @@ func (s *SpannerAdapter) TestingDeleteAll(ctx context.Context) (err error) {
 		spanner.Delete("objects", spanner.AllKeys()),
 		spanner.Delete("segments", spanner.AllKeys()),
 		spanner.Delete("node_aliases", spanner.AllKeys()),
+		spanner.Delete("prefix_tallies", spanner.AllKeys()),
 	})
 	return Error.Wrap(err)
 }
@@ -38,7 +38,7 @@ func (p *PostgresAdapter) testMigrateToLatest(ctx context.Context) error {
 			{
 				DB:          &p.db,
 				Description: "Test snapshot",
-				Version:     20,
+				Version:     21,
 				Action: migrate.SQL{
 					`CREATE TABLE objects (
 						project_id   BYTEA NOT NULL,
@@ -161,7 +161,24 @@ func (p *PostgresAdapter) testMigrateToLatest(ctx context.Context) error {
 
 					COMMENT ON TABLE  node_aliases            is 'node_aliases table contains unique identifiers (aliases) for storagenodes that take less space than a NodeID.';
 					COMMENT ON COLUMN node_aliases.node_id    is 'node_id refers to the storj.NodeID';
-					COMMENT ON COLUMN node_aliases.node_alias is 'node_alias is a unique integer value assigned for the node_id. It is used for compressing segments.remote_alias_pieces.';`,
+					COMMENT ON COLUMN node_aliases.node_alias is 'node_alias is a unique integer value assigned for the node_id. It is used for compressing segments.remote_alias_pieces.';
+
+					CREATE TABLE prefix_tallies (
+						project_id  BYTEA NOT NULL,
+						bucket_name BYTEA NOT NULL,
+						prefix      BYTEA NOT NULL,
+
+						object_count         INT8 NOT NULL default 0,
+						total_encrypted_size INT8 NOT NULL default 0,
+						segment_count        INT8 NOT NULL default 0,
+
+						updated_at TIMESTAMPTZ NOT NULL default now(),
+
+						PRIMARY KEY (project_id, bucket_name, prefix)
+					);
+
+					COMMENT ON TABLE  prefix_tallies        is 'prefix_tallies contains the usage of a bucket grouped by the leading components of the object key. It is maintained by the tally service.';
+					COMMENT ON COLUMN prefix_tallies.prefix is 'prefix is the encrypted key prefix including the trailing delimiter.';`,
 				},
 			},
 		},
@@ -172,7 +189,7 @@ func (p *PostgresAdapter) testMigrateToLatest(ctx context.Context) error {
 		migration.Steps = append(migration.Steps, &migrate.Step{
 			DB:          &p.db,
 			Description: "Constraint for ensuring our metabase correctness.",
-			Version:     21,
+			Version:     22,
 			Action: migrate.SQL{
 				`CREATE UNIQUE INDEX objects_one_unversioned_per_location ON objects (project_id, bucket_name, object_key) WHERE status IN ` + statusesUnversioned + `;`,
 			},
@@ -0,0 +1,93 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/storj/satellite/metabase"
+)
+
+// Field numbers of the write conditions in CommitObject, BeginDeleteObject,
+// FinishCopyObject and FinishMoveObject requests.
+//
+// The generated messages don't have these fields yet, so they are kept with
+// the unknown fields of the requests.
+const (
+	ifNoneMatchFieldNumber    protowire.Number = 100
+	ifMatchVersionFieldNumber protowire.Number = 101
+)
+
+// WriteCondition is a precondition on the latest committed object at the target
+// location of a commit, copy, move or delete request.
+type WriteCondition struct {
+	// IfNoneMatch requires that there's no committed object at the location.
+	IfNoneMatch bool
+	// IfMatchVersion requires that the latest committed object at the location
+	// has the specified version, as returned in pb.Object.ObjectVersion.
+	IfMatchVersion []byte
+}
+
+// AppendTo appends the condition to the encoded fields of a request, e.g. to
+// its XXX_unrecognized field.
+func (condition WriteCondition) AppendTo(fields []byte) []byte {
+	if condition.IfNoneMatch {
+		fields = protowire.AppendTag(fields, ifNoneMatchFieldNumber, protowire.VarintType)
+		fields = protowire.AppendVarint(fields, protowire.EncodeBool(true))
+	}
+	if len(condition.IfMatchVersion) > 0 {
+		fields = protowire.AppendTag(fields, ifMatchVersionFieldNumber, protowire.BytesType)
+		fields = protowire.AppendBytes(fields, condition.IfMatchVersion)
+	}
+	return fields
+}
+
+// parseWriteCondition reads the write condition from the unknown fields of a
+// request and converts it into its metabase counterpart.
+func parseWriteCondition(fields []byte) (metabase.WriteCondition, error) {
+	var condition WriteCondition
+	for len(fields) > 0 {
+		number, typ, n := protowire.ConsumeTag(fields)
+		if n < 0 {
+			return metabase.WriteCondition{}, rpcstatus.Wrap(rpcstatus.InvalidArgument, protowire.ParseError(n))
+		}
+		fields = fields[n:]
+
+		switch {
+		case number == ifNoneMatchFieldNumber && typ == protowire.VarintType:
+			var value uint64
+			value, n = protowire.ConsumeVarint(fields)
+			condition.IfNoneMatch = protowire.DecodeBool(value)
+		case number == ifMatchVersionFieldNumber && typ == protowire.BytesType:
+			condition.IfMatchVersion, n = protowire.ConsumeBytes(fields)
+		default:
+			n = protowire.ConsumeFieldValue(number, typ, fields)
+		}
+		if n < 0 {
+			return metabase.WriteCondition{}, rpcstatus.Wrap(rpcstatus.InvalidArgument, protowire.ParseError(n))
+		}
+		fields = fields[n:]
+	}
+
+	return condition.toMetabase()
+}
+
+// toMetabase converts the condition into its metabase counterpart.
+func (condition WriteCondition) toMetabase() (metabase.WriteCondition, error) {
+	result := metabase.WriteCondition{
+		IfNoneMatch: condition.IfNoneMatch,
+	}
+	if len(condition.IfMatchVersion) > 0 {
+		sv, err := metabase.StreamVersionIDFromBytes(condition.IfMatchVersion)
+		if err != nil {
+			return metabase.WriteCondition{}, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+		}
+		result.IfMatch = sv
+	}
+	if err := result.Verify(); err != nil {
+		return metabase.WriteCondition{}, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"storj.io/common/errs2"
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+)
+
+func TestParseWriteCondition(t *testing.T) {
+	t.Parallel()
+
+	version := metabase.NewStreamVersionID(5, testrand.UUID())
+
+	// the conditions are sent as fields, which the generated messages don't
+	// know, so they have to survive the encoding of the requests.
+	roundTrip := func(condition WriteCondition) []byte {
+		data, err := pb.Marshal(&pb.ObjectFinishCopyRequest{
+			NewBucket:        []byte("bucket"),
+			XXX_unrecognized: condition.AppendTo(nil),
+		})
+		require.NoError(t, err)
+
+		var req pb.ObjectFinishCopyRequest
+		require.NoError(t, pb.Unmarshal(data, &req))
+		require.Equal(t, []byte("bucket"), req.NewBucket)
+		return req.XXX_unrecognized
+	}
+
+	condition, err := parseWriteCondition(roundTrip(WriteCondition{}))
+	require.NoError(t, err)
+	require.True(t, condition.IsZero())
+
+	condition, err = parseWriteCondition(roundTrip(WriteCondition{IfNoneMatch: true}))
+	require.NoError(t, err)
+	require.Equal(t, metabase.WriteCondition{IfNoneMatch: true}, condition)
+
+	condition, err = parseWriteCondition(roundTrip(WriteCondition{IfMatchVersion: version.Bytes()}))
+	require.NoError(t, err)
+	require.Equal(t, metabase.WriteCondition{IfMatch: version}, condition)
+
+	// other unknown fields are ignored
+	fields := protowire.AppendTag(nil, 99, protowire.BytesType)
+	fields = protowire.AppendBytes(fields, []byte("unknown"))
+	condition, err = parseWriteCondition(WriteCondition{IfNoneMatch: true}.AppendTo(fields))
+	require.NoError(t, err)
+	require.Equal(t, metabase.WriteCondition{IfNoneMatch: true}, condition)
+
+	_, err = parseWriteCondition(roundTrip(WriteCondition{IfNoneMatch: true, IfMatchVersion: version.Bytes()}))
+	require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+	_, err = parseWriteCondition(fields[:len(fields)-1])
+	require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+}
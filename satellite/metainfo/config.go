@@ -14,6 +14,7 @@ import (
 
 	"storj.io/common/memory"
 	"storj.io/common/uuid"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/uplink/private/eestream"
@@ -140,6 +141,9 @@ type RateLimiterConfig struct {
 	Rate            float64       `help:"request rate per project per second." releaseDefault:"100" devDefault:"100" testDefault:"1000"`
 	CacheCapacity   int           `help:"number of projects to cache." releaseDefault:"10000" devDefault:"10" testDefault:"100"`
 	CacheExpiration time.Duration `help:"how long to cache the projects limiter." releaseDefault:"10m" devDefault:"10s"`
+
+	BucketLimits console.BucketRateLimits `help:"rate limits of individual buckets in the format <project-id>/<bucket>:list=<rate>/<burst>,read=<rate>/<burst>,write=<rate>/<burst>;..." default:""`
+	APIKeyLimits console.APIKeyRateLimits `help:"rate limits of individual API keys in the format <hex-encoded-macaroon-head>:list=<rate>/<burst>,read=<rate>/<burst>,write=<rate>/<burst>;..." default:""`
 }
 
 // UploadLimiterConfig is a configuration struct for endpoint upload limiting.
@@ -195,8 +199,11 @@ type Config struct {
 	FailureTrackerTickDuration   time.Duration         `default:"5s" help:"how often to bump the generation in the node failure tracker"`
 	SuccessTrackerTrustedUplinks []string              `help:"list of trusted uplinks for success tracker, deprecated. please use success-tracker-uplinks for uplinks that should get their own success tracker profiles and trusted-uplinks for uplinks that are trusted individually."`
 	SuccessTrackerUplinks        []string              `help:"list of uplinks for success tracker"`
+	LatencyTrackerPercentile     float64               `help:"the percentile of the observed upload durations, which the latency tracker estimates the node latency with" default:"90"`
 	FailureTrackerChanceToSkip   float64               `help:"the chance to skip a failure tracker generation bump" default:".6"`
 	TrustedUplinks               []string              `help:"list of trusted uplinks"`
+	UplinkLocationCapacity       int                   `default:"100000" help:"number of recently seen uplinks whose geolocated country is remembered for the nearby node selector, 0 disables the geolocation of uplinks; trusted uplinks (gateways) are never located"`
+	UplinkLocationExpiration     time.Duration         `default:"1h" help:"how long the geolocated country of an uplink is remembered"`
 
 	// TODO remove this flag when server-side copy implementation will be finished
 	ServerSideCopy         bool `help:"enable code for server-side copy, deprecated. please leave this to true." default:"true"`
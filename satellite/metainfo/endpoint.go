@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"cloud.google.com/go/spanner"
@@ -97,10 +98,14 @@ type Endpoint struct {
 	zstdEncoder                    *zstd.Encoder
 	successTrackers                *SuccessTrackers
 	failureTracker                 SuccessTracker
+	latencyTrackers                *LatencyTrackers
+	uplinkLocations                *UplinkLocations
 	trustedUplinks                 *trust.TrustedPeersList
-	placement                      nodeselection.PlacementDefinitions
 	placementEdgeUrlOverrides      console.PlacementEdgeURLOverrides
 
+	placementMu sync.RWMutex
+	placement   nodeselection.PlacementDefinitions
+
 	// rateLimiterTime is a function that returns the time to check with the rate limiter.
 	// It's handy for testing purposes. It defaults to time.Now.
 	rateLimiterTime func() time.Time
@@ -111,7 +116,7 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 	orders *orders.Service, cache *overlay.Service, attributions attribution.DB, peerIdentities overlay.PeerIdentities,
 	apiKeys APIKeys, projectUsage *accounting.Service, projects console.Projects, projectMembers console.ProjectMembers, users console.Users,
 	satellite signing.Signer, revocations revocation.DB, successTrackers *SuccessTrackers, failureTracker SuccessTracker,
-	trustedUplinks *trust.TrustedPeersList, config Config, migrationModeFlag *MigrationModeFlagExtension,
+	latencyTrackers *LatencyTrackers, uplinkLocations *UplinkLocations, trustedUplinks *trust.TrustedPeersList, config Config, migrationModeFlag *MigrationModeFlagExtension,
 	placement nodeselection.PlacementDefinitions, placementEdgeUrlOverrides console.PlacementEdgeURLOverrides, trustedOrders bool) (
 	*Endpoint, error) {
 
@@ -181,6 +186,8 @@ func NewEndpoint(log *zap.Logger, buckets *buckets.Service, metabaseDB *metabase
 		zstdEncoder:               encoder,
 		successTrackers:           successTrackers,
 		failureTracker:            failureTracker,
+		latencyTrackers:           latencyTrackers,
+		uplinkLocations:           uplinkLocations,
 		trustedUplinks:            trustedUplinks,
 		placement:                 placement,
 		placementEdgeUrlOverrides: placementEdgeUrlOverrides,
@@ -211,6 +218,7 @@ func (endpoint *Endpoint) Run(ctx context.Context) error {
 			return nil
 		case <-successTicker.C:
 			endpoint.successTrackers.BumpGeneration()
+			endpoint.latencyTrackers.BumpGeneration()
 		case <-failureTicker.C:
 			endpoint.failureTracker.BumpGeneration()
 		}
@@ -492,8 +500,26 @@ func (endpoint *Endpoint) usageTracking(keyInfo *console.APIKeyInfo, header *pb.
 	}, tags...)...)
 }
 
+// getPlacement returns the current definition of the placement.
+func (endpoint *Endpoint) getPlacement(placementID storj.PlacementConstraint) nodeselection.Placement {
+	endpoint.placementMu.RLock()
+	defer endpoint.placementMu.RUnlock()
+
+	return endpoint.placement[placementID]
+}
+
+// SetPlacements replaces the placement definitions, which are used to choose
+// the redundancy scheme of the new segments.
+func (endpoint *Endpoint) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) error {
+	endpoint.placementMu.Lock()
+	defer endpoint.placementMu.Unlock()
+
+	endpoint.placement = placements
+	return nil
+}
+
 func (endpoint *Endpoint) getRSProto(placementID storj.PlacementConstraint) *pb.RedundancyScheme {
-	rs := endpoint.config.RS.Override(endpoint.placement[placementID].EC)
+	rs := endpoint.config.RS.Override(endpoint.getPlacement(placementID).EC)
 	return &pb.RedundancyScheme{
 		Type:             pb.RedundancyScheme_RS,
 		MinReq:           int32(rs.Min),
@@ -513,3 +539,9 @@ func (endpoint *Endpoint) TestingSetRSConfig(rs RSConfig) {
 func (endpoint *Endpoint) TestingSetRateLimiterTime(time func() time.Time) {
 	endpoint.rateLimiterTime = time
 }
+
+// TestingSetScopedRateLimits sets the rate limits of individual buckets and API keys.
+func (endpoint *Endpoint) TestingSetScopedRateLimits(bucketLimits console.BucketRateLimits, apiKeyLimits console.APIKeyRateLimits) {
+	endpoint.config.RateLimiter.BucketLimits = bucketLimits
+	endpoint.config.RateLimiter.APIKeyLimits = apiKeyLimits
+}
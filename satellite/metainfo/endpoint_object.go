@@ -274,6 +274,17 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	return endpoint.commitObject(ctx, req)
+}
+
+func (endpoint *Endpoint) commitObject(ctx context.Context, req *pb.ObjectCommitRequest) (resp *pb.ObjectCommitResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	writeCondition, err := parseWriteCondition(req.XXX_unrecognized)
+	if err != nil {
+		return nil, err
+	}
+
 	streamID, err := endpoint.unmarshalSatStreamID(ctx, req.StreamId)
 	if err != nil {
 		return nil, rpcstatus.Wrap(rpcstatus.InvalidArgument, err)
@@ -368,6 +379,8 @@ func (endpoint *Endpoint) CommitObject(ctx context.Context, req *pb.ObjectCommit
 		DisallowDelete: !allowDelete,
 
 		Versioned: streamID.Versioned,
+
+		Condition: writeCondition,
 	}
 	// uplink can send empty metadata with not empty key/nonce
 	// we need to fix it on uplink side but that part will be
@@ -1699,10 +1712,24 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	return endpoint.beginDeleteObject(ctx, req)
+}
+
+func (endpoint *Endpoint) beginDeleteObject(ctx context.Context, req *pb.ObjectBeginDeleteRequest) (resp *pb.ObjectBeginDeleteResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	if err = validateRequestSimple(req); err != nil {
 		return nil, err
 	}
 
+	writeCondition, err := parseWriteCondition(req.XXX_unrecognized)
+	if err != nil {
+		return nil, err
+	}
+	if !writeCondition.IsZero() && (req.GetStatus() == int32(metabase.Pending) || len(req.ObjectVersion) > 0) {
+		return nil, rpcstatus.Error(rpcstatus.InvalidArgument, "conditions are only supported when deleting the latest committed object")
+	}
+
 	now := time.Now()
 
 	var canRead, canList, canGetRetention bool
@@ -1793,11 +1820,13 @@ func (endpoint *Endpoint) BeginDeleteObject(ctx context.Context, req *pb.ObjectB
 			},
 			Version:          req.ObjectVersion,
 			BypassGovernance: req.BypassGovernanceRetention,
+			Condition:        writeCondition,
 		})
 	}
 	if err != nil {
 		if !canRead && !canList {
-			// No error info is returned if neither Read, nor List permission is granted
+			// No error info is returned if neither Read, nor List permission is granted.
+			// This includes unmet write conditions, which would reveal the object.
 			return &pb.ObjectBeginDeleteResponse{}, nil
 		}
 		return nil, endpoint.ConvertMetabaseErr(err)
@@ -2504,6 +2533,7 @@ type DeleteCommittedObject struct {
 	metabase.ObjectLocation
 	Version          []byte
 	BypassGovernance bool
+	Condition        metabase.WriteCondition
 }
 
 // DeleteCommittedObject deletes all the pieces of the storage nodes that belongs
@@ -2543,11 +2573,17 @@ func (endpoint *Endpoint) DeleteCommittedObject(ctx context.Context, opts Delete
 				Enabled:          bucketData.ObjectLock.Enabled,
 				BypassGovernance: opts.BypassGovernance,
 			},
+
+			Condition: opts.Condition,
 		})
 		if err != nil {
 			return nil, Error.Wrap(err)
 		}
 	} else {
+		if !opts.Condition.IsZero() {
+			return nil, metabase.ErrInvalidRequest.New("Condition is not supported when deleting an exact version")
+		}
+
 		var sv metabase.StreamVersionID
 		sv, err = metabase.StreamVersionIDFromBytes(opts.Version)
 		if err != nil {
@@ -2777,6 +2813,17 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	return endpoint.finishMoveObject(ctx, req)
+}
+
+func (endpoint *Endpoint) finishMoveObject(ctx context.Context, req *pb.ObjectFinishMoveRequest) (resp *pb.ObjectFinishMoveResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	writeCondition, err := parseWriteCondition(req.XXX_unrecognized)
+	if err != nil {
+		return nil, err
+	}
+
 	if err = validateRequestSimple(req); err != nil {
 		return nil, err
 	}
@@ -2888,6 +2935,8 @@ func (endpoint *Endpoint) FinishMoveObject(ctx context.Context, req *pb.ObjectFi
 
 		Retention: retention,
 		LegalHold: req.LegalHold,
+
+		Condition: writeCondition,
 	})
 	if err != nil {
 		return nil, endpoint.ConvertMetabaseErr(err)
@@ -3062,6 +3111,17 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 
 	endpoint.versionCollector.collect(req.Header.UserAgent, mon.Func().ShortName())
 
+	return endpoint.finishCopyObject(ctx, req)
+}
+
+func (endpoint *Endpoint) finishCopyObject(ctx context.Context, req *pb.ObjectFinishCopyRequest) (resp *pb.ObjectFinishCopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	writeCondition, err := parseWriteCondition(req.XXX_unrecognized)
+	if err != nil {
+		return nil, err
+	}
+
 	if err = validateRequestSimple(req); err != nil {
 		return nil, err
 	}
@@ -3174,6 +3234,8 @@ func (endpoint *Endpoint) FinishCopyObject(ctx context.Context, req *pb.ObjectFi
 		Retention: retention,
 		LegalHold: req.LegalHold,
 
+		Condition: writeCondition,
+
 		VerifyLimits: func(encryptedObjectSize int64, nSegments int64) error {
 			return endpoint.addStorageUsageUpToLimit(ctx, keyInfo, encryptedObjectSize, nSegments)
 		},
@@ -2433,6 +2433,103 @@ func TestEndpoint_CopyObject(t *testing.T) {
 	})
 }
 
+func TestEndpoint_ConditionalWrites(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		apiKey := planet.Uplinks[0].APIKey[planet.Satellites[0].ID()]
+		satelliteSys := planet.Satellites[0]
+		endpoint := satelliteSys.API.Metainfo.Endpoint
+
+		err := planet.Uplinks[0].Upload(ctx, satelliteSys, "testbucket", "object", testrand.Bytes(1*memory.KiB))
+		require.NoError(t, err)
+
+		objects, err := satelliteSys.API.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Len(t, objects, 1)
+		existing := objects[0]
+
+		header := &pb.RequestHeader{
+			ApiKey: apiKey.SerializeRaw(),
+		}
+
+		beginObject := func() *pb.ObjectBeginResponse {
+			resp, err := endpoint.BeginObject(ctx, &pb.ObjectBeginRequest{
+				Header:             header,
+				Bucket:             []byte("testbucket"),
+				EncryptedObjectKey: []byte(existing.ObjectKey),
+				EncryptionParameters: &pb.EncryptionParameters{
+					CipherSuite: pb.CipherSuite_ENC_AESGCM,
+					BlockSize:   256,
+				},
+			})
+			require.NoError(t, err)
+			return resp
+		}
+
+		// invalid conditions are rejected
+		_, err = endpoint.CommitObject(ctx, &pb.ObjectCommitRequest{
+			Header:   header,
+			StreamId: beginObject().StreamId,
+			XXX_unrecognized: metainfo.WriteCondition{
+				IfNoneMatch:    true,
+				IfMatchVersion: existing.StreamVersionID().Bytes(),
+			}.AppendTo(nil),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.InvalidArgument))
+
+		// object exists already
+		_, err = endpoint.CommitObject(ctx, &pb.ObjectCommitRequest{
+			Header:           header,
+			StreamId:         beginObject().StreamId,
+			XXX_unrecognized: metainfo.WriteCondition{IfNoneMatch: true}.AppendTo(nil),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		// latest version matches
+		commitResp, err := endpoint.CommitObject(ctx, &pb.ObjectCommitRequest{
+			Header:           header,
+			StreamId:         beginObject().StreamId,
+			XXX_unrecognized: metainfo.WriteCondition{IfMatchVersion: existing.StreamVersionID().Bytes()}.AppendTo(nil),
+		})
+		require.NoError(t, err)
+
+		// existing object was replaced, so its version doesn't match anymore
+		_, err = endpoint.BeginDeleteObject(ctx, &pb.ObjectBeginDeleteRequest{
+			Header:             header,
+			Bucket:             []byte("testbucket"),
+			EncryptedObjectKey: []byte(existing.ObjectKey),
+			XXX_unrecognized:   metainfo.WriteCondition{IfMatchVersion: existing.StreamVersionID().Bytes()}.AppendTo(nil),
+		})
+		require.True(t, errs2.IsRPC(err, rpcstatus.FailedPrecondition))
+
+		// unmet conditions don't reveal the object without read or list permission
+		deleteOnlyKey, err := apiKey.Restrict(macaroon.Caveat{DisallowReads: true, DisallowLists: true})
+		require.NoError(t, err)
+
+		deleteResp, err := endpoint.BeginDeleteObject(ctx, &pb.ObjectBeginDeleteRequest{
+			Header:             &pb.RequestHeader{ApiKey: deleteOnlyKey.SerializeRaw()},
+			Bucket:             []byte("testbucket"),
+			EncryptedObjectKey: []byte(existing.ObjectKey),
+			XXX_unrecognized:   metainfo.WriteCondition{IfMatchVersion: existing.StreamVersionID().Bytes()}.AppendTo(nil),
+		})
+		require.NoError(t, err)
+		require.Nil(t, deleteResp.Object)
+
+		_, err = endpoint.BeginDeleteObject(ctx, &pb.ObjectBeginDeleteRequest{
+			Header:             header,
+			Bucket:             []byte("testbucket"),
+			EncryptedObjectKey: []byte(existing.ObjectKey),
+			XXX_unrecognized:   metainfo.WriteCondition{IfMatchVersion: commitResp.Object.ObjectVersion}.AppendTo(nil),
+		})
+		require.NoError(t, err)
+
+		objects, err = satelliteSys.API.Metainfo.Metabase.TestingAllObjects(ctx)
+		require.NoError(t, err)
+		require.Empty(t, objects)
+	})
+}
+
 func TestEndpoint_ParallelDeletes(t *testing.T) {
 	t.Skip("to be fixed - creating deadlocks")
 	testplanet.Run(t, testplanet.Config{
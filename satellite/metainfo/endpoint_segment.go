@@ -76,7 +76,7 @@ func (endpoint *Endpoint) beginSegment(ctx context.Context, req *pb.SegmentBegin
 		}
 	}
 
-	placement := endpoint.placement[storj.PlacementConstraint(streamID.Placement)]
+	placement := endpoint.getPlacement(storj.PlacementConstraint(streamID.Placement))
 	config := endpoint.config
 	rsParams := config.RS.Override(placement.EC)
 	defaultRedundancy := storj.RedundancyScheme{
@@ -90,6 +90,9 @@ func (endpoint *Endpoint) beginSegment(ctx context.Context, req *pb.SegmentBegin
 
 	maxPieceSize := defaultRedundancy.PieceSize(req.MaxOrderLimit)
 
+	// the nearby node selector needs the location of the uplink.
+	endpoint.locateUplink(ctx, peer.ID)
+
 	nodes, err := endpoint.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
 		RequestedCount: int(defaultRedundancy.TotalShares),
 		Placement:      storj.PlacementConstraint(streamID.Placement),
@@ -467,10 +470,13 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 	// increment our counters in the success tracker appropriate to the committing uplink
 	{
 		tracker := endpoint.successTrackers.GetTracker(peer.ID)
+		latencyTracker := endpoint.latencyTrackers.GetTracker(peer.ID)
 		isTrusted := endpoint.trustedUplinks.IsTrusted(peer.ID)
+		now := time.Now()
 		validPieceSet := make(map[storj.NodeID]struct{}, len(validPieces))
 		for _, piece := range validPieces {
 			tracker.Increment(piece.NodeId, true)
+			latencyTracker.Observe(piece.NodeId, pieceUploadDuration(segmentID.CreationDate, piece, now))
 			if isTrusted {
 				endpoint.failureTracker.Increment(piece.NodeId, true)
 			}
@@ -479,6 +485,7 @@ func (endpoint *Endpoint) CommitSegment(ctx context.Context, req *pb.SegmentComm
 		for _, limit := range originalLimits {
 			if _, ok := validPieceSet[limit.StorageNodeId]; !ok {
 				tracker.Increment(limit.StorageNodeId, false)
+				latencyTracker.Fail(limit.StorageNodeId)
 				if isTrusted {
 					endpoint.failureTracker.Increment(limit.StorageNodeId, false)
 				}
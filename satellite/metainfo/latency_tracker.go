@@ -0,0 +1,268 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"golang.org/x/exp/maps"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+// LatencyTracker describes a type that is told how long the uploads to the
+// nodes took, and can be queried for the expected upload duration of a node.
+type LatencyTracker interface {
+	// Observe tells the LatencyTracker how long a successful upload to a node
+	// took.
+	Observe(node storj.NodeID, duration time.Duration)
+
+	// Fail tells the LatencyTracker that an upload to a node didn't finish
+	// (e.g. it was cancelled as part of the long tail).
+	Fail(node storj.NodeID)
+
+	// Get returns the expected upload duration of a node in seconds. It can
+	// return NaN to indicate that it has no information about the node.
+	Get(node *nodeselection.SelectedNode) float64
+
+	// BumpGeneration should be called periodically to clear out stale
+	// information.
+	BumpGeneration()
+
+	monkit.StatSource
+}
+
+// GetNewLatencyTracker returns a function that creates a new LatencyTracker,
+// which estimates the upload duration with the given percentile.
+func GetNewLatencyTracker(percentile float64) (func() LatencyTracker, error) {
+	if percentile <= 0 || percentile >= 100 {
+		return nil, errs.New("latency tracker percentile should be between 0 and 100 (exclusive), not %v", percentile)
+	}
+	return func() LatencyTracker { return NewPercentileLatencyTracker(percentile) }, nil
+}
+
+// pieceUploadDuration returns how long the upload of a piece took, from the
+// creation of the (satellite signed) segment ID in BeginSegment until the
+// storage node signed the piece hash. The piece hash timestamp comes from the
+// clock of the node, so the duration is limited to the time passed on the
+// satellite clock.
+func pieceUploadDuration(segmentCreated time.Time, piece *pb.SegmentPieceUploadResult, now time.Time) time.Duration {
+	total := now.Sub(segmentCreated)
+	if piece.Hash == nil {
+		return total
+	}
+	duration := piece.Hash.Timestamp.Sub(segmentCreated)
+	return max(min(duration, total), 0)
+}
+
+// LatencyTrackers manages global and uplink level latency trackers.
+type LatencyTrackers struct {
+	trackers map[storj.NodeID]LatencyTracker
+	global   LatencyTracker
+}
+
+var _ nodeselection.UploadLatencyTracker = (*LatencyTrackers)(nil)
+
+// NewLatencyTrackers creates a new latency tracker.
+func NewLatencyTrackers(approvedUplinks []storj.NodeID, newTracker func() LatencyTracker) *LatencyTrackers {
+	global := newTracker()
+	trackers := make(map[storj.NodeID]LatencyTracker, len(approvedUplinks))
+	for _, uplink := range approvedUplinks {
+		trackers[uplink] = newTracker()
+	}
+
+	return &LatencyTrackers{
+		trackers: trackers,
+		global:   global,
+	}
+}
+
+// BumpGeneration will bump all the managed trackers.
+func (t *LatencyTrackers) BumpGeneration() {
+	for _, tracker := range t.trackers {
+		tracker.BumpGeneration()
+	}
+	t.global.BumpGeneration()
+}
+
+// GetTracker returns the tracker for the specific uplink. Returns with the
+// global tracker, if uplink is not whitelisted.
+func (t *LatencyTrackers) GetTracker(uplink storj.NodeID) LatencyTracker {
+	if tracker, ok := t.trackers[uplink]; ok {
+		return tracker
+	}
+	return t.global
+}
+
+// Get returns a function that can be used to get the expected upload duration
+// of a node (in seconds) for a given uplink.
+func (t *LatencyTrackers) Get(uplink storj.NodeID) func(node *nodeselection.SelectedNode) float64 {
+	return t.GetTracker(uplink).Get
+}
+
+// Stats reports monkit statistics for all of the trackers.
+func (t *LatencyTrackers) Stats(cb func(monkit.SeriesKey, string, float64)) {
+	ids := maps.Keys(t.trackers)
+	sort.Slice(ids, func(i, j int) bool { return ids[i].Less(ids[j]) })
+
+	for _, id := range ids {
+		t.trackers[id].Stats(func(key monkit.SeriesKey, field string, val float64) {
+			cb(key.WithTag("uplink_id", id.String()), field, val)
+		})
+	}
+	t.global.Stats(func(key monkit.SeriesKey, field string, val float64) {
+		cb(key.WithTag("uplink_id", "global"), field, val)
+	})
+}
+
+//
+// percentile latency tracker
+//
+
+const (
+	// latencyBuckets is the number of the histogram buckets. The upper bound
+	// of the bucket i is 2^i milliseconds, the last bucket is for everything
+	// slower than ~1 hour (including the failed uploads).
+	latencyBuckets = 24
+	// latencyDecay is the factor of the weight of the old observations at each
+	// generation bump.
+	latencyDecay = 0.5
+	// latencyMinWeight is the weight below which a node is forgotten.
+	latencyMinWeight = 1.0 / 16
+)
+
+type latencyHistogram struct {
+	mu     sync.Mutex
+	counts [latencyBuckets]float64
+	total  float64
+}
+
+func (h *latencyHistogram) add(bucket int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.counts[bucket]++
+	h.total++
+}
+
+// percentile returns the upper bound of the bucket where the percentile
+// falls, in seconds.
+func (h *latencyHistogram) percentile(p float64) float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.total < latencyMinWeight {
+		return math.NaN()
+	}
+	target := h.total * p / 100
+	var sum float64
+	for i, count := range h.counts {
+		sum += count
+		if sum >= target && count > 0 {
+			return float64(uint64(1)<<i) / 1000
+		}
+	}
+	return float64(uint64(1)<<(latencyBuckets-1)) / 1000
+}
+
+// decay reduces the weight of the existing observations. It returns false if
+// there is no relevant information left.
+func (h *latencyHistogram) decay() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.total = 0
+	for i := range h.counts {
+		h.counts[i] *= latencyDecay
+		h.total += h.counts[i]
+	}
+	return h.total >= latencyMinWeight
+}
+
+func latencyBucket(duration time.Duration) int {
+	if duration <= 0 {
+		return 0
+	}
+	bucket := bits.Len64(uint64(duration / time.Millisecond))
+	if bucket >= latencyBuckets {
+		bucket = latencyBuckets - 1
+	}
+	return bucket
+}
+
+// percentileLatencyTracker keeps a decaying histogram of the upload durations
+// per node. Failed uploads are counted as the slowest ones.
+type percentileLatencyTracker struct {
+	mu         sync.Mutex
+	data       sync.Map // storj.NodeID -> *latencyHistogram
+	percentile float64
+}
+
+// NewPercentileLatencyTracker creates a new latency tracker which estimates
+// the upload duration of the nodes with the given percentile of their
+// observed upload durations.
+func NewPercentileLatencyTracker(percentile float64) LatencyTracker {
+	return &percentileLatencyTracker{percentile: percentile}
+}
+
+func (t *percentileLatencyTracker) histogram(node storj.NodeID) *latencyHistogram {
+	histI, ok := t.data.Load(node)
+	if !ok {
+		histI, _ = t.data.LoadOrStore(node, new(latencyHistogram))
+	}
+	hist, _ := histI.(*latencyHistogram)
+	return hist
+}
+
+func (t *percentileLatencyTracker) Observe(node storj.NodeID, duration time.Duration) {
+	t.histogram(node).add(latencyBucket(duration))
+}
+
+func (t *percentileLatencyTracker) Fail(node storj.NodeID) {
+	t.histogram(node).add(latencyBuckets - 1)
+}
+
+func (t *percentileLatencyTracker) Get(node *nodeselection.SelectedNode) float64 {
+	histI, ok := t.data.Load(node.ID)
+	if !ok {
+		return math.NaN()
+	}
+	hist, _ := histI.(*latencyHistogram)
+	return hist.percentile(t.percentile)
+}
+
+// BumpGeneration decays the weight of the old observations, so slow nodes can
+// recover.
+func (t *percentileLatencyTracker) BumpGeneration() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.data.Range(func(node, histI any) bool {
+		hist, _ := histI.(*latencyHistogram)
+		if !hist.decay() {
+			t.data.Delete(node)
+		}
+		return true
+	})
+}
+
+func (t *percentileLatencyTracker) Stats(cb func(monkit.SeriesKey, string, float64)) {
+	dist := monkit.NewFloatDist(monkit.NewSeriesKey("latency_tracker"))
+
+	t.data.Range(func(_, histI any) bool {
+		hist, _ := histI.(*latencyHistogram)
+		val := hist.percentile(t.percentile)
+		if !math.IsNaN(val) {
+			dist.Insert(val)
+		}
+		return true
+	})
+
+	dist.Stats(cb)
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+func TestPercentileLatencyTracker(t *testing.T) {
+	t.Parallel()
+
+	tr := NewPercentileLatencyTracker(50)
+
+	fast, slow := storj.NodeID{0: 1}, storj.NodeID{0: 2}
+	latency := func(id storj.NodeID) float64 {
+		return tr.Get(&nodeselection.SelectedNode{ID: id})
+	}
+
+	require.True(t, math.IsNaN(latency(fast)))
+
+	for i := 0; i < 10; i++ {
+		tr.Observe(fast, 100*time.Millisecond)
+		tr.Observe(slow, 3*time.Second)
+	}
+
+	require.Equal(t, 0.128, latency(fast))
+	require.Equal(t, 4.096, latency(slow))
+
+	// the failed uploads are counted as the slowest ones
+	for i := 0; i < 11; i++ {
+		tr.Fail(fast)
+	}
+	require.Greater(t, latency(fast), latency(slow))
+
+	// the old observations decay, so the node can recover
+	tr.BumpGeneration()
+	for i := 0; i < 20; i++ {
+		tr.Observe(fast, 100*time.Millisecond)
+	}
+	require.Equal(t, 0.128, latency(fast))
+
+	// nodes without recent observations are forgotten
+	for i := 0; i < 10; i++ {
+		tr.BumpGeneration()
+	}
+	require.True(t, math.IsNaN(latency(fast)))
+	require.True(t, math.IsNaN(latency(slow)))
+}
+
+func TestLatencyTrackers(t *testing.T) {
+	t.Parallel()
+
+	uplink, node := storj.NodeID{0: 1}, storj.NodeID{0: 2}
+
+	_, err := GetNewLatencyTracker(100)
+	require.Error(t, err)
+	newTracker, err := GetNewLatencyTracker(90)
+	require.NoError(t, err)
+
+	trackers := NewLatencyTrackers([]storj.NodeID{uplink}, newTracker)
+	trackers.GetTracker(uplink).Observe(node, time.Second)
+	require.Equal(t, 1.024, trackers.Get(uplink)(&nodeselection.SelectedNode{ID: node}))
+	require.True(t, math.IsNaN(trackers.Get(storj.NodeID{})(&nodeselection.SelectedNode{ID: node})))
+
+	trackers.GetTracker(storj.NodeID{3}).Observe(node, time.Millisecond)
+	require.Equal(t, 0.002, trackers.Get(storj.NodeID{4})(&nodeselection.SelectedNode{ID: node}))
+}
+
+func TestPieceUploadDuration(t *testing.T) {
+	t.Parallel()
+
+	created := time.Now().Add(-10 * time.Second)
+	now := created.Add(5 * time.Second)
+
+	fast, slow := storj.NodeID{0: 1}, storj.NodeID{0: 2}
+	pieces := []*pb.SegmentPieceUploadResult{
+		{NodeId: fast, Hash: &pb.PieceHash{Timestamp: created.Add(100 * time.Millisecond)}},
+		{NodeId: slow, Hash: &pb.PieceHash{Timestamp: created.Add(3 * time.Second)}},
+	}
+
+	tr := NewPercentileLatencyTracker(50)
+	for _, piece := range pieces {
+		tr.Observe(piece.NodeId, pieceUploadDuration(created, piece, now))
+	}
+
+	// nodes of the same segment are scored by their own upload duration.
+	require.Equal(t, 0.128, tr.Get(&nodeselection.SelectedNode{ID: fast}))
+	require.Equal(t, 4.096, tr.Get(&nodeselection.SelectedNode{ID: slow}))
+
+	// the clock of the node can't make the duration negative or longer than
+	// the time passed on the satellite.
+	require.Zero(t, pieceUploadDuration(created, &pb.SegmentPieceUploadResult{Hash: &pb.PieceHash{Timestamp: created.Add(-time.Second)}}, now))
+	require.Equal(t, 5*time.Second, pieceUploadDuration(created, &pb.SegmentPieceUploadResult{Hash: &pb.PieceHash{Timestamp: now.Add(time.Hour)}}, now))
+}
@@ -10,6 +10,7 @@ import (
 
 	"storj.io/common/storj"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/shared/modular/config"
 	"storj.io/storj/shared/mud"
 )
@@ -44,6 +45,31 @@ func Module(ball *mud.Ball) {
 
 	})
 
+	mud.Provide[*LatencyTrackers](ball, func(log *zap.Logger, cfg Config) (*LatencyTrackers, error) {
+		var uplinks []storj.NodeID
+		for _, uplinkIDString := range cfg.SuccessTrackerTrustedUplinks {
+			uplinkID, err := storj.NodeIDFromString(uplinkIDString)
+			if err != nil {
+				log.Warn("Wrong uplink ID for the trusted list of the latency trackers", zap.String("uplink", uplinkIDString), zap.Error(err))
+			}
+			uplinks = append(uplinks, uplinkID)
+		}
+		newTracker, err := GetNewLatencyTracker(cfg.LatencyTrackerPercentile)
+		if err != nil {
+			return nil, err
+		}
+		trackers := NewLatencyTrackers(uplinks, newTracker)
+		monkit.ScopeNamed(mon.Name() + ".latency_trackers").Chain(trackers)
+		return trackers, nil
+	})
+
+	mud.Provide[*UplinkLocations](ball, func(cfg Config) *UplinkLocations {
+		return NewUplinkLocations(cfg.UplinkLocationCapacity, cfg.UplinkLocationExpiration)
+	})
+	mud.View[*UplinkLocations, nodeselection.UplinkLocator](ball, func(locations *UplinkLocations) nodeselection.UplinkLocator {
+		return locations
+	})
+
 	mud.Provide[SuccessTracker](ball, func(log *zap.Logger, cfg Config) SuccessTracker {
 		tracker := NewPercentSuccessTracker()
 		monkit.ScopeNamed(mon.Name() + ".failure_tracker").Chain(tracker)
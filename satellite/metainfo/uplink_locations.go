@@ -0,0 +1,134 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/common/rpc/rpcpeer"
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+// UplinkLocations remembers the geolocated country of the recently seen
+// uplinks, so the nearby node selector can prefer the nodes close to them.
+type UplinkLocations struct {
+	capacity   int
+	expiration time.Duration
+	nowFn      func() time.Time
+
+	mu        sync.Mutex
+	locations map[storj.NodeID]uplinkLocation
+}
+
+type uplinkLocation struct {
+	country location.CountryCode
+	updated time.Time
+}
+
+var _ nodeselection.UplinkLocator = (*UplinkLocations)(nil)
+
+// NewUplinkLocations creates a new UplinkLocations which remembers at most
+// capacity uplinks for the expiration time. A zero capacity disables it.
+func NewUplinkLocations(capacity int, expiration time.Duration) *UplinkLocations {
+	return &UplinkLocations{
+		capacity:   capacity,
+		expiration: expiration,
+		nowFn:      time.Now,
+		locations:  make(map[storj.NodeID]uplinkLocation),
+	}
+}
+
+// Enabled returns true if the locations of the uplinks are remembered.
+func (l *UplinkLocations) Enabled() bool {
+	return l != nil && l.capacity > 0
+}
+
+// Known returns true if the location of the uplink was set recently.
+func (l *UplinkLocations) Known(uplink storj.NodeID) bool {
+	if !l.Enabled() {
+		return false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.locations[uplink]
+	return ok && l.nowFn().Sub(entry.updated) < l.expiration
+}
+
+// Set sets the country of the uplink.
+func (l *UplinkLocations) Set(uplink storj.NodeID, country location.CountryCode) {
+	if !l.Enabled() {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.nowFn()
+	if _, ok := l.locations[uplink]; !ok && len(l.locations) >= l.capacity {
+		for id, entry := range l.locations {
+			if now.Sub(entry.updated) >= l.expiration {
+				delete(l.locations, id)
+			}
+		}
+		// still full, drop a random uplink.
+		for id := range l.locations {
+			if len(l.locations) < l.capacity {
+				break
+			}
+			delete(l.locations, id)
+		}
+	}
+	l.locations[uplink] = uplinkLocation{country: country, updated: now}
+}
+
+// Locate implements nodeselection.UplinkLocator.
+func (l *UplinkLocations) Locate(uplink storj.NodeID) location.CountryCode {
+	if !l.Enabled() {
+		return location.None
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	entry, ok := l.locations[uplink]
+	if !ok || l.nowFn().Sub(entry.updated) >= l.expiration {
+		return location.None
+	}
+	return entry.country
+}
+
+// locateUplink geolocates the IP address of the uplink, unless its location
+// is already known.
+//
+// Trusted uplinks, like the gateways, are not located: their address is not
+// the address of the clients they serve, and all the instances of a gateway
+// share the same identity, so the selection falls back to all the nodes.
+func (endpoint *Endpoint) locateUplink(ctx context.Context, uplink storj.NodeID) {
+	if !endpoint.uplinkLocations.Enabled() || endpoint.overlay.GeoIP == nil || endpoint.uplinkLocations.Known(uplink) {
+		return
+	}
+	if endpoint.trustedUplinks.IsTrusted(uplink) {
+		return
+	}
+
+	peer, err := rpcpeer.FromContext(ctx)
+	if err != nil {
+		return
+	}
+
+	country, err := endpoint.overlay.GeoIP.LookupISOCountryCode(peer.Addr.String())
+	if err != nil {
+		endpoint.log.Debug("unable to geolocate uplink", zap.Stringer("Uplink", uplink), zap.Error(err))
+		return
+	}
+	endpoint.uplinkLocations.Set(uplink, country)
+}
@@ -0,0 +1,51 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package metainfo
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testrand"
+	"storj.io/storj/shared/location"
+)
+
+func TestUplinkLocations(t *testing.T) {
+	now := time.Now()
+	locations := NewUplinkLocations(2, time.Hour)
+	locations.nowFn = func() time.Time { return now }
+
+	first, second, third := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+
+	require.False(t, locations.Known(first))
+	require.Equal(t, location.None, locations.Locate(first))
+
+	locations.Set(first, location.Japan)
+	require.True(t, locations.Known(first))
+	require.Equal(t, location.Japan, locations.Locate(first))
+
+	// expired locations are unknown.
+	now = now.Add(time.Hour)
+	require.False(t, locations.Known(first))
+	require.Equal(t, location.None, locations.Locate(first))
+
+	// the expired location is dropped, when the capacity is reached.
+	locations.Set(second, location.Germany)
+	locations.Set(third, location.UnitedStates)
+	require.Len(t, locations.locations, 2)
+	require.Equal(t, location.Germany, locations.Locate(second))
+	require.Equal(t, location.UnitedStates, locations.Locate(third))
+
+	// the capacity is never exceeded.
+	locations.Set(first, location.Japan)
+	require.Len(t, locations.locations, 2)
+	require.Equal(t, location.Japan, locations.Locate(first))
+
+	disabled := NewUplinkLocations(0, time.Hour)
+	disabled.Set(first, location.Japan)
+	require.False(t, disabled.Enabled())
+	require.Equal(t, location.None, disabled.Locate(first))
+}
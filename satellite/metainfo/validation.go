@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/subtle"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -141,6 +142,11 @@ func (endpoint *Endpoint) ValidateAuthN(ctx context.Context, header *pb.RequestH
 		}
 	}
 
+	if err = endpoint.checkScopedRate(ctx, keyInfo, rateLimitKind, permissions); err != nil {
+		endpoint.log.Debug("scoped rate check failed", zap.Error(err))
+		return nil, err
+	}
+
 	return keyInfo, nil
 }
 
@@ -208,6 +214,11 @@ func (endpoint *Endpoint) ValidateAuthAny(ctx context.Context, header *pb.Reques
 		*p.ActionPermitted = key.Check(ctx, keyInfo.Secret, keyInfo.Version, p.Action, endpoint.revocations) == nil
 	}
 
+	if err = endpoint.checkScopedRate(ctx, keyInfo, rateLimitKind, required); err != nil {
+		endpoint.log.Debug("scoped rate check failed", zap.Error(err))
+		return nil, err
+	}
+
 	return keyInfo, nil
 }
 
@@ -845,3 +856,87 @@ func keyInfoToLimits(keyInfo *console.APIKeyInfo) accounting.ProjectLimits {
 		BurstLimit: keyInfo.ProjectBurstLimit,
 	}
 }
+
+// checkScopedRate validates whether the rate limiter configured for the API key or
+// for the bucket of the request has been hit. Unlike checkRate, these limits are
+// grouped into list, read and write operations and they are only checked after
+// the request is authorized, so unauthorized requests don't use up the tokens.
+func (endpoint *Endpoint) checkScopedRate(ctx context.Context, apiKeyInfo *console.APIKeyInfo, rateKind console.LimitKind, permissions []VerifyPermission) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	if !endpoint.config.RateLimiter.Enabled {
+		return nil
+	}
+
+	limits := endpoint.config.RateLimiter
+	if len(limits.APIKeyLimits) == 0 && len(limits.BucketLimits) == 0 {
+		return nil
+	}
+
+	op, ok := console.RateLimitOperationOf(rateKind)
+	if !ok {
+		return nil
+	}
+
+	if keyLimits, ok := limits.APIKeyLimits.Get(apiKeyInfo.Head); ok {
+		limiterKey := "key:" + hex.EncodeToString(apiKeyInfo.Head) + ":" + string(op)
+		if err := endpoint.allowScoped(ctx, limiterKey, keyLimits.Policy(op), rateKind); err != nil {
+			return err
+		}
+	}
+
+	// a copy or a move across buckets checks the permissions of both buckets,
+	// and it's limited by both of them, but every bucket is counted only once.
+	checked := make(map[string]struct{}, len(permissions))
+	for _, p := range permissions {
+		if p.Optional || len(p.Action.Bucket) == 0 {
+			continue
+		}
+		if _, ok := checked[string(p.Action.Bucket)]; ok {
+			continue
+		}
+		checked[string(p.Action.Bucket)] = struct{}{}
+
+		bucketLimits, ok := limits.BucketLimits[console.BucketRateLimitKey{
+			ProjectID:  apiKeyInfo.ProjectID,
+			BucketName: string(p.Action.Bucket),
+		}]
+		if !ok {
+			continue
+		}
+
+		limiterKey := "bucket:" + apiKeyInfo.ProjectID.String() + "/" + string(p.Action.Bucket) + ":" + string(op)
+		if err := endpoint.allowScoped(ctx, limiterKey, bucketLimits.Policy(op), rateKind); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (endpoint *Endpoint) allowScoped(ctx context.Context, limiterKey string, policy *console.RateLimitPolicy, rateKind console.LimitKind) error {
+	if policy == nil {
+		return nil
+	}
+
+	limiter, err := endpoint.limiterCache.Get(ctx, limiterKey, func() (*rate.Limiter, error) {
+		return rate.NewLimiter(rate.Limit(policy.Rate), policy.Burst), nil
+	})
+	if err != nil {
+		return rpcstatus.Error(rpcstatus.Unavailable, err.Error())
+	}
+
+	if limiter.AllowN(endpoint.rateLimiterTime(), 1) {
+		return nil
+	}
+
+	if limiter.Burst() == 0 && limiter.Limit() == 0 {
+		return rpcstatus.Error(rpcstatus.PermissionDenied, "All access disabled")
+	}
+
+	mon.Event("metainfo_scoped_rate_limit_exceeded")
+
+	if rateKind == console.RateLimitPutNoError {
+		return nil
+	}
+	return rpcstatus.Error(rpcstatus.ResourceExhausted, "Too Many Requests")
+}
@@ -447,6 +447,111 @@ func TestEndpoint_checkRate(t *testing.T) {
 		})
 }
 
+func TestEndpoint_checkScopedRate(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 0, UplinkCount: 1,
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		sat := planet.Satellites[0]
+		endpoint := sat.Metainfo.Endpoint
+		projectID := planet.Uplinks[0].Projects[0].ID
+		apiKey := planet.Uplinks[0].APIKey[sat.ID()]
+
+		for _, bucket := range []string{"limited", "unlimited"} {
+			require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, sat, bucket))
+		}
+
+		rateLimiterTime := time.Now()
+		endpoint.TestingSetRateLimiterTime(func() time.Time {
+			return rateLimiterTime
+		})
+
+		listObjects := func(bucket string) error {
+			_, err := endpoint.ListObjects(ctx, &pb.ObjectListRequest{
+				Header: &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket: []byte(bucket),
+			})
+			return err
+		}
+		getObject := func(bucket string) error {
+			_, err := endpoint.GetObject(ctx, &pb.ObjectGetRequest{
+				Header:             &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket:             []byte(bucket),
+				EncryptedObjectKey: []byte("missing"),
+			})
+			return err
+		}
+
+		t.Run("bucket", func(t *testing.T) {
+			endpoint.TestingSetScopedRateLimits(console.BucketRateLimits{
+				{ProjectID: projectID, BucketName: "limited"}: {
+					List: &console.RateLimitPolicy{Rate: 1, Burst: 2},
+				},
+			}, nil)
+
+			for i := 0; i < 2; i++ {
+				require.NoError(t, listObjects("limited"))
+			}
+			require.True(t, errs2.IsRPC(listObjects("limited"), rpcstatus.ResourceExhausted))
+
+			// other buckets and operations are not affected
+			require.NoError(t, listObjects("unlimited"))
+			require.True(t, errs2.IsRPC(getObject("limited"), rpcstatus.NotFound))
+
+			// tokens are refilled over time
+			rateLimiterTime = rateLimiterTime.Add(time.Second)
+			require.NoError(t, listObjects("limited"))
+		})
+
+		t.Run("api key", func(t *testing.T) {
+			endpoint.TestingSetScopedRateLimits(nil, console.APIKeyRateLimits{
+				string(apiKey.Head()): {
+					Read: &console.RateLimitPolicy{Rate: 1, Burst: 1},
+				},
+			})
+
+			require.True(t, errs2.IsRPC(getObject("unlimited"), rpcstatus.NotFound))
+			require.True(t, errs2.IsRPC(getObject("unlimited"), rpcstatus.ResourceExhausted))
+
+			// restricted keys share the limits of the API key
+			restricted, err := apiKey.Restrict(macaroon.Caveat{DisallowDeletes: true})
+			require.NoError(t, err)
+			_, err = endpoint.GetObject(ctx, &pb.ObjectGetRequest{
+				Header:             &pb.RequestHeader{ApiKey: restricted.SerializeRaw()},
+				Bucket:             []byte("unlimited"),
+				EncryptedObjectKey: []byte("missing"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.ResourceExhausted))
+
+			require.NoError(t, listObjects("unlimited"))
+		})
+
+		t.Run("disabled", func(t *testing.T) {
+			endpoint.TestingSetScopedRateLimits(console.BucketRateLimits{
+				{ProjectID: projectID, BucketName: "limited"}: {
+					Write: &console.RateLimitPolicy{},
+				},
+			}, nil)
+
+			_, err := endpoint.BeginDeleteObject(ctx, &pb.ObjectBeginDeleteRequest{
+				Header:             &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket:             []byte("limited"),
+				EncryptedObjectKey: []byte("missing"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+
+			// moving an object across buckets is limited by the destination too.
+			_, err = endpoint.BeginMoveObject(ctx, &pb.ObjectBeginMoveRequest{
+				Header:                &pb.RequestHeader{ApiKey: apiKey.SerializeRaw()},
+				Bucket:                []byte("unlimited"),
+				EncryptedObjectKey:    []byte("missing"),
+				NewBucket:             []byte("limited"),
+				NewEncryptedObjectKey: []byte("missing"),
+			})
+			require.True(t, errs2.IsRPC(err, rpcstatus.PermissionDenied))
+		})
+	})
+}
+
 func TestEndpoint_checkUserStatus(t *testing.T) {
 	testplanet.Run(t, testplanet.Config{
 		SatelliteCount: 1, StorageNodeCount: 1, UplinkCount: 2,
@@ -5,6 +5,7 @@ package metrics
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 	"time"
 
@@ -28,7 +29,7 @@ type Observer struct {
 	metrics PlacementsMetrics
 }
 
-var _ rangedloop.Observer = (*Observer)(nil)
+var _ rangedloop.ResumableObserver = (*Observer)(nil)
 
 // NewObserver instantiates a new rangedloop observer which aggregates
 // object statistics from observed segments.
@@ -83,6 +84,61 @@ func (obs *Observer) Finish(ctx context.Context) error {
 	return nil
 }
 
+// SavePartial implements rangedloop.ResumableObserver.
+func (obs *Observer) SavePartial(ctx context.Context, partial rangedloop.Partial) ([]byte, error) {
+	fork, ok := partial.(*observerFork)
+	if !ok {
+		return nil, Error.New("expected %T but got %T", fork, partial)
+	}
+
+	data, err := json.Marshal(savedFork{
+		Totals:                fork.totals,
+		StreamID:              fork.streamID,
+		StreamPlacement:       fork.streamPlacement,
+		RemoteSegments:        fork.stream.remoteSegments,
+		RemoteBytes:           fork.stream.remoteBytes,
+		InlineSegments:        fork.stream.inlineSegments,
+		InlineBytes:           fork.stream.inlineBytes,
+		SegmentsWithExpiresAt: fork.stream.segmentsWithExpiresAt,
+	})
+	return data, Error.Wrap(err)
+}
+
+// RestorePartial implements rangedloop.ResumableObserver.
+func (obs *Observer) RestorePartial(ctx context.Context, data []byte) (rangedloop.Partial, error) {
+	var saved savedFork
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &observerFork{
+		totals:          saved.Totals,
+		streamID:        saved.StreamID,
+		streamPlacement: saved.StreamPlacement,
+		stream: streamMetrics{
+			remoteSegments:        saved.RemoteSegments,
+			remoteBytes:           saved.RemoteBytes,
+			inlineSegments:        saved.InlineSegments,
+			inlineBytes:           saved.InlineBytes,
+			segmentsWithExpiresAt: saved.SegmentsWithExpiresAt,
+		},
+	}, nil
+}
+
+// savedFork is the persisted state of a fork. The metrics of the last stream
+// are saved separately, because the stream may continue in the next batch.
+type savedFork struct {
+	Totals          PlacementsMetrics         `json:"totals"`
+	StreamID        uuid.UUID                 `json:"streamId"`
+	StreamPlacement storj.PlacementConstraint `json:"streamPlacement"`
+
+	RemoteSegments        int64 `json:"remoteSegments"`
+	RemoteBytes           int64 `json:"remoteBytes"`
+	InlineSegments        int64 `json:"inlineSegments"`
+	InlineBytes           int64 `json:"inlineBytes"`
+	SegmentsWithExpiresAt int64 `json:"segmentsWithExpiresAt"`
+}
+
 // TestingMetrics returns the accumulated metrics. It is intended to be called
 // from tests.
 func (obs *Observer) TestingMetrics() PlacementsMetrics {
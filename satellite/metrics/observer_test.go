@@ -131,6 +131,31 @@ func TestObserver(t *testing.T) {
 		require.Equal(t, expectedMetrics, metrics)
 	})
 
+	t.Run("partial survives save and restore", func(t *testing.T) {
+		obs := NewObserver()
+		expected := loop(t, obs, remote3, inline4, remote5)
+
+		err := obs.Start(ctx, time.Time{})
+		require.NoError(t, err)
+
+		// Split in the middle of a stream, so the restored fork must know
+		// which stream it was in.
+		segments := combineSegments(remote3, inline4, remote5)
+		partial, err := obs.Fork(ctx)
+		require.NoError(t, err)
+		require.NoError(t, partial.Process(ctx, segments[:2]))
+
+		data, err := obs.SavePartial(ctx, partial)
+		require.NoError(t, err)
+		restored, err := obs.RestorePartial(ctx, data)
+		require.NoError(t, err)
+		require.NoError(t, restored.Process(ctx, segments[2:]))
+
+		require.NoError(t, obs.Join(ctx, restored))
+		require.NoError(t, obs.Finish(ctx))
+		require.Equal(t, expected, obs.TestingMetrics())
+	})
+
 	t.Run("join fails gracefully on bad partial type", func(t *testing.T) {
 		type wrongPartial struct{ rangedloop.Partial }
 		obs := NewObserver()
@@ -4,6 +4,8 @@
 package satellite
 
 import (
+	"context"
+
 	"go.uber.org/zap"
 
 	"storj.io/common/debug"
@@ -21,6 +23,7 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/console"
 	"storj.io/storj/satellite/console/consoleweb"
+	"storj.io/storj/satellite/internalpb"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metainfo"
@@ -62,9 +65,10 @@ func Module(ball *mud.Ball) {
 
 	{
 		// TODO: fix reversed dependency (nodeselection -> overlay).
-		mud.Provide[nodeselection.PlacementDefinitions](ball, func(config nodeselection.PlacementConfig, selectionConfig overlay.NodeSelectionConfig, env *nodeselection.PlacementConfigEnvironment) (nodeselection.PlacementDefinitions, error) {
-			return config.Placement.Parse(selectionConfig.CreateDefaultPlacement, env)
+		mud.Provide[*nodeselection.PlacementReloader](ball, func(ctx context.Context, log *zap.Logger, config nodeselection.PlacementConfig, selectionConfig overlay.NodeSelectionConfig, db nodeselection.PlacementConfigDB, reloadConfig nodeselection.PlacementReloadConfig, env *nodeselection.PlacementConfigEnvironment) (*nodeselection.PlacementReloader, error) {
+			return nodeselection.LoadPlacementReloader(ctx, log, config.Placement, selectionConfig.CreateDefaultPlacement, db, reloadConfig, env)
 		})
+		mud.View[DB, nodeselection.PlacementConfigDB](ball, DB.PlacementConfigs)
 		nodeselection.Module(ball)
 	}
 	rangedloop.Module(ball)
@@ -116,8 +120,31 @@ func Module(ball *mud.Ball) {
 		}
 		return &EndpointRegistration{}, nil
 	})
-
+	mud.Provide[*RangedLoopCoordinatorRegistration](ball, func(srv *server.Server, service *rangedloop.Service) (*RangedLoopCoordinatorRegistration, error) {
+		coordinator := service.Coordinator()
+		if coordinator == nil {
+			return &RangedLoopCoordinatorRegistration{}, nil
+		}
+		err := internalpb.DRPCRegisterRangedLoopCoordinator(srv.PrivateDRPC(), coordinator)
+		if err != nil {
+			return nil, err
+		}
+		return &RangedLoopCoordinatorRegistration{}, nil
+	})
+	mud.Provide[*PlacementReloadRegistration](ball, func(reloader *nodeselection.PlacementReloader, overlayService *overlay.Service, metainfoEndpoint *metainfo.Endpoint) *PlacementReloadRegistration {
+		reloader.OnReload(overlayService.SetPlacements)
+		reloader.OnReload(metainfoEndpoint.SetPlacements)
+		return &PlacementReloadRegistration{}
+	})
 }
 
 // EndpointRegistration is a pseudo component to wire server and DRPC endpoints together.
 type EndpointRegistration struct{}
+
+// RangedLoopCoordinatorRegistration is a pseudo component to register the
+// coordinator of the distributed ranged loop on the private DRPC server.
+type RangedLoopCoordinatorRegistration struct{}
+
+// PlacementReloadRegistration is a pseudo component to register the placement
+// consumers of the API process on the placement reloader.
+type PlacementReloadRegistration struct{}
@@ -0,0 +1,95 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodehistory
+
+import (
+	"context"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/sync2"
+)
+
+var (
+	// Error is the standard error class for node history.
+	Error = errs.Class("node history")
+	mon   = monkit.Package()
+)
+
+// Config contains configurable values for the node history chore.
+type Config struct {
+	Interval  time.Duration `help:"how often to record a snapshot of the state of every node" default:"24h"`
+	Retention time.Duration `help:"how long to keep the node history entries" default:"2160h"`
+	BatchSize int           `help:"number of nodes recorded, or entries deleted, in a single query" default:"1000"`
+}
+
+// Chore records snapshots of the state of the nodes and deletes the entries
+// older than the retention.
+//
+// architecture: Chore
+type Chore struct {
+	log    *zap.Logger
+	db     DB
+	config Config
+	nowFn  func() time.Time
+	Loop   *sync2.Cycle
+}
+
+// NewChore is a constructor for Chore.
+func NewChore(log *zap.Logger, db DB, config Config) *Chore {
+	return &Chore{
+		log:    log,
+		db:     db,
+		config: config,
+		nowFn:  time.Now,
+		Loop:   sync2.NewCycle(config.Interval),
+	}
+}
+
+// Run runs the chore.
+func (chore *Chore) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	return chore.Loop.Run(ctx, func(ctx context.Context) error {
+		if err := chore.RunOnce(ctx); err != nil {
+			chore.log.Error("failed to record node history", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// RunOnce records a snapshot of every node and deletes the expired entries.
+func (chore *Chore) RunOnce(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := chore.nowFn()
+
+	count, err := chore.db.RecordSnapshots(ctx, now, chore.config.BatchSize)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	mon.IntVal("node_history_snapshots_recorded").Observe(int64(count))
+
+	deleted, err := chore.db.DeleteBefore(ctx, now.Add(-chore.config.Retention), chore.config.BatchSize)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	mon.IntVal("node_history_entries_deleted").Observe(deleted)
+
+	chore.log.Debug("node history recorded", zap.Int("snapshots", count), zap.Int64("deleted", deleted))
+	return nil
+}
+
+// SetNow sets nowFn on chore for testing.
+func (chore *Chore) SetNow(f func() time.Time) {
+	chore.nowFn = f
+}
+
+// Close closes the chore.
+func (chore *Chore) Close() error {
+	chore.Loop.Close()
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodehistory
+
+import (
+	"context"
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// DB implements the database for the node history.
+//
+// architecture: Database
+type DB interface {
+	// RecordSnapshots records the current state of every node which is neither
+	// disqualified nor exited, batchSize nodes at a time.
+	RecordSnapshots(ctx context.Context, recordedAt time.Time, batchSize int) (count int, err error)
+	// RecordTransitions records the current state of the nodes after their state changed.
+	RecordTransitions(ctx context.Context, nodeIDs []storj.NodeID, recordedAt time.Time, details string) (err error)
+	// Get returns the entries of a node recorded in [since, before), the most recent first.
+	Get(ctx context.Context, nodeID storj.NodeID, since, before time.Time, limit int) (entries []Entry, err error)
+	// DeleteBefore deletes the entries recorded before the given time.
+	DeleteBefore(ctx context.Context, before time.Time, batchSize int) (deleted int64, err error)
+}
+
+// Kind is the kind of a node history entry.
+type Kind int
+
+const (
+	// Snapshot is an entry recorded periodically for every node.
+	Snapshot Kind = 0
+	// Transition is an entry recorded when the state of a node changed.
+	Transition Kind = 1
+)
+
+// String returns the name of the kind.
+func (kind Kind) String() string {
+	switch kind {
+	case Snapshot:
+		return "snapshot"
+	case Transition:
+		return "transition"
+	default:
+		return "unknown"
+	}
+}
+
+// Entry is the state of a node at a point in time.
+type Entry struct {
+	NodeID     storj.NodeID
+	RecordedAt time.Time
+	Kind       Kind
+
+	Address           string
+	Version           string
+	FreeDisk          int64
+	AuditScore        float64
+	UnknownAuditScore float64
+	OnlineScore       float64
+
+	Vetted       bool
+	Suspended    bool
+	Exiting      bool
+	Disqualified bool
+
+	// Details describes the cause of a transition.
+	Details string
+}
@@ -24,6 +24,9 @@ type placementConfig struct {
 	// helpers which can be re-used later to simplify config
 	Templates map[string]string
 
+	// named node sets, which can be referenced as region("name")
+	Regions []regionDefinition
+
 	// the placement definitions
 	Placements []placementDefinition
 }
@@ -44,6 +47,12 @@ type UploadSuccessTracker interface {
 	Get(uplink storj.NodeID) func(node *SelectedNode) float64
 }
 
+// UploadLatencyTracker can give hints about the upload duration per node.
+type UploadLatencyTracker interface {
+	// Get gives the expected upload duration (in seconds) of the node for the uplink. Can be math.NaN (no information).
+	Get(uplink storj.NodeID) func(node *SelectedNode) float64
+}
+
 // UploadFailureTracker keeps track of node failures.
 type UploadFailureTracker interface {
 	Get(node *SelectedNode) float64
@@ -64,24 +73,53 @@ func (n NoopSuccessTracker) Get(uplink storj.NodeID) func(node *SelectedNode) fl
 
 var _ UploadSuccessTracker = NoopSuccessTracker{}
 
+// NoopLatencyTracker doesn't track upload durations at all. Always returns with NaN.
+type NoopLatencyTracker struct {
+}
+
+// Get implements UploadLatencyTracker.
+func (n NoopLatencyTracker) Get(uplink storj.NodeID) func(node *SelectedNode) float64 {
+	return func(node *SelectedNode) float64 { return math.NaN() }
+}
+
+var _ UploadLatencyTracker = NoopLatencyTracker{}
+
 // PlacementConfigEnvironment includes all generic functions and variables, which can be used in the configuration.
 type PlacementConfigEnvironment struct {
 	successTracker UploadSuccessTracker
 	failureTracker UploadFailureTracker
+	latencyTracker UploadLatencyTracker
+	uplinkLocator  UplinkLocator
+	regions        map[string]*Region
 }
 
 // NewPlacementConfigEnvironment creates PlacementConfigEnvironment.
-func NewPlacementConfigEnvironment(successTracker UploadSuccessTracker, failureTracker UploadFailureTracker) *PlacementConfigEnvironment {
+func NewPlacementConfigEnvironment(successTracker UploadSuccessTracker, failureTracker UploadFailureTracker, uplinkLocator UplinkLocator) *PlacementConfigEnvironment {
 	if successTracker == nil {
 		successTracker = NoopSuccessTracker{}
 	}
 	if failureTracker == nil {
 		failureTracker = uploadFailureTrackerFunc(func(node *SelectedNode) float64 { return math.NaN() })
 	}
+	if uplinkLocator == nil {
+		uplinkLocator = NoopUplinkLocator{}
+	}
 	return &PlacementConfigEnvironment{
 		successTracker: successTracker,
 		failureTracker: failureTracker,
+		latencyTracker: NoopLatencyTracker{},
+		uplinkLocator:  uplinkLocator,
+	}
+}
+
+// WithLatencyTracker returns a copy of the environment, where the latency tracker can be referenced.
+func (e *PlacementConfigEnvironment) WithLatencyTracker(latencyTracker UploadLatencyTracker) *PlacementConfigEnvironment {
+	if e == nil {
+		e = NewPlacementConfigEnvironment(nil, nil, nil)
 	}
+	res := *e
+	res.latencyTracker = latencyTracker
+	return &res
 }
 
 func (e *PlacementConfigEnvironment) apply(env map[any]any) {
@@ -91,6 +129,39 @@ func (e *PlacementConfigEnvironment) apply(env map[any]any) {
 	env["tracker"] = e.successTracker // backcompat
 	env["uploadSuccessTracker"] = e.successTracker
 	env["uploadFailureTracker"] = e.failureTracker
+	env["uploadLatencyTracker"] = e.latencyTracker
+	env["uplinkLocator"] = e.uplinkLocator
+	env["region"] = e.region
+	env["regions"] = func(names ...string) (NodeAttribute, error) {
+		var regions []*Region
+		for _, name := range names {
+			region, err := e.region(name)
+			if err != nil {
+				return nil, err
+			}
+			regions = append(regions, region)
+		}
+		return RegionAttribute(regions...), nil
+	}
+}
+
+// region returns the region with the given name.
+func (e *PlacementConfigEnvironment) region(name string) (*Region, error) {
+	region, ok := e.regions[name]
+	if !ok {
+		return nil, errs.New("region %q is not defined", name)
+	}
+	return region, nil
+}
+
+// withRegions returns a copy of the environment, where the regions can be referenced.
+func (e *PlacementConfigEnvironment) withRegions(regions map[string]*Region) *PlacementConfigEnvironment {
+	if e == nil {
+		e = NewPlacementConfigEnvironment(nil, nil, nil)
+	}
+	res := *e
+	res.regions = regions
+	return &res
 }
 
 // LoadConfig loads the placement yaml file and creates the Placement definitions.
@@ -134,6 +205,19 @@ func LoadConfigFromString(config string, environment *PlacementConfigEnvironment
 		return val
 	}
 
+	regions := map[string]*Region{}
+	for _, def := range cfg.Regions {
+		region, err := newRegion(def)
+		if err != nil {
+			return placements, errs.New("Region definition '%s' is invalid: %v", def.Name, err)
+		}
+		if _, found := regions[region.Name]; found {
+			return placements, errs.New("Region '%s' is defined multiple times", region.Name)
+		}
+		regions[region.Name] = region
+	}
+	environment = environment.withRegions(regions)
+
 	for _, def := range cfg.Placements {
 		p := Placement{
 			ID:   def.ID,
@@ -146,9 +230,10 @@ func LoadConfigFromString(config string, environment *PlacementConfigEnvironment
 		if err != nil {
 			return placements, errs.New("Filter definition '%s' of placement %d is invalid: %v", filter, def.ID, err)
 		}
+		p.Regions = FilterRegions(p.NodeFilter)
 
 		invariant := resolveTemplates(def.Invariant)
-		p.Invariant, err = InvariantFromString(invariant)
+		p.Invariant, err = InvariantFromString(invariant, environment)
 		if err != nil {
 			return placements, errs.New("Invariant definition '%s' of placement %d is invalid: %v", invariant, def.ID, err)
 		}
@@ -271,6 +356,7 @@ func DownloadSelectorFromString(expr string, environment *PlacementConfigEnviron
 		"random":    DefaultDownloadSelector,
 		"choiceofn": DownloadChoiceOfN,
 		"best":      DownloadBest,
+		"prefer":    DownloadPrefer,
 	}
 	environment.apply(env)
 	selector, err := mito.Eval(expr, env)
@@ -327,6 +413,27 @@ func SelectorFromString(expr string, environment *PlacementConfigEnvironment) (N
 			}
 			return BalancedGroupBasedSelector(attr, filter), nil
 		},
+		"nearby": func(locator UplinkLocator, minRemote float64, attribute string, delegate NodeSelectorInit) (NodeSelectorInit, error) {
+			var attr NodeAttribute
+			if attribute != "" {
+				var err error
+				attr, err = CreateNodeAttribute(attribute)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return NearbySelector(locator, minRemote, attr, delegate), nil
+		},
+		"capacity": func(attribute string, power float64) (NodeSelectorInit, error) {
+			attr, err := CreateNodeAttribute(attribute)
+			if err != nil {
+				return nil, err
+			}
+			if math.IsNaN(power) || power < 0 {
+				return nil, Error.New("power of the capacity selector must be non-negative: %f", power)
+			}
+			return CapacitySelector(attr, power), nil
+		},
 		"weighted": func(attribute string, defaultWeight float64, filter NodeFilter) (NodeSelectorInit, error) {
 			value, err := CreateNodeValue(attribute)
 			if err != nil {
@@ -386,6 +493,7 @@ func SelectorFromString(expr string, environment *PlacementConfigEnvironment) (N
 		"lastbut":            LastBut,
 		"median":             Median,
 		"piececount":         PieceCount,
+		"latency":            Latency,
 		// deprecated: use * -1 instead
 		"desc":           Desc,
 		"node_attribute": CreateNodeAttribute,
@@ -418,19 +526,27 @@ func SelectorFromString(expr string, environment *PlacementConfigEnvironment) (N
 }
 
 // InvariantFromString parses complex invariants (~declumping rules) from config lines.
-func InvariantFromString(expr string) (Invariant, error) {
+func InvariantFromString(expr string, environment *PlacementConfigEnvironment) (Invariant, error) {
 	if expr == "" {
 		return AllGood(), nil
 	}
 	env := map[any]any{
-		"maxcontrol": func(attribute string, max int64) (Invariant, error) {
-			attr, err := CreateNodeAttribute(attribute)
-			if err != nil {
-				return nil, err
+		"maxcontrol": func(attribute any, max int64) (Invariant, error) {
+			switch value := attribute.(type) {
+			case NodeAttribute:
+				return ClumpingByAttribute(value, int(max)), nil
+			case string:
+				attr, err := CreateNodeAttribute(value)
+				if err != nil {
+					return nil, err
+				}
+				return ClumpingByAttribute(attr, int(max)), nil
+			default:
+				return nil, Error.New("argument of maxcontrol must be a node attribute (or string), not %T", attribute)
 			}
-			return ClumpingByAttribute(attr, int(max)), nil
 		},
 	}
+	environment.apply(env)
 	filter, err := mito.Eval(expr, env)
 	if err != nil {
 		return nil, errs.New("Invalid invariant definition '%s', %v", expr, err)
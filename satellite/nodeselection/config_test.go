@@ -4,6 +4,8 @@
 package nodeselection
 
 import (
+	"fmt"
+	"strconv"
 	"testing"
 
 	"github.com/jtolio/mito"
@@ -18,7 +20,7 @@ import (
 
 func TestParsedConfig(t *testing.T) {
 
-	config, err := LoadConfig("config_test.yaml", NewPlacementConfigEnvironment(mockTracker{}, nil))
+	config, err := LoadConfig("config_test.yaml", NewPlacementConfigEnvironment(mockTracker{}, nil, nil))
 	require.NoError(t, err)
 	require.Len(t, config, 13)
 
@@ -111,7 +113,7 @@ func TestParsedConfig(t *testing.T) {
 
 func TestParsedConfigWithoutTracker(t *testing.T) {
 	// tracker is not available for certain microservices (like repair). Still the placement should work.
-	config, err := LoadConfig("config_test.yaml", NewPlacementConfigEnvironment(nil, nil))
+	config, err := LoadConfig("config_test.yaml", NewPlacementConfigEnvironment(nil, nil, nil))
 	require.NoError(t, err)
 	require.Len(t, config, 13)
 
@@ -135,8 +137,134 @@ func TestParsedConfigWithoutTracker(t *testing.T) {
 
 }
 
+func TestRegions(t *testing.T) {
+	signer := testrand.NodeID()
+	config, err := LoadConfigFromString(fmt.Sprintf(`
+regions:
+  - name: eu
+    description: European Union
+    countries: ["EU"]
+  - name: us
+    countries: ["US"]
+  - name: certified
+    countries: ["DE", "HU"]
+    tags:
+      - signer: %s
+        name: soc2
+        value: "true"
+    subnets: ["10.0.0.0/8"]
+placements:
+  - id: 1
+    name: eu
+    filter: region("eu") && exclude(region("certified"))
+    invariant: maxcontrol(regions("eu", "us"), 2)
+  - id: 2
+    name: eu-or-us
+    filter: region("eu") || region("us")
+    downloadselector: prefer(region("certified"), random)
+  - id: 3
+    name: certified
+    filter: region("certified")
+`, signer), nil)
+	require.NoError(t, err)
+
+	certified := &SelectedNode{
+		ID:          testrand.NodeID(),
+		CountryCode: location.Hungary,
+		LastNet:     "10.1.2.0",
+		LastIPPort:  "10.1.2.3:28967",
+		Tags: NodeTags{
+			{Signer: signer, Name: "soc2", Value: []byte("true")},
+		},
+	}
+	eu := &SelectedNode{ID: testrand.NodeID(), CountryCode: location.Germany, LastNet: "10.1.3.0"}
+	us := &SelectedNode{ID: testrand.NodeID(), CountryCode: location.UnitedStates, LastNet: "10.1.4.0"}
+	other := &SelectedNode{ID: testrand.NodeID(), CountryCode: location.Japan, LastNet: "10.1.5.0"}
+
+	t.Run("region", func(t *testing.T) {
+		region := config[1].Regions[0]
+		require.Equal(t, "eu", region.Name)
+		require.Equal(t, "European Union", region.Description)
+		require.Len(t, region.Countries, len(EuCountries))
+		require.Contains(t, region.Countries, "DE")
+
+		certifiedWithoutTag := *certified
+		certifiedWithoutTag.Tags = nil
+		outsideSubnet := *certified
+		outsideSubnet.LastIPPort = "11.1.2.3:28967"
+		onlyLastNet := *certified
+		onlyLastNet.LastIPPort = ""
+
+		filter := config[3].NodeFilter
+		require.Equal(t, []string{"DE", "HU"}, config[3].Regions[0].Countries)
+		require.True(t, filter.Match(certified))
+		require.True(t, filter.Match(&onlyLastNet))
+		require.False(t, filter.Match(&certifiedWithoutTag))
+		require.False(t, filter.Match(&outsideSubnet))
+		require.False(t, filter.Match(eu))
+	})
+
+	t.Run("filter", func(t *testing.T) {
+		require.True(t, config[1].NodeFilter.Match(eu))
+		require.False(t, config[1].NodeFilter.Match(certified))
+		require.False(t, config[1].NodeFilter.Match(us))
+
+		require.True(t, config[2].NodeFilter.Match(eu))
+		require.True(t, config[2].NodeFilter.Match(us))
+		require.False(t, config[2].NodeFilter.Match(other))
+
+		// excluded regions are not required by the placement.
+		require.Len(t, config[1].Regions, 1)
+		require.Len(t, config[2].Regions, 2)
+		require.Equal(t, "us", config[2].Regions[1].Name)
+	})
+
+	t.Run("invariant", func(t *testing.T) {
+		nodes := []SelectedNode{*eu, *eu, *certified, *us, *us, *other, *other, *other}
+		var pieces metabase.Pieces
+		for i, node := range nodes {
+			pieces = append(pieces, metabase.Piece{Number: uint16(i), StorageNode: node.ID})
+		}
+		// the third EU node (certified) is too much, nodes outside the regions are not limited.
+		result := config[1].Invariant(pieces, nodes)
+		require.Equal(t, 1, result.Count())
+		require.True(t, result.Contains(2))
+	})
+
+	t.Run("download selector", func(t *testing.T) {
+		nodes := map[storj.NodeID]*SelectedNode{
+			certified.ID: certified,
+			eu.ID:        eu,
+			us.ID:        us,
+		}
+		selected, err := config[2].DownloadSelector(storj.NodeID{}, nodes, 1)
+		require.NoError(t, err)
+		require.Equal(t, map[storj.NodeID]*SelectedNode{certified.ID: certified}, selected)
+
+		selected, err = config[2].DownloadSelector(storj.NodeID{}, nodes, 2)
+		require.NoError(t, err)
+		require.Len(t, selected, 3)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, tc := range []string{
+			`regions: [{name: eu}]`,
+			`regions: [{countries: ["DE"]}]`,
+			`regions: [{name: eu, countries: ["XYZ"]}]`,
+			`regions: [{name: eu, subnets: ["10.0.0.0"]}]`,
+			`regions: [{name: eu, tags: [{signer: invalid, name: soc2}]}]`,
+			`regions: [{name: eu, countries: ["DE"]}, {name: eu, countries: ["HU"]}]`,
+			`placements: [{id: 1, filter: region("eu")}]`,
+			`placements: [{id: 1, invariant: maxcontrol(regions("eu"), 1)}]`,
+		} {
+			_, err := LoadConfigFromString(tc, nil)
+			require.Error(t, err, tc)
+		}
+	})
+}
+
 func TestFilterFromString(t *testing.T) {
-	filter, err := FilterFromString(`exclude(nodelist("filter_testdata.txt"))`, NewPlacementConfigEnvironment(nil, nil))
+	filter, err := FilterFromString(`exclude(nodelist("filter_testdata.txt"))`, NewPlacementConfigEnvironment(nil, nil, nil))
 	require.NoError(t, err)
 
 	require.False(t, filter.Match(&SelectedNode{
@@ -172,6 +300,79 @@ func TestSelectorFromString(t *testing.T) {
 
 }
 
+func TestNearbySelectorFromString(t *testing.T) {
+	uplink := testrand.NodeID()
+	environment := NewPlacementConfigEnvironment(nil, nil, locatorFunc(func(id storj.NodeID) location.CountryCode {
+		if id == uplink {
+			return location.Japan
+		}
+		return location.None
+	}))
+
+	selector, err := SelectorFromString(`nearby(uplinkLocator, 0.5, "last_net", random())`, environment)
+	require.NoError(t, err)
+
+	var nodes []*SelectedNode
+	for i := 0; i < 10; i++ {
+		for _, country := range []location.CountryCode{location.Japan, location.Germany} {
+			nodes = append(nodes, &SelectedNode{
+				ID:          testrand.NodeID(),
+				LastNet:     country.String() + strconv.Itoa(i),
+				CountryCode: country,
+			})
+		}
+	}
+
+	initialized := selector(nodes, nil)
+	selected, err := initialized(uplink, 4, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, selected, 4)
+
+	japan := 0
+	for _, node := range selected {
+		if node.CountryCode == location.Japan {
+			japan++
+		}
+	}
+	require.Equal(t, 2, japan)
+
+	_, err = SelectorFromString(`nearby(uplinkLocator, 0.5, "invalid", random())`, environment)
+	require.Error(t, err)
+}
+
+func TestCapacitySelectorFromString(t *testing.T) {
+	environment := NewPlacementConfigEnvironment(ScoreNodeFunc(func(uplink storj.NodeID, node *SelectedNode) float64 {
+		return float64(node.FreeDisk)
+	}), nil, nil)
+
+	selector, err := SelectorFromString(`choiceofn(tracker, 2, unvetted(0.1, capacity("last_net", 0.5)))`, environment)
+	require.NoError(t, err)
+
+	var nodes []*SelectedNode
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, &SelectedNode{
+			ID:       testrand.NodeID(),
+			LastNet:  strconv.Itoa(i),
+			FreeDisk: int64(i),
+			Vetted:   true,
+		})
+	}
+
+	selected, err := selector(nodes, nil)(storj.NodeID{}, 4, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, selected, 4)
+
+	_, err = SelectorFromString(`capacity("last_net", -1)`, environment)
+	require.Error(t, err)
+
+	_, err = SelectorFromString(`capacity("invalid", 1)`, environment)
+	require.Error(t, err)
+}
+
+type locatorFunc func(storj.NodeID) location.CountryCode
+
+func (f locatorFunc) Locate(uplink storj.NodeID) location.CountryCode { return f(uplink) }
+
 func TestTargetType(t *testing.T) {
 	r := targetType(float64(1), ScoreNodeFunc(func(uplink storj.NodeID, node *SelectedNode) float64 {
 		return 0
@@ -16,11 +16,16 @@ type PlacementConfig struct {
 // Module is a mud module.
 func Module(ball *mud.Ball) {
 	// TODO: use trackers when we need them...
-	mud.Provide[*PlacementConfigEnvironment](ball, func() *PlacementConfigEnvironment {
-		return NewPlacementConfigEnvironment(nil, nil)
+	mud.Provide[*PlacementConfigEnvironment](ball, func(uplinkLocator UplinkLocator) *PlacementConfigEnvironment {
+		return NewPlacementConfigEnvironment(nil, nil, uplinkLocator)
 	})
-	mud.View[PlacementDefinitions, PlacementRules](ball, func(p PlacementDefinitions) PlacementRules {
-		return p.CreateFilters
+	mud.View[*PlacementReloader, PlacementDefinitions](ball, func(r *PlacementReloader) PlacementDefinitions {
+		_, placements := r.Placements()
+		return placements
+	})
+	mud.View[*PlacementReloader, PlacementRules](ball, func(r *PlacementReloader) PlacementRules {
+		return r.CreateFilters
 	})
 	config.RegisterConfig[PlacementConfig](ball, "")
+	config.RegisterConfig[PlacementReloadConfig](ball, "placement-reload")
 }
@@ -58,6 +58,8 @@ type SelectedNode struct {
 	PieceCount  int64
 	// free disk space in bytes
 	FreeDisk int64
+	// the node is in a declared maintenance window
+	PlannedOffline bool
 }
 
 // Clone returns a deep clone of the selected node.
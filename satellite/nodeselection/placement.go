@@ -25,6 +25,8 @@ type Placement struct {
 	Name string
 	// binding condition for filtering out nodes
 	NodeFilter NodeFilter
+	// Regions are the named regions required by the NodeFilter.
+	Regions []*Region
 	// Selector is the method how the nodes are selected from the full node space (eg. pick a subnet first, and pick a node from the subnet)
 	Selector NodeSelectorInit
 	// checked by repair job, applied to the full selection. Out of placement items will be replaced by new, selected by the Selector.
@@ -131,7 +133,7 @@ func (c *ConfigurablePlacementRule) Type() string {
 // defaultPlacement is used to create the placement if no placement has been set.
 func (c ConfigurablePlacementRule) Parse(defaultPlacement func() (Placement, error), environment *PlacementConfigEnvironment) (PlacementDefinitions, error) {
 	if environment == nil {
-		environment = NewPlacementConfigEnvironment(nil, nil)
+		environment = NewPlacementConfigEnvironment(nil, nil, nil)
 	}
 	if c.PlacementRules == "" {
 		dp, err := defaultPlacement()
@@ -164,6 +166,18 @@ func (c ConfigurablePlacementRule) Parse(defaultPlacement func() (Placement, err
 	return d, err
 }
 
+// Source returns the source of the placement definitions, if they are
+// defined in a YAML file, which can be reloaded at runtime.
+func (c ConfigurablePlacementRule) Source() (PlacementSource, bool) {
+	if !strings.HasSuffix(c.PlacementRules, ".yaml") {
+		return nil, false
+	}
+	if _, err := os.Stat(c.PlacementRules); err != nil {
+		return nil, false
+	}
+	return FilePlacementSource(c.PlacementRules), true
+}
+
 var _ pflag.Value = &ConfigurablePlacementRule{}
 
 // TestPlacementDefinitions creates placements for testing. Only 0 placement is defined with subnetfiltering.
@@ -3,7 +3,17 @@
 
 package nodeselection
 
-import "storj.io/storj/shared/location"
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"sort"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/shared/location"
+)
 
 // EuCountries defines the member countries of European Union.
 var EuCountries = []location.CountryCode{
@@ -42,3 +52,174 @@ var EeaCountriesWithoutEu = []location.CountryCode{
 	location.Liechtenstein,
 	location.Norway,
 }
+
+// Region is a named set of nodes (defined by countries, tags and subnets),
+// which can be shared across placement definitions.
+type Region struct {
+	// Name is the unique name of the region, used as region("name") in the definitions.
+	Name string
+	// Description is the human-readable description of the region.
+	Description string
+	// Countries are the ISO codes of the countries of the region. Empty, if
+	// the region is not restricted to countries.
+	Countries []string
+
+	countries *CountryFilter
+	tags      NodeFilters
+	subnets   []netip.Prefix
+}
+
+// regionDefinition is the YAML representation of a Region.
+type regionDefinition struct {
+	Name        string
+	Description string
+	Countries   []string
+	Tags        []regionTag
+	Subnets     []string
+}
+
+// regionTag is a tag condition of a region.
+type regionTag struct {
+	Signer string
+	Name   string
+	Value  string
+}
+
+// newRegion validates the definition and creates the Region.
+func newRegion(def regionDefinition) (*Region, error) {
+	if def.Name == "" {
+		return nil, errs.New("name is missing")
+	}
+	if len(def.Countries) == 0 && len(def.Tags) == 0 && len(def.Subnets) == 0 {
+		return nil, errs.New("at least one country, tag or subnet should be defined")
+	}
+
+	region := &Region{
+		Name:        def.Name,
+		Description: def.Description,
+	}
+
+	if len(def.Countries) > 0 {
+		for _, country := range def.Countries {
+			if country == "" {
+				return nil, errs.New("empty country code")
+			}
+		}
+		filter, err := NewCountryFilterFromString(def.Countries)
+		if err != nil {
+			return nil, err
+		}
+		region.countries = filter
+		for country, iso := range location.CountryISOCode {
+			if iso != "" && filter.permit.Contains(location.CountryCode(country)) {
+				region.Countries = append(region.Countries, iso)
+			}
+		}
+		sort.Strings(region.Countries)
+	}
+
+	for _, tag := range def.Tags {
+		signer, err := storj.NodeIDFromString(tag.Signer)
+		if err != nil {
+			return nil, errs.New("invalid tag signer %q: %v", tag.Signer, err)
+		}
+		if tag.Name == "" {
+			return nil, errs.New("tag name is missing")
+		}
+		region.tags = append(region.tags, NewTagFilter(signer, tag.Name, []byte(tag.Value), bytes.Equal))
+	}
+
+	for _, subnet := range def.Subnets {
+		prefix, err := netip.ParsePrefix(subnet)
+		if err != nil {
+			return nil, errs.New("invalid subnet %q: %v", subnet, err)
+		}
+		region.subnets = append(region.subnets, prefix.Masked())
+	}
+
+	return region, nil
+}
+
+// Match implements NodeFilter.
+func (r *Region) Match(node *SelectedNode) bool {
+	if r.countries != nil && !r.countries.Match(node) {
+		return false
+	}
+	if !r.tags.Match(node) {
+		return false
+	}
+	if len(r.subnets) == 0 {
+		return true
+	}
+
+	addr, ok := nodeAddr(node)
+	if !ok {
+		return false
+	}
+	for _, subnet := range r.subnets {
+		if subnet.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Region) String() string {
+	return fmt.Sprintf(`region("%s")`, r.Name)
+}
+
+var _ NodeFilter = &Region{}
+
+// nodeAddr returns the IP address of the node, or the address of its network
+// when only that is known.
+func nodeAddr(node *SelectedNode) (netip.Addr, bool) {
+	if addrPort, err := netip.ParseAddrPort(node.LastIPPort); err == nil {
+		return addrPort.Addr().Unmap(), true
+	}
+	if addr, err := netip.ParseAddr(node.LastNet); err == nil {
+		return addr.Unmap(), true
+	}
+	return netip.Addr{}, false
+}
+
+// RegionAttribute returns the name of the first matching region of the node,
+// or an empty string if none of the regions matches.
+func RegionAttribute(regions ...*Region) NodeAttribute {
+	return func(node SelectedNode) string {
+		for _, region := range regions {
+			if region.Match(&node) {
+				return region.Name
+			}
+		}
+		return ""
+	}
+}
+
+// FilterRegions returns the regions which are required by the filter. Regions
+// which are only used in exclusions are not returned.
+func FilterRegions(filter NodeFilter) (regions []*Region) {
+	var walk func(filter NodeFilter)
+	walk = func(filter NodeFilter) {
+		switch f := filter.(type) {
+		case *Region:
+			for _, region := range regions {
+				if region == f {
+					return
+				}
+			}
+			regions = append(regions, f)
+		case NodeFilters:
+			for _, filter := range f {
+				walk(filter)
+			}
+		case OrFilter:
+			for _, filter := range f {
+				walk(filter)
+			}
+		case AnnotatedNodeFilter:
+			walk(f.Filter)
+		}
+	}
+	walk(filter)
+	return regions
+}
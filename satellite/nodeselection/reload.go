@@ -0,0 +1,298 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/storj"
+	"storj.io/common/sync2"
+)
+
+// PlacementSource provides the YAML placement configuration.
+type PlacementSource interface {
+	// Load returns the current content of the placement configuration.
+	Load(ctx context.Context) ([]byte, error)
+}
+
+// FilePlacementSource reads the placement configuration from a YAML file.
+type FilePlacementSource string
+
+// Load implements PlacementSource.
+func (f FilePlacementSource) Load(ctx context.Context) ([]byte, error) {
+	return os.ReadFile(string(f))
+}
+
+var _ PlacementSource = FilePlacementSource("")
+
+// ErrPlacementConfigNotFound is returned when no placement configuration is stored in the database.
+var ErrPlacementConfigNotFound = errs.Class("placement configuration not found")
+
+// PlacementConfigDB stores the versions of the YAML placement configuration.
+//
+// architecture: Database
+type PlacementConfigDB interface {
+	// Store stores the configuration with its version, as the latest one.
+	Store(ctx context.Context, version string, config []byte) error
+	// Latest returns the most recently stored configuration.
+	Latest(ctx context.Context) (config []byte, err error)
+}
+
+// DBPlacementSource reads the latest placement configuration from the database.
+type DBPlacementSource struct {
+	DB PlacementConfigDB
+}
+
+// Load implements PlacementSource.
+func (d DBPlacementSource) Load(ctx context.Context) ([]byte, error) {
+	return d.DB.Latest(ctx)
+}
+
+var _ PlacementSource = DBPlacementSource{}
+
+// StorePlacementConfig checks the placement configuration, and stores it in
+// the database, so the processes reloading from the database switch to it.
+func StorePlacementConfig(ctx context.Context, db PlacementConfigDB, config []byte) (version string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if _, err := LoadConfigFromString(string(config), nil); err != nil {
+		return "", ErrPlacement.Wrap(err)
+	}
+	version = PlacementVersion(config)
+	return version, db.Store(ctx, version, config)
+}
+
+// LoadPlacements loads the placement configuration from the source, and
+// returns the definitions with the version of the configuration.
+func LoadPlacements(ctx context.Context, source PlacementSource, environment *PlacementConfigEnvironment) (_ PlacementDefinitions, version string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	config, err := source.Load(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	placements, err := LoadConfigFromString(string(config), environment)
+	if err != nil {
+		return nil, "", ErrPlacement.Wrap(err)
+	}
+	return placements, PlacementVersion(config), nil
+}
+
+// PlacementVersion returns the version (content hash) of the placement configuration.
+func PlacementVersion(config []byte) string {
+	hash := sha256.Sum256(config)
+	return hex.EncodeToString(hash[:8])
+}
+
+// VerifyReload checks if the placement definitions can be replaced with the
+// next ones. Placements can be added or changed, but existing placement IDs
+// must not disappear, as there may be segments stored with them.
+func (d PlacementDefinitions) VerifyReload(next PlacementDefinitions) error {
+	var missing []storj.PlacementConstraint
+	for id := range d {
+		if _, found := next[id]; !found {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Slice(missing, func(i, j int) bool { return missing[i] < missing[j] })
+		return ErrPlacement.New("placements would be removed by the reload: %v", missing)
+	}
+	return nil
+}
+
+// LoadPlacementReloader creates the PlacementReloader of a satellite process.
+// When reloading is enabled, the initial definitions are loaded from the
+// configured source, so the version of the reloader is the version of the
+// configuration, which was actually parsed. Otherwise, or when no configuration
+// is stored in the database yet, the definitions of the placement flag are used.
+// Reloading from a file fails, when the placement flag isn't a YAML file, e.g.
+// with inline definitions.
+func LoadPlacementReloader(ctx context.Context, log *zap.Logger, rule ConfigurablePlacementRule, defaultPlacement func() (Placement, error), db PlacementConfigDB, config PlacementReloadConfig, environment *PlacementConfigEnvironment) (_ *PlacementReloader, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var source PlacementSource
+	if config.Interval > 0 {
+		switch config.Source {
+		case "file":
+			var ok bool
+			source, ok = rule.Source()
+			if !ok {
+				return nil, ErrPlacement.New("placement definitions can be reloaded from a file only when the placement flag is an existing YAML file")
+			}
+		case "db":
+			source = DBPlacementSource{DB: db}
+		default:
+			return nil, ErrPlacement.New("unknown placement source %q", config.Source)
+		}
+	}
+
+	if source != nil {
+		placements, version, err := LoadPlacements(ctx, source, environment)
+		if err == nil {
+			return NewPlacementReloader(log, source, environment, placements, version, config), nil
+		}
+		if !ErrPlacementConfigNotFound.Has(err) {
+			return nil, err
+		}
+		log.Info("no placement configuration is stored yet, using the placement flag")
+	}
+
+	placements, err := rule.Parse(defaultPlacement, environment)
+	if err != nil {
+		return nil, err
+	}
+	return NewPlacementReloader(log, source, environment, placements, "", config), nil
+}
+
+// PlacementReloadConfig configures the reloading of the placement definitions.
+type PlacementReloadConfig struct {
+	Interval time.Duration `help:"how often the YAML placement definitions are reloaded at runtime (0 disables reloading)" default:"0s"`
+	Source   string        `help:"where the placement definitions are reloaded from: file (the YAML file of the placement flag) or db (the latest configuration stored with store-placement-config)" default:"file"`
+}
+
+// PlacementListener is called with the new placement definitions after a reload.
+type PlacementListener func(ctx context.Context, placements PlacementDefinitions) error
+
+// PlacementReloader periodically reloads the placement definitions, and
+// propagates them to the listeners, when the configuration is changed.
+//
+// Without a source, it only provides the definitions loaded at startup.
+type PlacementReloader struct {
+	log         *zap.Logger
+	source      PlacementSource
+	environment *PlacementConfigEnvironment
+	Loop        *sync2.Cycle
+
+	// reloadMu makes sure that only one reload runs at the same time.
+	reloadMu sync.Mutex
+
+	mu         sync.Mutex
+	version    string
+	placements PlacementDefinitions
+	listeners  []PlacementListener
+}
+
+// NewPlacementReloader creates a new PlacementReloader. The placements are the
+// definitions, which are already loaded at startup, and version is the version
+// of the configuration they were parsed from (empty, if they don't come from
+// the source).
+func NewPlacementReloader(log *zap.Logger, source PlacementSource, environment *PlacementConfigEnvironment, placements PlacementDefinitions, version string, config PlacementReloadConfig) *PlacementReloader {
+	if config.Interval <= 0 {
+		source = nil
+	}
+	return &PlacementReloader{
+		log:         log,
+		source:      source,
+		environment: environment,
+		Loop:        sync2.NewCycle(config.Interval),
+		version:     version,
+		placements:  placements,
+	}
+}
+
+// Enabled returns true, if the placement definitions are reloaded at runtime.
+func (r *PlacementReloader) Enabled() bool {
+	return r.source != nil
+}
+
+// OnReload registers a listener, which is called after every successful reload.
+func (r *PlacementReloader) OnReload(listener PlacementListener) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.listeners = append(r.listeners, listener)
+}
+
+// Placements returns the current version and placement definitions.
+func (r *PlacementReloader) Placements() (version string, placements PlacementDefinitions) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.version, r.placements
+}
+
+// CreateFilters implements PlacementRules with the current placement definitions.
+func (r *PlacementReloader) CreateFilters(constraint storj.PlacementConstraint) (filter NodeFilter, selector DownloadSelector) {
+	_, placements := r.Placements()
+	return placements.CreateFilters(constraint)
+}
+
+// Run periodically reloads the placement definitions.
+func (r *PlacementReloader) Run(ctx context.Context) error {
+	if !r.Enabled() {
+		return nil
+	}
+	return r.Loop.Run(ctx, func(ctx context.Context) error {
+		if _, err := r.Reload(ctx); err != nil {
+			r.log.Error("failed to reload placement definitions", zap.Error(err))
+		}
+		return nil
+	})
+}
+
+// Reload loads the placement configuration, and replaces the current
+// definitions if the configuration is changed and the change is safe.
+func (r *PlacementReloader) Reload(ctx context.Context) (changed bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	r.reloadMu.Lock()
+	defer r.reloadMu.Unlock()
+
+	if r.source == nil {
+		return false, nil
+	}
+
+	currentVersion, currentPlacements := r.Placements()
+
+	config, err := r.source.Load(ctx)
+	if err != nil {
+		if ErrPlacementConfigNotFound.Has(err) {
+			return false, nil
+		}
+		return false, ErrPlacement.Wrap(err)
+	}
+
+	version := PlacementVersion(config)
+	if version == currentVersion {
+		return false, nil
+	}
+
+	placements, err := LoadConfigFromString(string(config), r.environment)
+	if err != nil {
+		return false, ErrPlacement.Wrap(err)
+	}
+	if err := currentPlacements.VerifyReload(placements); err != nil {
+		return false, err
+	}
+
+	r.mu.Lock()
+	r.version = version
+	r.placements = placements
+	listeners := r.listeners
+	r.mu.Unlock()
+
+	var group errs.Group
+	for _, listener := range listeners {
+		group.Add(listener(ctx, placements))
+	}
+
+	r.log.Info("placement definitions are reloaded", zap.String("previous", currentVersion), zap.String("version", version))
+	mon.Event("placement_reloaded")
+
+	return true, ErrPlacement.Wrap(group.Err())
+}
+
+// Close stops the reloader.
+func (r *PlacementReloader) Close() error {
+	r.Loop.Close()
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/storj/shared/location"
+)
+
+func TestPlacementReloader(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	configFile := filepath.Join(t.TempDir(), "placement.yaml")
+	write := func(config string) {
+		require.NoError(t, os.WriteFile(configFile, []byte(config), 0644))
+	}
+
+	write(`
+placements:
+  - id: 0
+    name: global
+  - id: 1
+    name: eu
+    filter: country("EU")
+`)
+
+	rule := ConfigurablePlacementRule{PlacementRules: configFile}
+	source, ok := rule.Source()
+	require.True(t, ok)
+
+	initial, initialVersion, err := LoadPlacements(ctx, source, nil)
+	require.NoError(t, err)
+	require.Len(t, initial, 2)
+
+	reloader := NewPlacementReloader(zaptest.NewLogger(t), source, nil, initial, initialVersion, PlacementReloadConfig{Interval: time.Minute})
+	require.True(t, reloader.Enabled())
+
+	var reloaded []PlacementDefinitions
+	reloader.OnReload(func(ctx context.Context, placements PlacementDefinitions) error {
+		reloaded = append(reloaded, placements)
+		return nil
+	})
+
+	// the configuration loaded at startup is not reloaded.
+	changed, err := reloader.Reload(ctx)
+	require.NoError(t, err)
+	require.False(t, changed)
+	version, placements := reloader.Placements()
+	require.Equal(t, initialVersion, version)
+	require.Len(t, placements, 2)
+
+	// placements can be changed and added.
+	write(`
+placements:
+  - id: 0
+    name: global
+  - id: 1
+    name: de
+    filter: country("DE")
+  - id: 2
+    name: us
+    filter: country("US")
+`)
+	changed, err = reloader.Reload(ctx)
+	require.NoError(t, err)
+	require.True(t, changed)
+	require.Len(t, reloaded, 1)
+	require.Len(t, reloaded[0], 3)
+	require.Equal(t, "de", reloaded[0][1].Name)
+	filter, _ := reloader.CreateFilters(1)
+	require.False(t, filter.Match(&SelectedNode{CountryCode: location.France}))
+	require.False(t, reloaded[0][1].NodeFilter.Match(&SelectedNode{CountryCode: location.France}))
+
+	newVersion, placements := reloader.Placements()
+	require.NotEqual(t, version, newVersion)
+	require.Len(t, placements, 3)
+
+	// placements can't disappear.
+	write(`
+placements:
+  - id: 0
+    name: global
+  - id: 1
+    name: de
+    filter: country("DE")
+`)
+	_, err = reloader.Reload(ctx)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "[2]")
+
+	// invalid configuration is not loaded.
+	write(`
+placements:
+  - id: 0
+    filter: country(
+  - id: 1
+  - id: 2
+`)
+	_, err = reloader.Reload(ctx)
+	require.Error(t, err)
+
+	require.Len(t, reloaded, 1)
+	version, placements = reloader.Placements()
+	require.Equal(t, newVersion, version)
+	require.Len(t, placements, 3)
+
+	_, ok = ConfigurablePlacementRule{PlacementRules: `10:country("DE")`}.Source()
+	require.False(t, ok)
+}
+
+type memoryPlacementConfigDB struct {
+	versions []string
+	configs  [][]byte
+}
+
+func (db *memoryPlacementConfigDB) Store(ctx context.Context, version string, config []byte) error {
+	db.versions = append(db.versions, version)
+	db.configs = append(db.configs, config)
+	return nil
+}
+
+func (db *memoryPlacementConfigDB) Latest(ctx context.Context) ([]byte, error) {
+	if len(db.configs) == 0 {
+		return nil, ErrPlacementConfigNotFound.New("")
+	}
+	return db.configs[len(db.configs)-1], nil
+}
+
+func TestDBPlacementSource(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	db := &memoryPlacementConfigDB{}
+	source := DBPlacementSource{DB: db}
+
+	_, _, err := LoadPlacements(ctx, source, nil)
+	require.True(t, ErrPlacementConfigNotFound.Has(err))
+
+	// nothing is stored yet, the reloader keeps the definitions of the flag.
+	reloader := NewPlacementReloader(zaptest.NewLogger(t), source, nil, TestPlacementDefinitions(), "", PlacementReloadConfig{Interval: time.Minute})
+	changed, err := reloader.Reload(ctx)
+	require.NoError(t, err)
+	require.False(t, changed)
+
+	// invalid configurations are not stored.
+	_, err = StorePlacementConfig(ctx, db, []byte("placements:\n  - id: 0\n    filter: country(\n"))
+	require.Error(t, err)
+	require.Empty(t, db.versions)
+
+	version, err := StorePlacementConfig(ctx, db, []byte("placements:\n  - id: 0\n    name: global\n  - id: 1\n    name: eu\n"))
+	require.NoError(t, err)
+	require.Equal(t, []string{version}, db.versions)
+
+	changed, err = reloader.Reload(ctx)
+	require.NoError(t, err)
+	require.True(t, changed)
+	current, placements := reloader.Placements()
+	require.Equal(t, version, current)
+	require.Equal(t, "eu", placements[1].Name)
+
+	// a disabled reloader never reloads.
+	disabled := NewPlacementReloader(zaptest.NewLogger(t), source, nil, TestPlacementDefinitions(), "", PlacementReloadConfig{})
+	require.False(t, disabled.Enabled())
+	changed, err = disabled.Reload(ctx)
+	require.NoError(t, err)
+	require.False(t, changed)
+	require.NoError(t, disabled.Run(ctx))
+}
+
+func TestLoadPlacementReloader(t *testing.T) {
+	ctx := testcontext.New(t)
+	log := zaptest.NewLogger(t)
+
+	defaultPlacement := func() (Placement, error) { return Placement{}, nil }
+	inline := ConfigurablePlacementRule{PlacementRules: `10:country("DE")`}
+
+	// inline definitions can't be reloaded from a file.
+	_, err := LoadPlacementReloader(ctx, log, inline, defaultPlacement, nil, PlacementReloadConfig{Interval: time.Minute, Source: "file"}, nil)
+	require.True(t, ErrPlacement.Has(err))
+
+	reloader, err := LoadPlacementReloader(ctx, log, inline, defaultPlacement, nil, PlacementReloadConfig{Source: "file"}, nil)
+	require.NoError(t, err)
+	require.False(t, reloader.Enabled())
+	_, placements := reloader.Placements()
+	require.Contains(t, placements, storj.PlacementConstraint(10))
+}
@@ -405,6 +405,25 @@ func PieceCount(divider int64) ScoreNode {
 	})
 }
 
+// Latency scores the node based on the expected upload duration reported by the latency tracker.
+// Nodes at or below the target duration (in seconds) get the best score (1), slower nodes
+// get proportionally lower scores. Unknown nodes are scored with NaN.
+func Latency(tracker UploadLatencyTracker, target float64) (ScoreNode, error) {
+	if target <= 0 {
+		return nil, Error.New("target latency should be positive, not %f", target)
+	}
+	return ScoreNodeFunc(func(uplink storj.NodeID, node *SelectedNode) float64 {
+		latency := tracker.Get(uplink)(node)
+		if math.IsNaN(latency) {
+			return latency
+		}
+		if latency <= target {
+			return 1
+		}
+		return target / latency
+	}), nil
+}
+
 // LastBut scores a selection based on the worst node (but skip the worst n nodes).
 func LastBut(attr ScoreNode, skip int64) ScoreSelection {
 	return scoreBy(attr, func(l int) int {
@@ -559,6 +578,24 @@ func DownloadBest(tracker UploadSuccessTracker) DownloadSelector {
 	}
 }
 
+// DownloadPrefer downloads only from the nodes matching the filter (like a
+// region close to the uplink), if there are enough of them. Otherwise all the
+// nodes are used. The final selection is done by the delegate.
+func DownloadPrefer(filter NodeFilter, delegate DownloadSelector) DownloadSelector {
+	return func(requester storj.NodeID, possibleNodes map[storj.NodeID]*SelectedNode, needed int) (map[storj.NodeID]*SelectedNode, error) {
+		preferred := make(map[storj.NodeID]*SelectedNode, len(possibleNodes))
+		for id, node := range possibleNodes {
+			if filter.Match(node) {
+				preferred[id] = node
+			}
+		}
+		if len(preferred) < needed {
+			return delegate(requester, possibleNodes, needed)
+		}
+		return delegate(requester, preferred, needed)
+	}
+}
+
 // FilterBest is a selector, which keeps only the best nodes (based on percentage, or fixed number of nodes).
 // this selector will permanently ban the worst nodes for the period of nodeselection cache refresh.
 func FilterBest(tracker UploadSuccessTracker, selection string, uplink string, delegate NodeSelectorInit) NodeSelectorInit {
@@ -764,67 +801,19 @@ func WeightedSelector(weightFunc NodeValue, initFilter NodeFilter) NodeSelectorI
 
 		n := len(filtered)
 
-		normalized := make([]float64, n)
-		total := float64(0)
+		weights := make([]float64, n)
 		for ix, node := range filtered {
-			nodeValue := weightFunc(*node)
-			total += nodeValue
-			normalized[ix] = nodeValue
+			weights[ix] = weightFunc(*node)
 		}
+		table := newAliasTable(weights)
 
-		// in case of all value is zero, we need to select nodes with the same chance
-		// it's safe to use 1, instead of all values --> total will be len(filtered)
-		if total == 0 {
-			total = float64(len(filtered))
-		}
-
-		for ix := range filtered {
-			normalized[ix] = normalized[ix] / total * float64(n)
-		}
-
-		threshold := float64(1)
-		// initialize the buckets
-		var underfull []int
-		var overfull []int
-		for ix := range filtered {
-			if normalized[ix] < threshold {
-				underfull = append(underfull, ix)
-			} else {
-				overfull = append(overfull, ix)
-			}
-		}
-
-		alias := make([]int, n)
-		// pour the overfull buckets into the underfull buckets
-		for len(underfull) > 0 && len(overfull) > 0 {
-			// select one is above and one with under
-			uf := underfull[0]
-			of := overfull[0]
-			underfull = underfull[1:]
-			overfull = overfull[1:]
-
-			alias[uf] = of
-			normalized[of] -= threshold - normalized[uf]
-
-			if normalized[of] < threshold {
-				underfull = append(underfull, of)
-			} else if normalized[of] > threshold {
-				overfull = append(overfull, of)
-			}
-		}
 		return func(requester storj.NodeID, selectN int, excluded []storj.NodeID, alreadySelected []*SelectedNode) ([]*SelectedNode, error) {
 			var selected []*SelectedNode
 			if n == 0 {
 				return selected, nil
 			}
 			for i := 0; i < selectN*5; i++ {
-				r := rand.Intn(n)
-				var selectedNode *SelectedNode
-				if normalized[r] > rand.Float64() {
-					selectedNode = filtered[r]
-				} else {
-					selectedNode = filtered[alias[r]]
-				}
+				selectedNode := filtered[table.sample()]
 				if includedInNodes(alreadySelected, selectedNode) || included(excluded, selectedNode) || includedInNodes(selected, selectedNode) {
 					continue
 				}
@@ -837,3 +826,82 @@ func WeightedSelector(weightFunc NodeValue, initFilter NodeFilter) NodeSelectorI
 		}
 	}
 }
+
+// aliasTable samples indexes with custom probabilities in constant time.
+// The implementation is based on Walker's alias method: https://www.youtube.com/watch?v=retAwpUv42E
+type aliasTable struct {
+	probability []float64
+	alias       []int
+}
+
+// newAliasTable creates an aliasTable, where the chance of each index is
+// proportional to its weight. When all weights are zero, every index has the
+// same chance.
+func newAliasTable(weights []float64) aliasTable {
+	n := len(weights)
+
+	normalized := make([]float64, n)
+	total := float64(0)
+	for ix, weight := range weights {
+		total += weight
+		normalized[ix] = weight
+	}
+
+	// in case of all value is zero, we need to select nodes with the same chance
+	// it's safe to use 1, instead of all values --> total will be len(weights)
+	if total == 0 {
+		total = float64(n)
+		for ix := range normalized {
+			normalized[ix] = 1
+		}
+	}
+
+	for ix := range normalized {
+		normalized[ix] = normalized[ix] / total * float64(n)
+	}
+
+	threshold := float64(1)
+	// initialize the buckets
+	var underfull []int
+	var overfull []int
+	for ix := range normalized {
+		if normalized[ix] < threshold {
+			underfull = append(underfull, ix)
+		} else {
+			overfull = append(overfull, ix)
+		}
+	}
+
+	alias := make([]int, n)
+	// pour the overfull buckets into the underfull buckets
+	for len(underfull) > 0 && len(overfull) > 0 {
+		// select one is above and one with under
+		uf := underfull[0]
+		of := overfull[0]
+		underfull = underfull[1:]
+		overfull = overfull[1:]
+
+		alias[uf] = of
+		normalized[of] -= threshold - normalized[uf]
+
+		if normalized[of] < threshold {
+			underfull = append(underfull, of)
+		} else if normalized[of] > threshold {
+			overfull = append(overfull, of)
+		}
+	}
+
+	return aliasTable{
+		probability: normalized,
+		alias:       alias,
+	}
+}
+
+// sample returns a random index. It must not be called on an empty table.
+func (a aliasTable) sample() int {
+	r := rand.Intn(len(a.probability))
+	if a.probability[r] > rand.Float64() {
+		return r
+	}
+	return a.alias[r]
+}
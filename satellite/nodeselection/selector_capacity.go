@@ -0,0 +1,116 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"math"
+	"math/rand"
+
+	"storj.io/common/storj"
+)
+
+// CapacitySelector selects the groups of nodes (like last_net) with a chance
+// proportional to their free disk space, to make small and big nodes filled up
+// at the same time. Inside a group, the nodes are selected with the same
+// weights.
+//
+// The weight of a node is its free disk space raised to the power: 1 targets
+// equal fill time, values between 0 and 1 dampen the preference of big nodes,
+// and 0 selects all groups with the same chance (like AttributeGroupSelector).
+//
+// When the weighted selection can't find enough groups (e.g. a few huge groups
+// are already selected), the remaining groups are selected with equal chance.
+func CapacitySelector(attribute NodeAttribute, power float64) NodeSelectorInit {
+	if math.IsNaN(power) || power < 0 {
+		panic("power of the capacity selector must be non-negative")
+	}
+
+	return func(nodes []*SelectedNode, filter NodeFilter) NodeSelector {
+		type group struct {
+			nodes   []*SelectedNode
+			weights []float64
+			total   float64
+		}
+
+		var groups []*group
+		groupByAttribute := make(map[string]*group)
+		for _, node := range nodes {
+			if filter != nil && !filter.Match(node) {
+				continue
+			}
+
+			weight := math.Pow(float64(max(node.FreeDisk, 0)), power)
+
+			a := attribute(*node)
+			g, ok := groupByAttribute[a]
+			if !ok {
+				g = &group{}
+				groupByAttribute[a] = g
+				groups = append(groups, g)
+			}
+			g.nodes = append(g.nodes, node)
+			g.weights = append(g.weights, weight)
+			g.total += weight
+		}
+
+		weights := make([]float64, len(groups))
+		for ix, g := range groups {
+			weights[ix] = g.total
+		}
+		table := newAliasTable(weights)
+
+		// pick selects a node of the group with a chance proportional to the
+		// weight, skipping the excluded ones.
+		pick := func(g *group, excluded []storj.NodeID) *SelectedNode {
+			var candidate *SelectedNode
+			total := float64(0)
+			for ix, node := range g.nodes {
+				if included(excluded, node) {
+					continue
+				}
+				// reservoir sampling with weights, a zero weight node is
+				// only selected if there is no better candidate.
+				total += g.weights[ix]
+				if candidate == nil || (total > 0 && rand.Float64()*total < g.weights[ix]) {
+					candidate = node
+				}
+			}
+			return candidate
+		}
+
+		return func(requester storj.NodeID, n int, excluded []storj.NodeID, alreadySelected []*SelectedNode) (selected []*SelectedNode, err error) {
+			if n == 0 || len(groups) == 0 {
+				return selected, nil
+			}
+
+			used := make([]bool, len(groups))
+			try := func(ix int) {
+				if used[ix] {
+					return
+				}
+				used[ix] = true
+
+				g := groups[ix]
+				if includedInNodes(alreadySelected, g.nodes...) {
+					return
+				}
+				if node := pick(g, excluded); node != nil {
+					selected = append(selected, node.Clone())
+				}
+			}
+
+			for i := 0; i < n*5 && len(selected) < n; i++ {
+				try(table.sample())
+			}
+
+			if len(selected) < n {
+				r := NewRandomOrder(len(groups))
+				for r.Next() && len(selected) < n {
+					try(int(r.At()))
+				}
+			}
+			return selected, nil
+		}
+	}
+}
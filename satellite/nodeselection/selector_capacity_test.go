@@ -0,0 +1,170 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/nodeselection"
+)
+
+func TestCapacitySelector(t *testing.T) {
+	t.Run("proportional to free disk", func(t *testing.T) {
+		small := &nodeselection.SelectedNode{ID: testrand.NodeID(), LastNet: "10.0.0.0", FreeDisk: 1000}
+		big := &nodeselection.SelectedNode{ID: testrand.NodeID(), LastNet: "10.0.1.0", FreeDisk: 3000}
+		nodes := []*nodeselection.SelectedNode{small, big}
+
+		for _, tc := range []struct {
+			power    float64
+			expected float64
+		}{
+			{power: 1, expected: 0.75},
+			{power: 0.5, expected: 0.634},
+			{power: 0, expected: 0.5},
+		} {
+			selector := nodeselection.CapacitySelector(nodeselection.LastNetAttribute, tc.power)(nodes, nil)
+
+			bigSelected := 0
+			for i := 0; i < 10000; i++ {
+				selected, err := selector(storj.NodeID{}, 1, nil, nil)
+				require.NoError(t, err)
+				require.Len(t, selected, 1)
+				if selected[0].ID == big.ID {
+					bigSelected++
+				}
+			}
+			require.InDelta(t, tc.expected*10000, bigSelected, 300, "power %f", tc.power)
+		}
+	})
+
+	t.Run("one node per subnet", func(t *testing.T) {
+		var nodes []*nodeselection.SelectedNode
+		for i := 0; i < 20; i++ {
+			nodes = append(nodes, &nodeselection.SelectedNode{
+				ID:       testrand.NodeID(),
+				LastNet:  fmt.Sprintf("10.0.%d.0", i%10),
+				FreeDisk: int64(1000 * (i + 1)),
+			})
+		}
+		selector := nodeselection.CapacitySelector(nodeselection.LastNetAttribute, 1)(nodes, nil)
+
+		for i := 0; i < 100; i++ {
+			excluded := []storj.NodeID{nodes[0].ID}
+			alreadySelected := []*nodeselection.SelectedNode{nodes[1]}
+
+			selected, err := selector(storj.NodeID{}, 9, excluded, alreadySelected)
+			require.NoError(t, err)
+			require.Len(t, selected, 9)
+
+			subnets := map[string]bool{nodes[1].LastNet: true}
+			for _, node := range selected {
+				require.NotEqual(t, nodes[0].ID, node.ID)
+				require.False(t, subnets[node.LastNet], "subnet %s is selected twice", node.LastNet)
+				subnets[node.LastNet] = true
+			}
+		}
+	})
+
+	t.Run("not enough subnets", func(t *testing.T) {
+		nodes := []*nodeselection.SelectedNode{
+			{ID: testrand.NodeID(), LastNet: "10.0.0.0", FreeDisk: 1},
+			{ID: testrand.NodeID(), LastNet: "10.0.1.0", FreeDisk: 1000000},
+			{ID: testrand.NodeID(), LastNet: "10.0.2.0"},
+		}
+		selector := nodeselection.CapacitySelector(nodeselection.LastNetAttribute, 1)(nodes, nil)
+
+		selected, err := selector(storj.NodeID{}, 5, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 3)
+	})
+
+	t.Run("composable", func(t *testing.T) {
+		var nodes []*nodeselection.SelectedNode
+		for i := 0; i < 40; i++ {
+			nodes = append(nodes, &nodeselection.SelectedNode{
+				ID:       testrand.NodeID(),
+				LastNet:  fmt.Sprintf("10.0.%d.0", i),
+				FreeDisk: int64(1000 * (i + 1)),
+				Vetted:   i%4 != 0,
+			})
+		}
+		tracker := nodeselection.ScoreNodeFunc(func(uplink storj.NodeID, node *nodeselection.SelectedNode) float64 {
+			return float64(node.FreeDisk)
+		})
+		selector := nodeselection.ChoiceOfN(tracker, 2,
+			nodeselection.UnvettedSelector(0.2, nodeselection.CapacitySelector(nodeselection.LastNetAttribute, 1)))(nodes, nil)
+
+		selected, err := selector(storj.NodeID{}, 10, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 10)
+
+		subnets := map[string]bool{}
+		for _, node := range selected {
+			require.False(t, subnets[node.LastNet], "subnet %s is selected twice", node.LastNet)
+			subnets[node.LastNet] = true
+		}
+	})
+
+	require.Panics(t, func() {
+		nodeselection.CapacitySelector(nodeselection.LastNetAttribute, -1)
+	})
+}
+
+// TestCapacitySelectorConvergence simulates the uploads to nodes with very
+// different capacity, and checks how full the network is when the first node
+// is filled up.
+func TestCapacitySelectorConvergence(t *testing.T) {
+	simulate := func(init nodeselection.NodeSelectorInit) (fillRatio float64) {
+		var nodes []*nodeselection.SelectedNode
+		capacity := int64(0)
+		for i := 0; i < 30; i++ {
+			node := &nodeselection.SelectedNode{
+				ID:       testrand.NodeID(),
+				LastNet:  fmt.Sprintf("10.0.%d.0", i),
+				FreeDisk: int64(200 * (i%10 + 1)),
+			}
+			capacity += node.FreeDisk
+			nodes = append(nodes, node)
+		}
+
+		used := int64(0)
+		for {
+			// the free space is refreshed periodically, like the upload node cache.
+			selector := init(nodes, nil)
+			for i := 0; i < 10; i++ {
+				selected, err := selector(storj.NodeID{}, 5, nil, nil)
+				require.NoError(t, err)
+				require.Len(t, selected, 5)
+
+				for _, s := range selected {
+					for _, node := range nodes {
+						if node.ID != s.ID {
+							continue
+						}
+						node.FreeDisk--
+						used++
+						if node.FreeDisk == 0 {
+							return float64(used) / float64(capacity)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	random := simulate(nodeselection.AttributeGroupSelector(nodeselection.LastNetAttribute))
+	dampened := simulate(nodeselection.CapacitySelector(nodeselection.LastNetAttribute, 0.5))
+	weighted := simulate(nodeselection.CapacitySelector(nodeselection.LastNetAttribute, 1))
+
+	// with equal chance, the smallest nodes are filled when the network is ~18% full.
+	require.Less(t, random, 0.5)
+	require.Greater(t, dampened, random)
+	require.Greater(t, weighted, dampened)
+	require.Greater(t, weighted, 0.9)
+}
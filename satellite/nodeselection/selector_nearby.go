@@ -0,0 +1,159 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"sync"
+
+	"storj.io/common/storj"
+	"storj.io/storj/shared/location"
+)
+
+// UplinkLocator returns the location of an uplink.
+type UplinkLocator interface {
+	// Locate returns the country of the uplink, or location.None if it's unknown.
+	Locate(uplink storj.NodeID) location.CountryCode
+}
+
+// NoopUplinkLocator doesn't know the location of any uplink.
+type NoopUplinkLocator struct{}
+
+// Locate implements UplinkLocator.
+func (NoopUplinkLocator) Locate(uplink storj.NodeID) location.CountryCode {
+	return location.None
+}
+
+var _ UplinkLocator = NoopUplinkLocator{}
+
+// continentOf maps the countries to the name of their continent. Countries
+// listed on multiple continents belong to the first one in alphabetical order.
+var continentOf = func() map[location.CountryCode]string {
+	names := make([]string, 0, len(location.Continents))
+	for name := range location.Continents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	continents := make(map[location.CountryCode]string)
+	for _, name := range names {
+		for _, country := range location.Continents[name] {
+			if _, ok := continents[country]; !ok {
+				continents[country] = name
+			}
+		}
+	}
+	return continents
+}()
+
+// NearbySelector selects nodes from the continent of the uplink, located by the
+// geolocated IP of the uplink. At least minRemote fraction of the nodes are
+// selected from the other continents, to keep the pieces spread globally.
+// When there aren't enough nodes nearby or on other continents, the remaining
+// nodes are selected from all the nodes.
+//
+// All selections are done with the delegate selector. When attribute is not
+// nil, the nodes sharing the attribute (like last_net) with the nodes selected
+// nearby are excluded from the remote selection, so the pieces are not clumped
+// even if the delegate selector only knows the nodes of its own region. The
+// same applies to the nodes of the already selected pieces.
+func NearbySelector(locator UplinkLocator, minRemote float64, attribute NodeAttribute, delegate NodeSelectorInit) NodeSelectorInit {
+	if math.IsNaN(minRemote) || minRemote < 0 || minRemote > 1 {
+		panic("minimum remote fraction of the nearby selector is invalid")
+	}
+	if locator == nil {
+		locator = NoopUplinkLocator{}
+	}
+
+	return func(nodes []*SelectedNode, filter NodeFilter) NodeSelector {
+		withFilter := func(continentFilter NodeFilter) NodeFilter {
+			if filter == nil {
+				return continentFilter
+			}
+			return NodeFilters{filter, continentFilter}
+		}
+
+		// the selectors of a region are only created when an uplink from
+		// there uploads, as most placements are only used from a few regions.
+		type region struct {
+			once   sync.Once
+			nearby NodeSelector
+			remote NodeSelector
+		}
+
+		global := delegate(nodes, filter)
+
+		nodesByAttribute := make(map[string][]storj.NodeID)
+		if attribute != nil {
+			for _, node := range nodes {
+				if filter != nil && !filter.Match(node) {
+					continue
+				}
+				if value := attribute(*node); value != "" {
+					nodesByAttribute[value] = append(nodesByAttribute[value], node.ID)
+				}
+			}
+		}
+		// exclude returns the excluded nodes extended with the nodes which
+		// share the attribute with the selected nodes.
+		exclude := func(excluded []storj.NodeID, selected []*SelectedNode) []storj.NodeID {
+			if attribute == nil || len(selected) == 0 {
+				return excluded
+			}
+			excluded = slices.Clone(excluded)
+			for _, node := range selected {
+				excluded = append(excluded, nodesByAttribute[attribute(*node)]...)
+			}
+			return excluded
+		}
+
+		regions := make(map[string]*region, len(location.Continents))
+		for name := range location.Continents {
+			regions[name] = &region{}
+		}
+
+		return func(requester storj.NodeID, n int, excluded []storj.NodeID, alreadySelected []*SelectedNode) ([]*SelectedNode, error) {
+			name := continentOf[locator.Locate(requester)]
+			region, ok := regions[name]
+			if !ok {
+				return global(requester, n, excluded, alreadySelected)
+			}
+			region.once.Do(func() {
+				nearby, _ := NewContinentFilterFromString(name)
+				remote, _ := NewContinentFilterFromString("!" + name)
+				region.nearby = delegate(nodes, withFilter(nearby))
+				region.remote = delegate(nodes, withFilter(remote))
+			})
+
+			nearbyCount := n - int(math.Ceil(float64(n)*minRemote))
+
+			var selected []*SelectedNode
+			if nearbyCount > 0 {
+				nearby, err := region.nearby(requester, nearbyCount, exclude(excluded, alreadySelected), alreadySelected)
+				if err != nil {
+					mon.Counter("nearby_selector_nearby_failure").Inc(1)
+				}
+				selected = append(selected, nearby...)
+			}
+
+			remote, err := region.remote(requester, n-len(selected), exclude(excluded, slices.Concat(alreadySelected, selected)), slices.Concat(alreadySelected, selected))
+			if err != nil {
+				mon.Counter("nearby_selector_remote_failure").Inc(1)
+			}
+			selected = append(selected, remote...)
+
+			if len(selected) < n {
+				mon.Counter("nearby_selector_global_fallback").Inc(1)
+				rest, err := global(requester, n-len(selected), exclude(excluded, slices.Concat(alreadySelected, selected)), slices.Concat(alreadySelected, selected))
+				selected = append(selected, rest...)
+				if err != nil {
+					return selected, err
+				}
+			}
+			return selected, nil
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package nodeselection_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/location"
+)
+
+type mapLocator map[storj.NodeID]location.CountryCode
+
+func (m mapLocator) Locate(uplink storj.NodeID) location.CountryCode {
+	return m[uplink]
+}
+
+func TestNearbySelector(t *testing.T) {
+	// every subnet has one node in each country.
+	generate := func(countries map[location.CountryCode]int) (nodes []*nodeselection.SelectedNode) {
+		for country, count := range countries {
+			for i := 0; i < count; i++ {
+				nodes = append(nodes, &nodeselection.SelectedNode{
+					ID:          testrand.NodeID(),
+					LastNet:     fmt.Sprintf("10.0.%d.0", i),
+					CountryCode: country,
+				})
+			}
+		}
+		return nodes
+	}
+	countByCountry := func(nodes []*nodeselection.SelectedNode) map[location.CountryCode]int {
+		counts := map[location.CountryCode]int{}
+		for _, node := range nodes {
+			counts[node.CountryCode]++
+		}
+		return counts
+	}
+
+	tokyo, unknown := testrand.NodeID(), testrand.NodeID()
+	locator := mapLocator{tokyo: location.Japan}
+
+	t.Run("nearby and remote", func(t *testing.T) {
+		nodes := generate(map[location.CountryCode]int{location.Japan: 20, location.Germany: 20, location.UnitedStates: 20})
+		selector := nodeselection.NearbySelector(locator, 0.3, nil, nodeselection.RandomSelector())(nodes, nil)
+
+		for i := 0; i < 100; i++ {
+			selected, err := selector(tokyo, 10, nil, nil)
+			require.NoError(t, err)
+			require.Len(t, selected, 10)
+			require.Equal(t, 7, countByCountry(selected)[location.Japan])
+		}
+	})
+
+	t.Run("unknown uplink", func(t *testing.T) {
+		nodes := generate(map[location.CountryCode]int{location.Japan: 20, location.Germany: 20})
+		selector := nodeselection.NearbySelector(locator, 0.3, nil, nodeselection.RandomSelector())(nodes, nil)
+
+		japan := 0
+		for i := 0; i < 100; i++ {
+			selected, err := selector(unknown, 10, nil, nil)
+			require.NoError(t, err)
+			require.Len(t, selected, 10)
+			japan += countByCountry(selected)[location.Japan]
+		}
+		require.InDelta(t, 500, japan, 150)
+	})
+
+	t.Run("not enough nearby nodes", func(t *testing.T) {
+		nodes := generate(map[location.CountryCode]int{location.Japan: 3, location.Germany: 20})
+		selector := nodeselection.NearbySelector(locator, 0.3, nil, nodeselection.RandomSelector())(nodes, nil)
+
+		selected, err := selector(tokyo, 10, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 10)
+		require.Equal(t, map[location.CountryCode]int{location.Japan: 3, location.Germany: 7}, countByCountry(selected))
+	})
+
+	t.Run("not enough remote nodes", func(t *testing.T) {
+		nodes := generate(map[location.CountryCode]int{location.Japan: 20, location.Germany: 1})
+		selector := nodeselection.NearbySelector(locator, 0.3, nil, nodeselection.RandomSelector())(nodes, nil)
+
+		selected, err := selector(tokyo, 10, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 10)
+		require.Equal(t, map[location.CountryCode]int{location.Japan: 9, location.Germany: 1}, countByCountry(selected))
+	})
+
+	t.Run("placement filter", func(t *testing.T) {
+		nodes := generate(map[location.CountryCode]int{location.Japan: 20, location.Germany: 20})
+		filter := nodeselection.NewCountryFilter(location.NewSet(location.Germany))
+		selector := nodeselection.NearbySelector(locator, 0.3, nil, nodeselection.RandomSelector())(nodes, filter)
+
+		selected, err := selector(tokyo, 10, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, map[location.CountryCode]int{location.Germany: 10}, countByCountry(selected))
+	})
+
+	t.Run("subnets are not clumped", func(t *testing.T) {
+		nodes := generate(map[location.CountryCode]int{location.Japan: 10, location.Germany: 10})
+		selector := nodeselection.NearbySelector(locator, 0.5, nodeselection.LastNetAttribute, nodeselection.AttributeGroupSelector(nodeselection.LastNetAttribute))(nodes, nil)
+
+		for i := 0; i < 100; i++ {
+			selected, err := selector(tokyo, 10, nil, nil)
+			require.NoError(t, err)
+			require.Len(t, selected, 10)
+
+			subnets := map[string]bool{}
+			for _, node := range selected {
+				require.False(t, subnets[node.LastNet], "subnet %s is selected twice", node.LastNet)
+				subnets[node.LastNet] = true
+			}
+		}
+	})
+
+	t.Run("regions are initialized lazily", func(t *testing.T) {
+		nodes := generate(map[location.CountryCode]int{location.Japan: 20, location.Germany: 20})
+		initialized := 0
+		delegate := func(nodes []*nodeselection.SelectedNode, filter nodeselection.NodeFilter) nodeselection.NodeSelector {
+			initialized++
+			return nodeselection.RandomSelector()(nodes, filter)
+		}
+		selector := nodeselection.NearbySelector(locator, 0.3, nil, delegate)(nodes, nil)
+		// only the global selector.
+		require.Equal(t, 1, initialized)
+
+		for i := 0; i < 10; i++ {
+			_, err := selector(tokyo, 10, nil, nil)
+			require.NoError(t, err)
+			_, err = selector(unknown, 10, nil, nil)
+			require.NoError(t, err)
+		}
+		// the nearby and remote selectors of Asia.
+		require.Equal(t, 3, initialized)
+	})
+
+	require.Panics(t, func() {
+		nodeselection.NearbySelector(locator, 1.5, nil, nodeselection.RandomSelector())
+	})
+}
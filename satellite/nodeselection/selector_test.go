@@ -1095,6 +1095,71 @@ func TestPieceCount(t *testing.T) {
 	}))
 }
 
+// mockLatencyTracker returns the configured latency (in seconds) of the nodes.
+type mockLatencyTracker struct {
+	latency map[storj.NodeID]float64
+}
+
+var _ nodeselection.UploadLatencyTracker = (*mockLatencyTracker)(nil)
+
+func (m *mockLatencyTracker) Get(uplink storj.NodeID) func(node *nodeselection.SelectedNode) float64 {
+	return func(node *nodeselection.SelectedNode) float64 {
+		if latency, ok := m.latency[node.ID]; ok {
+			return latency
+		}
+		return math.NaN()
+	}
+}
+
+func TestLatency(t *testing.T) {
+	fast, slow, unknown := testrand.NodeID(), testrand.NodeID(), testrand.NodeID()
+	tracker := &mockLatencyTracker{latency: map[storj.NodeID]float64{
+		fast: 0.1,
+		slow: 2,
+	}}
+
+	score, err := nodeselection.Latency(tracker, 0.5)
+	require.NoError(t, err)
+	require.Equal(t, float64(1), score.Get(storj.NodeID{})(&nodeselection.SelectedNode{ID: fast}))
+	require.Equal(t, 0.25, score.Get(storj.NodeID{})(&nodeselection.SelectedNode{ID: slow}))
+	require.True(t, math.IsNaN(score.Get(storj.NodeID{})(&nodeselection.SelectedNode{ID: unknown})))
+
+	_, err = nodeselection.Latency(tracker, 0)
+	require.Error(t, err)
+
+	t.Run("selector", func(t *testing.T) {
+		var nodes []*nodeselection.SelectedNode
+		for i := 0; i < 10; i++ {
+			node := &nodeselection.SelectedNode{ID: testrand.NodeID()}
+			tracker.latency[node.ID] = float64(i + 1)
+			nodes = append(nodes, node)
+		}
+
+		// the latency tracker is used in addition to the success tracker
+		environment := nodeselection.NewPlacementConfigEnvironment(&mockTracker{}, nil, nil).WithLatencyTracker(tracker)
+
+		selector, err := nodeselection.SelectorFromString("filterbest(latency(uploadLatencyTracker, 2.0), \"2\", \"\", random())", environment)
+		require.NoError(t, err)
+		selected, err := selector(nodes, nil)(storj.NodeID{}, 2, nil, nil)
+		require.NoError(t, err)
+		require.ElementsMatch(t, nodes[:2], selected)
+
+		selector, err = nodeselection.SelectorFromString("choiceofnselection(2, filterbest(tracker, \"5\", \"\", random()), median(latency(uploadLatencyTracker, 1.0)))", environment)
+		require.NoError(t, err)
+		selected, err = selector(nodes, nil)(storj.NodeID{}, 2, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 2)
+
+		// without a latency tracker, all the nodes are unknown
+		selector, err = nodeselection.SelectorFromString("choiceofnselection(2, random(), median(latency(uploadLatencyTracker, 1.0)))",
+			nodeselection.NewPlacementConfigEnvironment(nil, nil, nil))
+		require.NoError(t, err)
+		selected, err = selector(nodes, nil)(storj.NodeID{}, 2, nil, nil)
+		require.NoError(t, err)
+		require.Len(t, selected, 2)
+	})
+}
+
 func TestLastBut(t *testing.T) {
 	var nodes []*nodeselection.SelectedNode
 	for i := 0; i < 10; i++ {
@@ -540,12 +540,16 @@ func (service *Service) CreatePutRepairOrderLimits(ctx context.Context, segment
 	defer mon.Task()(&ctx)(&err)
 
 	// Create the order limits for being used to upload the repaired pieces
-	pieceSize := segment.PieceSize()
+	pieceSize := newRedundancy.PieceSize(int64(segment.EncryptedSize))
 	totalPieces := int(newRedundancy.TotalShares)
 
-	if segment.Redundancy.RequiredShares != newRedundancy.RequiredShares {
+	// the erasure coding can only change, when all pieces are replaced (re-striping).
+	if segment.Redundancy.RequiredShares != newRedundancy.RequiredShares && len(healthySet) > 0 {
 		return nil, storj.PiecePrivateKey{}, Error.New("cannot change required share count during this style of repair")
 	}
+	if segment.Redundancy.ShareSize != newRedundancy.ShareSize && len(healthySet) > 0 {
+		return nil, storj.PiecePrivateKey{}, Error.New("cannot change share size during this style of repair")
+	}
 
 	var numRetrievablePieces int
 	for _, o := range getOrderLimits {
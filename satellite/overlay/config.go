@@ -51,6 +51,9 @@ type NodeSelectionConfig struct {
 	NetworkPrefixIPv6 int           `help:"the prefix to use in determining 'network' for IPv6 addresses" default:"64" hidden:"true"`
 	MinimumDiskSpace  memory.Size   `help:"how much disk space a node at minimum must have to be selected for upload" default:"5.00GB" testDefault:"100.00MB"`
 
+	MaxMaintenanceDuration time.Duration `help:"the maximum duration of a planned node maintenance window" default:"24h"`
+	MaintenanceCooldown    time.Duration `help:"the minimum time between the end of a planned node maintenance window and the start of the next one" default:"168h"`
+
 	AsOfSystemTime AsOfSystemTimeConfig
 
 	UploadExcludedCountryCodes []string `help:"list of country codes to exclude from node selection for uploads (DEPRECATED: use placement definition instead)" default:"" testDefault:"FR,BE"`
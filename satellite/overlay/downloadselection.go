@@ -5,6 +5,7 @@ package overlay
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -36,7 +37,9 @@ type DownloadSelectionCache struct {
 	db     DownloadSelectionDB
 	config DownloadSelectionCacheConfig
 
-	cache          sync2.ReadCacheOf[*DownloadSelectionCacheState]
+	cache sync2.ReadCacheOf[*DownloadSelectionCacheState]
+
+	mu             sync.Mutex
 	placementRules nodeselection.PlacementRules
 }
 
@@ -64,6 +67,15 @@ func (cache *DownloadSelectionCache) Refresh(ctx context.Context) (err error) {
 	return err
 }
 
+// SetPlacements replaces the placement definitions used to filter the nodes.
+func (cache *DownloadSelectionCache) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) error {
+	cache.mu.Lock()
+	defer cache.mu.Unlock()
+
+	cache.placementRules = placements.CreateFilters
+	return nil
+}
+
 // read loads the latest download selection state.
 func (cache *DownloadSelectionCache) read(ctx context.Context) (_ *DownloadSelectionCacheState, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -87,7 +99,11 @@ func (cache *DownloadSelectionCache) GetNodeIPsFromPlacement(ctx context.Context
 		return nil, Error.Wrap(err)
 	}
 
-	filter, _ := cache.placementRules(placement)
+	cache.mu.Lock()
+	placementRules := cache.placementRules
+	cache.mu.Unlock()
+
+	filter, _ := placementRules(placement)
 
 	return state.FilteredIPs(nodes, filter), nil
 }
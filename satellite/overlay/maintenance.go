@@ -0,0 +1,59 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"time"
+
+	"github.com/zeebo/errs"
+)
+
+// ErrInvalidMaintenanceWindow is returned when the maintenance window can't be accepted.
+var ErrInvalidMaintenanceWindow = errs.Class("invalid maintenance window")
+
+// MaintenanceWindow is the planned downtime of a storage node (e.g. hardware
+// replacement). During the window the node is treated as planned-offline: it
+// is not selected for uploads, its pieces don't trigger repair on their own,
+// and failed audit connections don't lower its online score.
+type MaintenanceWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Active returns true if the maintenance window covers the given time.
+func (window MaintenanceWindow) Active(now time.Time) bool {
+	return !now.Before(window.Start) && now.Before(window.End)
+}
+
+// Validate checks whether the maintenance window is acceptable at the given
+// time: it must end in the future, and it can't be longer than maxDuration.
+func (window MaintenanceWindow) Validate(now time.Time, maxDuration time.Duration) error {
+	switch {
+	case !window.End.After(window.Start):
+		return ErrInvalidMaintenanceWindow.New("end (%s) must be after start (%s)", window.End, window.Start)
+	case !window.End.After(now):
+		return ErrInvalidMaintenanceWindow.New("end (%s) is already in the past", window.End)
+	case window.End.Sub(window.Start) > maxDuration:
+		return ErrInvalidMaintenanceWindow.New("duration (%s) is longer than the allowed maximum (%s)", window.End.Sub(window.Start), maxDuration)
+	}
+	return nil
+}
+
+// ValidateRenewal checks whether the maintenance window can replace the
+// previous window of the node at the given time. An upcoming window can be
+// replaced freely, and a started window can be changed as long as it keeps
+// its start. Otherwise the new window has to start at least cooldown after
+// the previous one ended, so the node can't stay in maintenance longer than
+// the maximum duration by renewing its window.
+func (window MaintenanceWindow) ValidateRenewal(previous MaintenanceWindow, now time.Time, cooldown time.Duration) error {
+	switch {
+	case previous.Start.After(now):
+		return nil
+	case window.Start.Equal(previous.Start):
+		return nil
+	case window.Start.Before(previous.End.Add(cooldown)):
+		return ErrInvalidMaintenanceWindow.New("start (%s) must be at least %s after the end of the previous window (%s)", window.Start, cooldown, previous.End)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/overlay"
+)
+
+func TestMaintenanceWindow(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	window := overlay.MaintenanceWindow{Start: now, End: now.Add(4 * time.Hour)}
+
+	require.False(t, window.Active(now.Add(-time.Second)))
+	require.True(t, window.Active(now))
+	require.True(t, window.Active(now.Add(4*time.Hour-time.Second)))
+	require.False(t, window.Active(now.Add(4*time.Hour)))
+
+	require.NoError(t, window.Validate(now, 4*time.Hour))
+	require.NoError(t, window.Validate(now.Add(time.Hour), 24*time.Hour))
+
+	for _, tc := range []struct {
+		name   string
+		window overlay.MaintenanceWindow
+	}{
+		{"end before start", overlay.MaintenanceWindow{Start: now, End: now.Add(-time.Hour)}},
+		{"empty", overlay.MaintenanceWindow{Start: now, End: now}},
+		{"in the past", overlay.MaintenanceWindow{Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)}},
+		{"too long", overlay.MaintenanceWindow{Start: now, End: now.Add(5 * time.Hour)}},
+	} {
+		err := tc.window.Validate(now, 4*time.Hour)
+		require.Error(t, err, tc.name)
+		require.True(t, overlay.ErrInvalidMaintenanceWindow.Has(err), tc.name)
+	}
+}
+
+func TestMaintenanceWindowRenewal(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	cooldown := 7 * 24 * time.Hour
+	previous := overlay.MaintenanceWindow{Start: now.Add(-23 * time.Hour), End: now.Add(time.Hour)}
+
+	// renewing the window just before it ends would extend the maintenance beyond the maximum.
+	renewal := overlay.MaintenanceWindow{Start: now, End: now.Add(24 * time.Hour)}
+	err := renewal.ValidateRenewal(previous, now, cooldown)
+	require.True(t, overlay.ErrInvalidMaintenanceWindow.Has(err))
+
+	// the started window can be changed, while it keeps its start.
+	changed := overlay.MaintenanceWindow{Start: previous.Start, End: now.Add(30 * time.Minute)}
+	require.NoError(t, changed.ValidateRenewal(previous, now, cooldown))
+
+	// the next window can start after the cooldown.
+	next := overlay.MaintenanceWindow{Start: previous.End.Add(cooldown), End: previous.End.Add(cooldown + time.Hour)}
+	require.NoError(t, next.ValidateRenewal(previous, now, cooldown))
+
+	// an upcoming window can be replaced.
+	upcoming := overlay.MaintenanceWindow{Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)}
+	require.NoError(t, renewal.ValidateRenewal(upcoming, now, cooldown))
+}
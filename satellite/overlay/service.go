@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -78,6 +79,8 @@ type DB interface {
 	SetNodeContained(ctx context.Context, node storj.NodeID, contained bool) (err error)
 	// SetAllContainedNodes updates the contained field for all nodes, as necessary.
 	SetAllContainedNodes(ctx context.Context, containedNodes []storj.NodeID) (err error)
+	// SetNodeMaintenance sets the planned maintenance window of the node, or clears it when window is nil.
+	SetNodeMaintenance(ctx context.Context, node storj.NodeID, window *MaintenanceWindow) (err error)
 
 	// ActiveNodesPieceCounts returns a map of node IDs to piece counts from the db.
 	// Returns only pieces for nodes that are not disqualified.
@@ -163,6 +166,20 @@ const (
 	DisqualificationReasonNodeOffline DisqualificationReason = 3
 )
 
+// String returns a description of the disqualification reason.
+func (reason DisqualificationReason) String() string {
+	switch reason {
+	case DisqualificationReasonAuditFailure:
+		return "audit failure"
+	case DisqualificationReasonSuspension:
+		return "suspension"
+	case DisqualificationReasonNodeOffline:
+		return "node offline"
+	default:
+		return "unknown"
+	}
+}
+
 // NodeCheckInInfo contains all the info that will be updated when a node checkins.
 type NodeCheckInInfo struct {
 	NodeID                  storj.NodeID
@@ -212,6 +229,8 @@ type ReputationStatus struct {
 	UnknownAuditSuspended  *time.Time
 	OfflineSuspended       *time.Time
 	VettedAt               *time.Time
+	// Maintenance is the planned maintenance window of the node.
+	Maintenance *MaintenanceWindow
 }
 
 // ReputationUpdate contains reputation update data for a node.
@@ -262,6 +281,7 @@ type NodeDossier struct {
 	LastOfflineEmail        *time.Time
 	LastSoftwareUpdateEmail *time.Time
 	CountryCode             location.CountryCode
+	Maintenance             *MaintenanceWindow
 }
 
 // NodeStats contains statistics about a node.
@@ -313,8 +333,10 @@ type Service struct {
 	UploadSelectionCache   *UploadSelectionCache
 	DownloadSelectionCache *DownloadSelectionCache
 	LastNetFunc            LastNetFunc
-	placementDefinitions   nodeselection.PlacementDefinitions
-	placementLookup        map[string]storj.PlacementConstraint
+
+	placementMu          sync.RWMutex
+	placementDefinitions nodeselection.PlacementDefinitions
+	placementLookup      map[string]storj.PlacementConstraint
 }
 
 // LastNetFunc is the type of a function that will be used to derive a network from an ip and port.
@@ -367,10 +389,6 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, placements nod
 		return nil, errs.Wrap(err)
 	}
 
-	placementLookup := make(map[string]storj.PlacementConstraint, len(placements))
-	for _, placement := range placements {
-		placementLookup[placement.Name] = placement.ID
-	}
 	return &Service{
 		log:                  log,
 		db:                   db,
@@ -387,10 +405,34 @@ func NewService(log *zap.Logger, db DB, nodeEvents nodeevents.DB, placements nod
 		LastNetFunc:            MaskOffLastNet,
 
 		placementDefinitions: placements,
-		placementLookup:      placementLookup,
+		placementLookup:      newPlacementLookup(placements),
 	}, nil
 }
 
+func newPlacementLookup(placements nodeselection.PlacementDefinitions) map[string]storj.PlacementConstraint {
+	placementLookup := make(map[string]storj.PlacementConstraint, len(placements))
+	for _, placement := range placements {
+		placementLookup[placement.Name] = placement.ID
+	}
+	return placementLookup
+}
+
+// SetPlacements replaces the placement definitions of the service and its
+// node selection caches.
+func (service *Service) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	service.placementMu.Lock()
+	service.placementDefinitions = placements
+	service.placementLookup = newPlacementLookup(placements)
+	service.placementMu.Unlock()
+
+	return errs.Combine(
+		service.UploadSelectionCache.SetPlacements(ctx, placements),
+		service.DownloadSelectionCache.SetPlacements(ctx, placements),
+	)
+}
+
 // Run runs the background processes needed for caches.
 func (service *Service) Run(ctx context.Context) error {
 	return errs.Combine(sync2.Concurrently(
@@ -573,6 +615,56 @@ func (service *Service) SetNodeContained(ctx context.Context, node storj.NodeID,
 	return service.db.SetNodeContained(ctx, node, contained)
 }
 
+// SetNodeMaintenance declares a planned maintenance window for the node. The
+// window starts immediately when its start is zero, it can't be longer than
+// the configured maximum, and it has to keep the configured cooldown after the
+// previous window of the node.
+func (service *Service) SetNodeMaintenance(ctx context.Context, node storj.NodeID, window MaintenanceWindow) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	if window.Start.IsZero() {
+		window.Start = now
+	}
+	if err := window.Validate(now, service.config.Node.MaxMaintenanceDuration); err != nil {
+		return err
+	}
+
+	dossier, err := service.db.Get(ctx, node)
+	if err != nil {
+		return err
+	}
+	if dossier.Maintenance != nil {
+		if err := window.ValidateRenewal(*dossier.Maintenance, now, service.config.Node.MaintenanceCooldown); err != nil {
+			return err
+		}
+	}
+
+	return service.db.SetNodeMaintenance(ctx, node, &window)
+}
+
+// ClearNodeMaintenance cancels the planned maintenance window of the node. A
+// window which has already started is ended instead of removed, so that it
+// still counts for the cooldown of the next window.
+func (service *Service) ClearNodeMaintenance(ctx context.Context, node storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dossier, err := service.db.Get(ctx, node)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	switch window := dossier.Maintenance; {
+	case window == nil || !window.End.After(now):
+		return nil
+	case window.Start.After(now):
+		return service.db.SetNodeMaintenance(ctx, node, nil)
+	default:
+		return service.db.SetNodeMaintenance(ctx, node, &MaintenanceWindow{Start: window.Start, End: now})
+	}
+}
+
 // UpdateCheckIn updates a single storagenode's check-in info if needed.
 /*
 The check-in info is updated in the database if:
@@ -758,11 +850,17 @@ func (service *Service) GetNodeTags(ctx context.Context, id storj.NodeID) (nodes
 // GetLocationFromPlacement returns the location identifier of the bucket.
 // It comes from the name of the placement (or `nodeselection.Location` in case of legacy config).
 func (service *Service) GetLocationFromPlacement(placement storj.PlacementConstraint) string {
+	service.placementMu.RLock()
+	defer service.placementMu.RUnlock()
+
 	return service.placementDefinitions[placement].Name
 }
 
 // GetPlacementConstraintFromName returns the placement constraint given the placement name.
 func (service *Service) GetPlacementConstraintFromName(name string) (id storj.PlacementConstraint, exists bool) {
+	service.placementMu.RLock()
+	defer service.placementMu.RUnlock()
+
 	id, exists = service.placementLookup[name]
 	return id, exists
 }
@@ -5,6 +5,7 @@ package overlay
 
 import (
 	"context"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -38,7 +39,9 @@ type UploadSelectionCache struct {
 	cache sync2.ReadCacheOf[nodeselection.State]
 
 	defaultFilters nodeselection.NodeFilters
-	placements     nodeselection.PlacementDefinitions
+
+	mu         sync.Mutex
+	placements nodeselection.PlacementDefinitions
 }
 
 // NewUploadSelectionCache creates a new cache that keeps a list of all the storage nodes that are qualified to store data.
@@ -81,10 +84,26 @@ func (cache *UploadSelectionCache) read(ctx context.Context) (_ nodeselection.St
 	mon.IntVal("refresh_cache_size_new").Observe(int64(len(newNodes)))
 
 	var allNodes = append(append([]*nodeselection.SelectedNode{}, reputableNodes...), newNodes...)
-	state := nodeselection.NewState(allNodes, cache.placements)
+	cache.mu.Lock()
+	placements := cache.placements
+	cache.mu.Unlock()
+
+	state := nodeselection.NewState(allNodes, placements)
 	return state, nil
 }
 
+// SetPlacements replaces the placement definitions, and refreshes the cache to
+// use them immediately.
+func (cache *UploadSelectionCache) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cache.mu.Lock()
+	cache.placements = placements
+	cache.mu.Unlock()
+
+	return cache.Refresh(ctx)
+}
+
 // GetNodes selects nodes from the cache that will be used to upload a file.
 // Every node selected will be from a distinct network.
 // If the cache hasn't been refreshed recently it will do so first.
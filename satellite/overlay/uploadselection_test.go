@@ -818,6 +818,11 @@ func (m *mockdb) SetAllContainedNodes(ctx context.Context, containedNodes []stor
 	panic("implement me")
 }
 
+// SetNodeMaintenance satisfies nodeevents.DB interface.
+func (m *mockdb) SetNodeMaintenance(ctx context.Context, node storj.NodeID, window *overlay.MaintenanceWindow) (err error) {
+	panic("implement me")
+}
+
 // AllPieceCounts satisfies nodeevents.DB interface.
 func (m *mockdb) ActiveNodesPieceCounts(ctx context.Context) (pieceCounts map[storj.NodeID]int64, err error) {
 	panic("implement me")
@@ -54,12 +54,14 @@ import (
 	"storj.io/storj/satellite/kms"
 	"storj.io/storj/satellite/mailservice"
 	"storj.io/storj/satellite/mailservice/simulate"
+	"storj.io/storj/satellite/metabase/inventory"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metabase/zombiedeletion"
 	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/metainfo/expireddeletion"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodehistory"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
@@ -104,6 +106,12 @@ type DB interface {
 	OverlayCache() overlay.DB
 	// NodeEvents returns a database for node event information
 	NodeEvents() nodeevents.DB
+	// NodeHistory returns a database for the history of the node states
+	NodeHistory() nodehistory.DB
+	// DurabilityRisks returns a database for the durability risk reports
+	DurabilityRisks() durability.DB
+	// InventoryReports returns a database for the bucket inventory report runs
+	InventoryReports() inventory.DB
 	// Reputation returns database for audit reputation information
 	Reputation() reputation.DB
 	// Attribution returns database for partner keys information
@@ -116,6 +124,10 @@ type DB interface {
 	RepairQueue() queue.RepairQueue
 	// VerifyQueue returns queue for segments chosen for verification
 	VerifyQueue() audit.VerifyQueue
+	// AuditCampaigns returns database for targeted audit campaigns
+	AuditCampaigns() audit.CampaignDB
+	// PlacementConfigs returns database for the reloadable placement configurations
+	PlacementConfigs() nodeselection.PlacementConfigDB
 	// ReverifyQueue returns queue for pieces that need audit reverification
 	ReverifyQueue() audit.ReverifyQueue
 	// Console returns database for satellite console
@@ -173,7 +185,8 @@ type Config struct {
 	Server   server.Config
 	Debug    debug.Config
 
-	Placement nodeselection.ConfigurablePlacementRule `help:"detailed placement rules in the form 'id:definition;id:definition;...' where id is a 16 bytes integer (use >10 for backward compatibility), definition is a combination of the following functions:country(2 letter country codes,...), tag(nodeId, key, bytes(value)) all(...,...)."`
+	Placement       nodeselection.ConfigurablePlacementRule `help:"detailed placement rules in the form 'id:definition;id:definition;...' where id is a 16 bytes integer (use >10 for backward compatibility), definition is a combination of the following functions:country(2 letter country codes,...), tag(nodeId, key, bytes(value)) all(...,...)."`
+	PlacementReload nodeselection.PlacementReloadConfig
 
 	Admin admin.Config
 
@@ -181,6 +194,7 @@ type Config struct {
 	Overlay      overlay.Config
 	OfflineNodes offlinenodes.Config
 	NodeEvents   nodeevents.Config
+	NodeHistory  nodehistory.Config
 	StrayNodes   straynodes.Config
 
 	Metainfo metainfo.Config
@@ -204,6 +218,7 @@ type Config struct {
 
 	ExpiredDeletion expireddeletion.Config
 	ZombieDeletion  zombiedeletion.Config
+	Inventory       inventory.Config
 
 	Tally            tally.Config
 	NodeTally        nodetally.Config
@@ -25,6 +25,7 @@ import (
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/metrics"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/repair/checker"
 )
@@ -40,7 +41,8 @@ type RangedLoop struct {
 	Services *lifecycle.Group
 
 	Audit struct {
-		Observer rangedloop.Observer
+		Observer         rangedloop.Observer
+		CampaignObserver rangedloop.Observer
 	}
 
 	Debug struct {
@@ -60,6 +62,10 @@ type RangedLoop struct {
 		Observer *checker.Observer
 	}
 
+	Placement struct {
+		Reloader *nodeselection.PlacementReloader
+	}
+
 	Accounting struct {
 		NodeTallyObserver *nodetally.Observer
 	}
@@ -108,6 +114,7 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 
 	{ // setup audit observer
 		peer.Audit.Observer = audit.NewObserver(log.Named("audit"), db.VerifyQueue(), config.Audit)
+		peer.Audit.CampaignObserver = audit.NewCampaignObserver(log.Named("audit:campaigns"), db.AuditCampaigns(), db.VerifyQueue(), config.Audit)
 	}
 
 	{ // setup metrics observer
@@ -130,11 +137,15 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 		)
 	}
 
-	{ // setup overlay
-		placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
+	{ // setup placement
+		peer.Placement.Reloader, err = nodeselection.LoadPlacementReloader(context.TODO(), peer.Log.Named("placement:reloader"), config.Placement, config.Overlay.Node.CreateDefaultPlacement, peer.DB.PlacementConfigs(), config.PlacementReload, nil)
 		if err != nil {
-			return nil, err
+			return nil, errs.Combine(err, peer.Close())
 		}
+	}
+
+	{ // setup overlay
+		_, placement := peer.Placement.Reloader.Placements()
 
 		peer.Overlay.Service, err = overlay.NewService(peer.Log.Named("overlay"), peer.DB.OverlayCache(), peer.DB.NodeEvents(), placement, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
 		if err != nil {
@@ -155,15 +166,12 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 
 		for class, f := range classes {
 			cache := checker.NewReliabilityCache(peer.Overlay.Service, config.Checker.ReliabilityCacheStaleness)
-			peer.DurabilityReport.Observer = append(peer.DurabilityReport.Observer, durability.NewDurability(db.OverlayCache(), metabaseDB, cache, class, f, config.RangedLoop.AsOfSystemInterval))
+			peer.DurabilityReport.Observer = append(peer.DurabilityReport.Observer, durability.NewDurability(db.OverlayCache(), metabaseDB, cache, class, f, db.DurabilityRisks(), config.DurabilityReport.TopOffenders, config.RangedLoop.AsOfSystemInterval))
 		}
 	}
 
 	{ // setup repair
-		placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
-		if err != nil {
-			return nil, err
-		}
+		_, placement := peer.Placement.Reloader.Placements()
 
 		if len(config.Checker.RepairExcludedCountryCodes) == 0 {
 			config.Checker.RepairExcludedCountryCodes = config.Overlay.RepairExcludedCountryCodes
@@ -174,11 +182,30 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 			peer.DB.RepairQueue(),
 			peer.Overlay.Service,
 			placement,
+			config.Metainfo.RS,
 			config.Checker,
 		)
+
+		if peer.Placement.Reloader.Enabled() {
+			peer.Placement.Reloader.OnReload(peer.Overlay.Service.SetPlacements)
+			peer.Placement.Reloader.OnReload(peer.Repair.Observer.SetPlacements)
+
+			peer.Services.Add(lifecycle.Item{
+				Name:  "placement:reloader",
+				Run:   peer.Placement.Reloader.Run,
+				Close: peer.Placement.Reloader.Close,
+			})
+			peer.Debug.Server.Panel.Add(
+				debug.Cycle("Placement Reloader", peer.Placement.Reloader.Loop))
+		}
 	}
 
 	{ // setup ranged loop
+		if config.RangedLoop.Distributed.Enabled {
+			// the coordinator needs a DRPC server, which is only wired up in the modular satellite.
+			return nil, errs.Combine(errs.New("distributed ranged loop requires the modular satellite"), peer.Close())
+		}
+
 		rand := rand.New(rand.NewSource(time.Now().UnixNano()))
 
 		observers := []rangedloop.Observer{
@@ -187,7 +214,7 @@ func NewRangedLoop(log *zap.Logger, db DB, metabaseDB *metabase.DB, config *Conf
 		}
 
 		if config.Audit.UseRangedLoop {
-			observers = append(observers, peer.Audit.Observer)
+			observers = append(observers, peer.Audit.Observer, peer.Audit.CampaignObserver)
 		}
 
 		if config.Tally.UseRangedLoop {
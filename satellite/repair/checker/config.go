@@ -23,11 +23,15 @@ type Config struct {
 	RepairTargetOverrides     RepairTargetOverrides    `help:"comma-separated override values for repair success target in the format k-target" default:""`
 	// Node failure rate is an estimation based on a 6 hour checker run interval (4 checker iterations per day), a network of about 9200 nodes, and about 2 nodes churning per day.
 	// This results in `2/9200/4 = 0.00005435` being the probability of any single node going down in the interval of one checker iteration.
-	NodeFailureRate            float64  `help:"the probability of a single node going down within the next checker iteration" default:"0.00005435" `
-	RepairQueueInsertBatchSize int      `help:"Number of damaged segments to buffer in-memory before flushing to the repair queue" default:"100" `
-	RepairExcludedCountryCodes []string `help:"list of country codes to treat node from this country as offline " default:"" hidden:"true"`
-	DoDeclumping               bool     `help:"Treat pieces on the same network as in need of repair" default:"true"`
-	DoPlacementCheck           bool     `help:"Treat pieces out of segment placement as in need of repair" default:"true"`
+	NodeFailureRate            float64       `help:"the probability of a single node going down within the next checker iteration" default:"0.00005435" `
+	RepairDeadlineFraction     float64       `help:"fraction of the expected lifetime of an injured segment (see node-failure-rate) until which it should be repaired" default:"0.1"`
+	MaxRepairDeadline          time.Duration `help:"the latest deadline for repairing an injured segment" default:"168h"`
+	RepairQueueInsertBatchSize int           `help:"Number of damaged segments to buffer in-memory before flushing to the repair queue" default:"100" `
+	RepairExcludedCountryCodes []string      `help:"list of country codes to treat node from this country as offline " default:"" hidden:"true"`
+	DoDeclumping               bool          `help:"Treat pieces on the same network as in need of repair" default:"true"`
+	DoPlacementCheck           bool          `help:"Treat pieces out of segment placement as in need of repair" default:"true"`
+	Restripe                   bool          `help:"queue healthy segments, whose redundancy scheme differs from the one of their placement, with the lowest priority for re-striping (requires repairer.restripe)" default:"false"`
+	MaintenanceRepairMargin    int           `help:"pieces on nodes in a planned maintenance window postpone the repair only while the segment has more healthy pieces than the required ones plus this margin" default:"5"`
 }
 
 // RepairThresholdOverrides override values for repair threshold.
@@ -5,7 +5,9 @@ package checker
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math"
 	"reflect"
 	"sort"
 	"strconv"
@@ -21,6 +23,7 @@ import (
 	"storj.io/common/storj"
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/repair"
@@ -29,8 +32,8 @@ import (
 )
 
 var (
-	_ rangedloop.Observer = (*Observer)(nil)
-	_ rangedloop.Partial  = (*observerFork)(nil)
+	_ rangedloop.ResumableObserver = (*Observer)(nil)
+	_ rangedloop.Partial           = (*observerFork)(nil)
 )
 
 // Observer implements the ranged loop Observer interface.
@@ -44,10 +47,15 @@ type Observer struct {
 	repairThresholdOverrides RepairThresholdOverrides
 	repairTargetOverrides    RepairTargetOverrides
 	nodeFailureRate          float64
+	deadlineFraction         float64
+	maxDeadline              time.Duration
 	repairQueueBatchSize     int
 	excludedCountryCodes     map[location.CountryCode]struct{}
 	doDeclumping             bool
 	doPlacementCheck         bool
+	doRestripe               bool
+	maintenanceMargin        int
+	rsConfig                 metainfo.RSConfig
 	placements               nodeselection.PlacementDefinitions
 
 	// the following are reset on each iteration
@@ -56,6 +64,8 @@ type Observer struct {
 
 	mu             sync.Mutex
 	statsCollector map[redundancyStyle]*observerRSStats
+	// reloaded placements are used from the next iteration.
+	reloadedPlacements nodeselection.PlacementDefinitions
 }
 
 type redundancyStyle struct {
@@ -64,7 +74,7 @@ type redundancyStyle struct {
 }
 
 // NewObserver creates new checker observer instance.
-func NewObserver(logger *zap.Logger, repairQueue queue.RepairQueue, overlay *overlay.Service, placements nodeselection.PlacementDefinitions, config Config) *Observer {
+func NewObserver(logger *zap.Logger, repairQueue queue.RepairQueue, overlay *overlay.Service, placements nodeselection.PlacementDefinitions, rsConfig metainfo.RSConfig, config Config) *Observer {
 	excludedCountryCodes := make(map[location.CountryCode]struct{})
 	for _, countryCode := range config.RepairExcludedCountryCodes {
 		if cc := location.ToCountryCode(countryCode); cc != location.None {
@@ -86,10 +96,15 @@ func NewObserver(logger *zap.Logger, repairQueue queue.RepairQueue, overlay *ove
 		repairThresholdOverrides: config.RepairThresholdOverrides,
 		repairTargetOverrides:    config.RepairTargetOverrides,
 		nodeFailureRate:          config.NodeFailureRate,
+		deadlineFraction:         config.RepairDeadlineFraction,
+		maxDeadline:              config.MaxRepairDeadline,
 		repairQueueBatchSize:     config.RepairQueueInsertBatchSize,
 		excludedCountryCodes:     excludedCountryCodes,
 		doDeclumping:             config.DoDeclumping,
 		doPlacementCheck:         config.DoPlacementCheck,
+		doRestripe:               config.Restripe,
+		maintenanceMargin:        config.MaintenanceRepairMargin,
+		rsConfig:                 rsConfig,
 		placements:               placements,
 		statsCollector:           make(map[redundancyStyle]*observerRSStats),
 	}
@@ -158,6 +173,24 @@ func (observer *Observer) Start(ctx context.Context, startTime time.Time) (err e
 	// Reuse the allocated slice.
 	observer.TotalStats = observer.TotalStats[:0]
 
+	observer.mu.Lock()
+	if observer.reloadedPlacements != nil {
+		observer.placements = observer.reloadedPlacements
+		observer.reloadedPlacements = nil
+	}
+	observer.mu.Unlock()
+
+	return nil
+}
+
+// SetPlacements replaces the placement definitions. The new definitions are
+// used from the next iteration, to check all the segments of an iteration
+// with the same placements.
+func (observer *Observer) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) error {
+	observer.mu.Lock()
+	defer observer.mu.Unlock()
+
+	observer.reloadedPlacements = placements
 	return nil
 }
 
@@ -191,6 +224,66 @@ func (observer *Observer) Join(ctx context.Context, partial rangedloop.Partial)
 	return nil
 }
 
+// savedFork is the serialized state of observerFork.
+type savedFork struct {
+	LastStreamID uuid.UUID                `json:"last_stream_id"`
+	TotalStats   aggregateStatsPlacements `json:"total_stats"`
+	RSStats      []savedRSStats           `json:"rs_stats"`
+}
+
+type savedRSStats struct {
+	Redundancy redundancyStyle `json:"redundancy"`
+	Stats      aggregateStats  `json:"stats"`
+}
+
+// SavePartial serializes the stats of the Partial. The segments, which need
+// repair, are flushed to the repair queue, so they are not lost when the
+// loop is interrupted.
+func (observer *Observer) SavePartial(ctx context.Context, partial rangedloop.Partial) (_ []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	repPartial, ok := partial.(*observerFork)
+	if !ok {
+		return nil, Error.New("expected partial type %T but got %T", repPartial, partial)
+	}
+
+	if err := repPartial.repairQueue.Flush(ctx); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	saved := savedFork{
+		LastStreamID: repPartial.lastStreamID,
+		TotalStats:   repPartial.totalStats,
+	}
+	for rs, partialStats := range repPartial.rsStats {
+		saved.RSStats = append(saved.RSStats, savedRSStats{
+			Redundancy: rs,
+			Stats:      partialStats.iterationAggregates,
+		})
+	}
+
+	data, err := json.Marshal(saved)
+	return data, Error.Wrap(err)
+}
+
+// RestorePartial recreates a Partial saved by SavePartial.
+func (observer *Observer) RestorePartial(ctx context.Context, data []byte) (_ rangedloop.Partial, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var saved savedFork
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	fork := newObserverFork(observer).(*observerFork)
+	fork.lastStreamID = saved.LastStreamID
+	fork.totalStats = saved.TotalStats
+	for _, rs := range saved.RSStats {
+		fork.getStatsByRS(rs.Redundancy).iterationAggregates = rs.Stats
+	}
+	return fork, nil
+}
+
 // Finish is called after all segments are processed by all observers.
 func (observer *Observer) Finish(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -243,7 +336,7 @@ func (observer *Observer) getObserverStats(redundancy redundancyStyle) *observer
 
 	observerStats, exists := observer.statsCollector[redundancy]
 	if !exists {
-		rsString := getRSString(loadRedundancy(redundancy.Scheme, observer.repairThresholdOverrides, observer.repairTargetOverrides))
+		rsString := getRSString(LoadRedundancy(redundancy.Scheme, observer.repairThresholdOverrides, observer.repairTargetOverrides))
 		observerStats = &observerRSStats{aggregateStats{}, newIterationRSStats(rsString), newSegmentRSStats(rsString, redundancy.Placement)}
 		mon.Chain(observerStats)
 		observer.statsCollector[redundancy] = observerStats
@@ -252,7 +345,9 @@ func (observer *Observer) getObserverStats(redundancy redundancyStyle) *observer
 	return observerStats
 }
 
-func loadRedundancy(redundancy storj.RedundancyScheme, repairThresholdOverrides RepairThresholdOverrides, repairTargetOverrides RepairTargetOverrides) (int, int, int, int) {
+// LoadRedundancy returns the required, repair, success and total piece counts
+// of the redundancy scheme, after applying the configured overrides.
+func LoadRedundancy(redundancy storj.RedundancyScheme, repairThresholdOverrides RepairThresholdOverrides, repairTargetOverrides RepairTargetOverrides) (int, int, int, int) {
 	repair := int(redundancy.RepairShares)
 	optimal := int(redundancy.OptimalShares)
 	total := int(redundancy.TotalShares)
@@ -279,6 +374,42 @@ func loadRedundancy(redundancy storj.RedundancyScheme, repairThresholdOverrides
 	return int(redundancy.RequiredShares), repair, optimal, total
 }
 
+// RestripeRedundancy returns the redundancy scheme of the placement, when the
+// segment has to be re-encoded to match it. Segments which differ only in the
+// repair or success thresholds don't need to be re-encoded.
+func RestripeRedundancy(rsConfig metainfo.RSConfig, placement nodeselection.Placement, current storj.RedundancyScheme) (storj.RedundancyScheme, bool) {
+	rs := rsConfig.Override(placement.EC)
+	target := storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      rs.ErasureShareSize.Int32(),
+		RequiredShares: int16(rs.Min),
+		RepairShares:   int16(rs.Repair),
+		OptimalShares:  int16(rs.Success),
+		TotalShares:    int16(rs.Total),
+	}
+	if target.RequiredShares <= 0 || target.ShareSize <= 0 {
+		return storj.RedundancyScheme{}, false
+	}
+	if target.RequiredShares == current.RequiredShares && target.ShareSize == current.ShareSize {
+		// the pieces are still valid, only the thresholds are different.
+		return storj.RedundancyScheme{}, false
+	}
+	return target, true
+}
+
+// NeedsRepair decides whether a segment has to be queued for repair, either
+// because of its health or because of pieces forcing a repair.
+func NeedsRepair(numHealthy, numForcingRepair, repairThreshold, successThreshold int) (dueToHealth, dueToForcing bool) {
+	// we repair when the number of healthy pieces is less than or equal to the repair threshold and is greater or equal to
+	// minimum required pieces in redundancy
+	// except for the case when the repair and success thresholds are the same (a case usually seen during testing).
+	// separate case is when we find pieces which are outside segment placement. in such case we are putting segment
+	// into queue right away.
+	dueToHealth = numHealthy <= repairThreshold && numHealthy < successThreshold
+	dueToForcing = numForcingRepair > 0
+	return dueToHealth, dueToForcing
+}
+
 // RefreshReliabilityCache forces refreshing node online status cache.
 func (observer *Observer) RefreshReliabilityCache(ctx context.Context) error {
 	return observer.nodesCache.Refresh(ctx)
@@ -293,6 +424,8 @@ type observerFork struct {
 	repairThresholdOverrides RepairThresholdOverrides
 	repairTargetOverrides    RepairTargetOverrides
 	nodeFailureRate          float64
+	deadlineFraction         float64
+	maxDeadline              time.Duration
 	getNodesEstimate         func(ctx context.Context) (int, error)
 	log                      *zap.Logger
 	lastStreamID             uuid.UUID
@@ -306,6 +439,9 @@ type observerFork struct {
 	excludedCountryCodes map[location.CountryCode]struct{}
 	doDeclumping         bool
 	doPlacementCheck     bool
+	doRestripe           bool
+	maintenanceMargin    int
+	rsConfig             metainfo.RSConfig
 	placements           nodeselection.PlacementDefinitions
 
 	getObserverStats func(redundancyStyle) *observerRSStats
@@ -322,11 +458,16 @@ func newObserverFork(observer *Observer) rangedloop.Partial {
 		repairThresholdOverrides: observer.repairThresholdOverrides,
 		repairTargetOverrides:    observer.repairTargetOverrides,
 		nodeFailureRate:          observer.nodeFailureRate,
+		deadlineFraction:         observer.deadlineFraction,
+		maxDeadline:              observer.maxDeadline,
 		getNodesEstimate:         observer.getNodesEstimate,
 		log:                      observer.logger,
 		excludedCountryCodes:     observer.excludedCountryCodes,
 		doDeclumping:             observer.doDeclumping,
 		doPlacementCheck:         observer.doPlacementCheck,
+		doRestripe:               observer.doRestripe,
+		maintenanceMargin:        observer.maintenanceMargin,
+		rsConfig:                 observer.rsConfig,
 		placements:               observer.placements,
 		getObserverStats:         observer.getObserverStats,
 	}
@@ -370,6 +511,8 @@ var (
 	segmentsBelowMinReqCounter        = mon.Counter("checker_segments_below_min_req")        //mon:locked
 	injuredSegmentHealthFloatVal      = mon.FloatVal("checker_injured_segment_health")       //mon:locked
 	segmentTimeUntilIrreparableIntVal = mon.IntVal("checker_segment_time_until_irreparable") //mon:locked
+	segmentsPostponedCounter          = mon.Counter("checker_segments_repair_postponed_by_maintenance")
+	segmentsQueuedForRestripeCounter  = mon.Counter("checker_segments_queued_for_restripe")
 
 	allSegmentPiecesLostPerWeekFloatVal        = mon.FloatVal("checker_all_segment_pieces_lost_per_week")
 	freshSegmentPiecesLostPerWeekFloatVal      = mon.FloatVal("checker_fresh_segment_pieces_lost_per_week")
@@ -500,18 +643,24 @@ func (fork *observerFork) process(ctx context.Context, segment *rangedloop.Segme
 		stats.segmentStats.yearOldSegmentPiecesLostPerWeek.Observe(piecesLostPerWeek)
 	}
 
-	required, repairThreshold, successThreshold, _ := loadRedundancy(segment.Redundancy, fork.repairThresholdOverrides, fork.repairTargetOverrides)
+	required, repairThreshold, successThreshold, _ := LoadRedundancy(segment.Redundancy, fork.repairThresholdOverrides, fork.repairTargetOverrides)
 	segmentHealth := repair.SegmentHealth(numHealthy, required, totalNumNodes, fork.nodeFailureRate, piecesCheck.ForcingRepair.Count())
 	segmentHealthFloatVal.Observe(segmentHealth)
 	stats.segmentStats.segmentHealth.Observe(segmentHealth)
 
-	// we repair when the number of healthy pieces is less than or equal to the repair threshold and is greater or equal to
-	// minimum required pieces in redundancy
-	// except for the case when the repair and success thresholds are the same (a case usually seen during testing).
-	// separate case is when we find pieces which are outside segment placement. in such case we are putting segment
-	// into queue right away.
-	repairDueToHealth := (numHealthy <= repairThreshold && numHealthy < successThreshold)
-	repairDueToForcing := piecesCheck.ForcingRepair.Count() > 0
+	// pieces on nodes in a planned maintenance window are expected to come back,
+	// so they don't trigger the repair on their own, as long as the segment has
+	// enough healthy pieces to survive further losses.
+	numPlannedOffline := piecesCheck.PlannedOffline.Count()
+	if numHealthy <= required+fork.maintenanceMargin {
+		numPlannedOffline = 0
+	}
+	repairDueToHealth, repairDueToForcing := NeedsRepair(numHealthy+numPlannedOffline, piecesCheck.ForcingRepair.Count(), repairThreshold, successThreshold)
+	if numPlannedOffline > 0 && !repairDueToHealth {
+		if dueToHealth, _ := NeedsRepair(numHealthy, 0, repairThreshold, successThreshold); dueToHealth {
+			segmentsPostponedCounter.Inc(1)
+		}
+	}
 	if repairDueToHealth || repairDueToForcing {
 
 		injuredSegmentHealthFloatVal.Observe(segmentHealth)
@@ -524,11 +673,14 @@ func (fork *observerFork) process(ctx context.Context, segment *rangedloop.Segme
 			stats.iterationAggregates.remoteSegmentsNeedingRepairDueToForcing++
 		}
 
+		now := time.Now().UTC()
+		deadline := repair.SegmentDeadline(now, segmentHealth, fork.deadlineFraction, fork.maxDeadline)
 		err := fork.repairQueue.Insert(ctx, &queue.InjuredSegment{
 			StreamID:      segment.StreamID,
 			Position:      segment.Position,
-			UpdatedAt:     time.Now().UTC(),
+			UpdatedAt:     now,
 			SegmentHealth: segmentHealth,
+			Deadline:      &deadline,
 			Placement:     segment.Placement,
 		}, func() {
 			// Counters are increased after the queue has determined
@@ -595,6 +747,26 @@ func (fork *observerFork) process(ctx context.Context, segment *rangedloop.Segme
 		return nil
 	}
 
+	if fork.doRestripe && piecesCheck.Retrievable.Count() >= required {
+		if _, ok := RestripeRedundancy(fork.rsConfig, fork.placements[segment.Placement], segment.Redundancy); ok {
+			// healthy segments are re-striped after all the injured ones,
+			// so they get the lowest possible priority and no deadline.
+			err := fork.repairQueue.Insert(ctx, &queue.InjuredSegment{
+				StreamID:      segment.StreamID,
+				Position:      segment.Position,
+				UpdatedAt:     time.Now().UTC(),
+				SegmentHealth: math.MaxFloat64,
+				Placement:     segment.Placement,
+			}, func() {
+				segmentsQueuedForRestripeCounter.Inc(1)
+			})
+			if err != nil {
+				log.Error("error adding segment to queue for re-striping", zap.Error(err))
+				return nil
+			}
+		}
+	}
+
 	if numHealthy > repairThreshold && numHealthy <= (repairThreshold+len(
 		fork.totalStats[segment.Placement].remoteSegmentsOverThreshold,
 	)) {
@@ -5,6 +5,7 @@ package checker
 
 import (
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
@@ -17,6 +18,7 @@ import (
 	"storj.io/common/testrand"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/shared/location"
@@ -67,15 +69,18 @@ func TestObserverForkProcess(t *testing.T) {
 
 	createFork := func(o *Observer, q queue.RepairQueue) *observerFork {
 		return &observerFork{
-			log:              zaptest.NewLogger(t),
-			getObserverStats: o.getObserverStats,
-			rsStats:          make(map[redundancyStyle]*partialRSStats),
-			doDeclumping:     o.doDeclumping,
-			doPlacementCheck: o.doPlacementCheck,
-			placements:       o.placements,
-			getNodesEstimate: o.getNodesEstimate,
-			nodesCache:       o.nodesCache,
-			repairQueue:      queue.NewInsertBuffer(q, 1000),
+			log:               zaptest.NewLogger(t),
+			getObserverStats:  o.getObserverStats,
+			rsStats:           make(map[redundancyStyle]*partialRSStats),
+			doDeclumping:      o.doDeclumping,
+			doPlacementCheck:  o.doPlacementCheck,
+			doRestripe:        o.doRestripe,
+			maintenanceMargin: o.maintenanceMargin,
+			rsConfig:          o.rsConfig,
+			placements:        o.placements,
+			getNodesEstimate:  o.getNodesEstimate,
+			nodesCache:        o.nodesCache,
+			repairQueue:       queue.NewInsertBuffer(q, 1000),
 		}
 	}
 
@@ -172,4 +177,159 @@ func TestObserverForkProcess(t *testing.T) {
 		require.Len(t, q.Segments, 0)
 	})
 
+	t.Run("restripe", func(t *testing.T) {
+		segment := &rangedloop.Segment{
+			Pieces: createPieces(nodes, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9),
+			Redundancy: storj.RedundancyScheme{
+				Algorithm:      storj.ReedSolomon,
+				ShareSize:      256,
+				RepairShares:   4,
+				RequiredShares: 3,
+				OptimalShares:  8,
+				TotalShares:    10,
+			},
+			RootPieceID: testrand.PieceID(),
+		}
+
+		for _, tc := range []struct {
+			name     string
+			restripe bool
+			rs       metainfo.RSConfig
+			queued   bool
+		}{
+			{name: "disabled", restripe: false, rs: metainfo.RSConfig{ErasureShareSize: 256, Min: 2, Repair: 3, Success: 4, Total: 5}},
+			{name: "same redundancy", restripe: true, rs: metainfo.RSConfig{ErasureShareSize: 256, Min: 3, Repair: 4, Success: 8, Total: 10}},
+			{name: "only thresholds differ", restripe: true, rs: metainfo.RSConfig{ErasureShareSize: 256, Min: 3, Repair: 5, Success: 9, Total: 10}},
+			{name: "different redundancy", restripe: true, rs: metainfo.RSConfig{ErasureShareSize: 256, Min: 2, Repair: 3, Success: 4, Total: 5}, queued: true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				o := createDefaultObserver()
+				o.doRestripe = tc.restripe
+				o.rsConfig = tc.rs
+				q := queue.MockRepairQueue{}
+				fork := createFork(o, &q)
+				require.NoError(t, fork.process(ctx, segment))
+				require.NoError(t, fork.repairQueue.Flush(ctx))
+
+				if !tc.queued {
+					require.Len(t, q.Segments, 0)
+					return
+				}
+				require.Len(t, q.Segments, 1)
+				// healthy segments are queued after every injured segment.
+				require.Equal(t, math.MaxFloat64, q.Segments[0].SegmentHealth)
+				require.Nil(t, q.Segments[0].Deadline)
+			})
+		}
+	})
+
+	t.Run("planned maintenance", func(t *testing.T) {
+		segment := &rangedloop.Segment{
+			Pieces: createPieces(nodes, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9),
+			Redundancy: storj.RedundancyScheme{
+				Algorithm:      storj.ReedSolomon,
+				ShareSize:      256,
+				RepairShares:   7,
+				RequiredShares: 3,
+				OptimalShares:  8,
+				TotalShares:    10,
+			},
+			RootPieceID: testrand.PieceID(),
+		}
+
+		for _, tc := range []struct {
+			name   string
+			margin int
+			queued bool
+		}{
+			// 7 healthy pieces are above the floor of 3+2.
+			{name: "postponed above the floor", margin: 2},
+			// 7 healthy pieces are not above the floor of 3+4.
+			{name: "repaired at the floor", margin: 4, queued: true},
+		} {
+			t.Run(tc.name, func(t *testing.T) {
+				o := createDefaultObserver()
+				o.maintenanceMargin = tc.margin
+
+				nodeByID := mapNodes(nodes, func(node nodeselection.SelectedNode) bool { return true })
+				for _, node := range nodes[7:] {
+					node.Online = false
+					node.PlannedOffline = true
+					nodeByID[node.ID] = node
+				}
+				o.nodesCache.state.Store(&reliabilityState{
+					nodeByID: nodeByID,
+					created:  time.Now(),
+				})
+
+				q := queue.MockRepairQueue{}
+				fork := createFork(o, &q)
+				require.NoError(t, fork.process(ctx, segment))
+				require.NoError(t, fork.repairQueue.Flush(ctx))
+
+				if tc.queued {
+					require.Len(t, q.Segments, 1)
+				} else {
+					require.Len(t, q.Segments, 0)
+				}
+			})
+		}
+	})
+
+	t.Run("save and restore partial", func(t *testing.T) {
+		o := createDefaultObserver()
+		o.doDeclumping = true
+		o.logger = zaptest.NewLogger(t)
+		q := queue.MockRepairQueue{}
+		o.repairQueue = &q
+		o.repairQueueBatchSize = 1000
+
+		partial, err := o.Fork(ctx)
+		require.NoError(t, err)
+		segment := rangedloop.Segment{
+			StreamID: testrand.UUID(),
+			Pieces:   createPieces(nodes, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9),
+			Redundancy: storj.RedundancyScheme{
+				Algorithm:      storj.ReedSolomon,
+				ShareSize:      256,
+				RepairShares:   4,
+				RequiredShares: 6,
+				OptimalShares:  8,
+				TotalShares:    10,
+			},
+			RootPieceID: testrand.PieceID(),
+		}
+		require.NoError(t, partial.Process(ctx, []rangedloop.Segment{segment}))
+
+		data, err := o.SavePartial(ctx, partial)
+		require.NoError(t, err)
+		// the segment needing repair is flushed to the queue.
+		require.Len(t, q.Segments, 1)
+
+		restored, err := o.RestorePartial(ctx, data)
+		require.NoError(t, err)
+		fork := restored.(*observerFork)
+		require.Equal(t, partial.(*observerFork).totalStats, fork.totalStats)
+		require.Equal(t, segment.StreamID, fork.lastStreamID)
+		require.Len(t, fork.rsStats, 1)
+		for _, stats := range fork.rsStats {
+			require.EqualValues(t, 1, stats.iterationAggregates.remoteSegmentsNeedingRepair)
+		}
+
+		require.NoError(t, o.Join(ctx, restored))
+		require.EqualValues(t, 1, o.TotalStats[0].remoteSegmentsChecked)
+	})
+
+	t.Run("reloaded placements are used from the next iteration", func(t *testing.T) {
+		o := createDefaultObserver()
+
+		reloaded := nodeselection.TestPlacementDefinitions()
+		reloaded.AddPlacementRule(10, nodeselection.NodeFilters{}.WithCountryFilter(location.NewSet(location.Germany)), nodeselection.DefaultDownloadSelector)
+		require.NoError(t, o.SetPlacements(ctx, reloaded))
+		require.NotContains(t, o.placements, storj.PlacementConstraint(10))
+
+		require.NoError(t, o.Start(ctx, time.Now()))
+		require.Contains(t, o.placements, storj.PlacementConstraint(10))
+		require.Contains(t, newObserverFork(o).(*observerFork).placements, storj.PlacementConstraint(10))
+	})
 }
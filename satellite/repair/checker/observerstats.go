@@ -4,6 +4,7 @@
 package checker
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/spacemonkeygo/monkit/v3"
@@ -212,6 +213,54 @@ type aggregateStats struct {
 	remoteSegmentsOverThreshold [5]int64
 }
 
+// savedAggregateStats is the serialized form of aggregateStats.
+type savedAggregateStats struct {
+	ObjectsChecked                          int64       `json:"objects_checked"`
+	RemoteSegmentsChecked                   int64       `json:"remote_segments_checked"`
+	RemoteSegmentsNeedingRepair             int64       `json:"remote_segments_needing_repair"`
+	RemoteSegmentsNeedingRepairDueToForcing int64       `json:"remote_segments_needing_repair_due_to_forcing"`
+	NewRemoteSegmentsNeedingRepair          int64       `json:"new_remote_segments_needing_repair"`
+	RemoteSegmentsLost                      int64       `json:"remote_segments_lost"`
+	RemoteSegmentsFailedToCheck             int64       `json:"remote_segments_failed_to_check"`
+	ObjectsLost                             []uuid.UUID `json:"objects_lost"`
+	RemoteSegmentsOverThreshold             [5]int64    `json:"remote_segments_over_threshold"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a aggregateStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(savedAggregateStats{
+		ObjectsChecked:                          a.objectsChecked,
+		RemoteSegmentsChecked:                   a.remoteSegmentsChecked,
+		RemoteSegmentsNeedingRepair:             a.remoteSegmentsNeedingRepair,
+		RemoteSegmentsNeedingRepairDueToForcing: a.remoteSegmentsNeedingRepairDueToForcing,
+		NewRemoteSegmentsNeedingRepair:          a.newRemoteSegmentsNeedingRepair,
+		RemoteSegmentsLost:                      a.remoteSegmentsLost,
+		RemoteSegmentsFailedToCheck:             a.remoteSegmentsFailedToCheck,
+		ObjectsLost:                             a.objectsLost,
+		RemoteSegmentsOverThreshold:             a.remoteSegmentsOverThreshold,
+	})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *aggregateStats) UnmarshalJSON(data []byte) error {
+	var saved savedAggregateStats
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return err
+	}
+	*a = aggregateStats{
+		objectsChecked:                          saved.ObjectsChecked,
+		remoteSegmentsChecked:                   saved.RemoteSegmentsChecked,
+		remoteSegmentsNeedingRepair:             saved.RemoteSegmentsNeedingRepair,
+		remoteSegmentsNeedingRepairDueToForcing: saved.RemoteSegmentsNeedingRepairDueToForcing,
+		newRemoteSegmentsNeedingRepair:          saved.NewRemoteSegmentsNeedingRepair,
+		remoteSegmentsLost:                      saved.RemoteSegmentsLost,
+		remoteSegmentsFailedToCheck:             saved.RemoteSegmentsFailedToCheck,
+		objectsLost:                             saved.ObjectsLost,
+		remoteSegmentsOverThreshold:             saved.RemoteSegmentsOverThreshold,
+	}
+	return nil
+}
+
 func (a *aggregateStats) combine(stats aggregateStats) {
 	a.objectsChecked += stats.objectsChecked
 	a.remoteSegmentsChecked += stats.remoteSegmentsChecked
@@ -52,6 +52,10 @@ type PiecesCheckResult struct {
 	// (Equivalently: all Piece Numbers from the segment which are NOT in Missing OR
 	// Suspended OR Clumped OR Exiting OR OutOfPlacement OR InExcludedCountry).
 	Healthy intset.Set
+	// PlannedOffline is the set of pieces which are unhealthy only because they reside
+	// on offline nodes in a declared maintenance window. These pieces are expected to
+	// come back, so they shouldn't trigger a repair on their own.
+	PlannedOffline intset.Set
 }
 
 // ClassifySegmentPieces classifies the pieces of a segment into the categories
@@ -149,6 +153,20 @@ func ClassifySegmentPieces(pieces metabase.Pieces, nodes []nodeselection.Selecte
 		}
 	}
 
+	// PlannedOffline = Missing only because of a maintenance window
+	result.PlannedOffline = intset.NewSet(maxPieceNum)
+	for index, nodeRecord := range nodes {
+		pieceNum := int(pieces[index].Number)
+		if !nodeRecord.PlannedOffline || !result.Missing.Contains(pieceNum) {
+			continue
+		}
+		if result.Suspended.Contains(pieceNum) || result.Clumped.Contains(pieceNum) || result.Exiting.Contains(pieceNum) ||
+			result.OutOfPlacement.Contains(pieceNum) || result.InExcludedCountry.Contains(pieceNum) {
+			continue
+		}
+		result.PlannedOffline.Include(pieceNum)
+	}
+
 	return result
 }
 
@@ -162,6 +180,7 @@ func (result PiecesCheckResult) MarshalLogObject(enc zapcore.ObjectEncoder) erro
 	enc.AddInt("ForcingRepair", result.ForcingRepair.Count())
 	enc.AddInt("Healthy", result.Healthy.Count())
 	enc.AddInt("OutOfPlacement", result.OutOfPlacement.Count())
+	enc.AddInt("PlannedOffline", result.PlannedOffline.Count())
 	enc.AddInt("Retrievable", result.Retrievable.Count())
 	enc.AddInt("Suspended", result.Suspended.Count())
 	enc.AddInt("Unhealthy", result.Unhealthy.Count())
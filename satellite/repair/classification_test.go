@@ -45,6 +45,27 @@ func TestClassifySegmentPieces(t *testing.T) {
 		require.Equal(t, 0, result.UnhealthyRetrievable.Count())
 	})
 
+	t.Run("planned offline", func(t *testing.T) {
+		var selectedNodes = generateNodes(10, func(ix int) bool {
+			return ix < 6
+		}, func(ix int, node *nodeselection.SelectedNode) {
+			// 6,7 are in a maintenance window, 8,9 are just offline
+			node.PlannedOffline = ix >= 6 && ix < 8
+			if ix == 7 {
+				node.Exiting = true
+			}
+		})
+
+		pieces := createPieces(selectedNodes, 0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+		result := ClassifySegmentPieces(pieces, getNodes(selectedNodes, pieces), map[location.CountryCode]struct{}{}, true, false, nodeselection.TestPlacementDefinitions()[0])
+
+		require.Equal(t, 4, result.Missing.Count())
+		require.Equal(t, 6, result.Healthy.Count())
+		// 7 is exiting, it shouldn't be kept
+		require.Equal(t, 1, result.PlannedOffline.Count())
+		require.True(t, result.PlannedOffline.Contains(6))
+	})
+
 	t.Run("out of placement", func(t *testing.T) {
 		var selectedNodes = generateNodes(10, func(ix int) bool {
 			return true
@@ -3,7 +3,10 @@
 
 package repair
 
-import "math"
+import (
+	"math"
+	"time"
+)
 
 // segmentHealthNHD purports to find the number of days that a segment can be
 // expected to survive, with the given failureRate.
@@ -122,3 +125,23 @@ func SegmentHealth(numHealthy, minPieces, totalNodes int, failureRate float64, n
 	}
 	return base
 }
+
+// SegmentDeadline returns the time until which a segment with the given
+// health should be repaired.
+//
+// The health of a segment is the number of days it is expected to survive
+// with the node failure rate used to calculate it (see segmentHealthNHD). The
+// deadline is the given fraction of that lifetime, but no later than
+// maxDeadline. Segments which can't be reconstructed anymore have a deadline
+// of now.
+func SegmentDeadline(now time.Time, segmentHealth, fraction float64, maxDeadline time.Duration) time.Time {
+	deadline := segmentHealth * fraction * float64(24*time.Hour)
+	switch {
+	case math.IsNaN(deadline) || deadline <= 0:
+		return now
+	case deadline >= float64(maxDeadline):
+		return now.Add(maxDeadline)
+	default:
+		return now.Add(time.Duration(deadline))
+	}
+}
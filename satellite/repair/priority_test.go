@@ -6,6 +6,7 @@ package repair_test
 import (
 	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -87,3 +88,18 @@ func TestPiecesOutOfPlacementCauseHighPriority(t *testing.T) {
 		repair.SegmentHealth(10, 10, 10000, failureRate, 1),
 		repair.SegmentHealth(9, 9, 10000, failureRate, 1))
 }
+
+func TestSegmentDeadline(t *testing.T) {
+	const failureRate = 0.00005435
+	now := time.Now()
+
+	endangered := repair.SegmentHealth(30, 29, 24000, failureRate, 0)
+	lessEndangered := repair.SegmentHealth(32, 29, 24000, failureRate, 0)
+	assert.True(t, repair.SegmentDeadline(now, endangered, 0.01, 365*24*time.Hour).Before(
+		repair.SegmentDeadline(now, lessEndangered, 0.01, 365*24*time.Hour)))
+
+	assert.Equal(t, now.Add(12*time.Hour), repair.SegmentDeadline(now, 5, 0.1, 30*24*time.Hour))
+	assert.Equal(t, now.Add(time.Hour), repair.SegmentDeadline(now, 5, 0.1, time.Hour))
+	assert.Equal(t, now, repair.SegmentDeadline(now, repair.SegmentHealth(9, 10, 10000, failureRate, 0), 0.1, time.Hour))
+	assert.Equal(t, now.Add(time.Hour), repair.SegmentDeadline(now, math.Inf(1), 0.1, time.Hour))
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queue
+
+import (
+	"time"
+
+	"storj.io/common/storj"
+)
+
+// PlacementWeights are the relative shares of the repair capacity of the
+// placements. Placements without weight have weight 1. A placement with
+// weight 0 gets only the segments which are past their deadline.
+type PlacementWeights map[storj.PlacementConstraint]float64
+
+// Weight returns the weight of the placement.
+func (weights PlacementWeights) Weight(placement storj.PlacementConstraint) float64 {
+	if weight, ok := weights[placement]; ok {
+		return weight
+	}
+	return 1
+}
+
+// PlacementLoad describes the segments of a placement in the repair queue.
+type PlacementLoad struct {
+	Placement storj.PlacementConstraint
+	// InFlight is the number of segments which were selected recently and
+	// are probably being repaired.
+	InFlight int
+	// Waiting is the number of segments which can be selected.
+	Waiting int
+	// PastDeadline is the number of waiting segments which are past their deadline.
+	PastDeadline int
+}
+
+// UpdateLoads moves the selected segments from waiting to in flight, so that
+// the loads can be used for the following selections without counting the
+// queue again.
+func UpdateLoads(loads []PlacementLoad, selected []InjuredSegment, now time.Time) {
+	for _, segment := range selected {
+		for i := range loads {
+			load := &loads[i]
+			if load.Placement != segment.Placement {
+				continue
+			}
+			load.InFlight++
+			load.Waiting = max(load.Waiting-1, 0)
+			if segment.Deadline != nil && segment.Deadline.Before(now) {
+				load.PastDeadline = max(load.PastDeadline-1, 0)
+			}
+			break
+		}
+	}
+}
+
+// FairShares decides how many segments to select from every placement.
+//
+// Segments past their deadline are shared first, regardless of how many
+// other segments the placements have. The rest of the limit is shared with
+// weighted fair queueing: every segment goes to the placement that has the
+// fewest segments in flight, including the ones already shared, relative to
+// its weight.
+func FairShares(limit int, weights PlacementWeights, loads []PlacementLoad) map[storj.PlacementConstraint]int {
+	shares := make(map[storj.PlacementConstraint]int, len(loads))
+
+	limit = shareFairly(limit, weights, loads, shares, func(load PlacementLoad) int {
+		return load.PastDeadline
+	})
+	shareFairly(limit, weights, loads, shares, func(load PlacementLoad) int {
+		if weights.Weight(load.Placement) <= 0 {
+			return 0
+		}
+		return load.Waiting
+	})
+
+	return shares
+}
+
+// shareFairly adds up to limit segments to shares, without exceeding the
+// available segments of the placements. It returns the remaining limit.
+func shareFairly(limit int, weights PlacementWeights, loads []PlacementLoad, shares map[storj.PlacementConstraint]int, available func(PlacementLoad) int) int {
+	for ; limit > 0; limit-- {
+		best := -1
+		var bestFinish float64
+		for i, load := range loads {
+			if shares[load.Placement] >= available(load) {
+				continue
+			}
+
+			// zero weight results in +Inf, which sorts after all other placements.
+			finish := float64(load.InFlight+shares[load.Placement]+1) / weights.Weight(load.Placement)
+			if best < 0 || finish < bestFinish || (finish == bestFinish && load.Placement < loads[best].Placement) {
+				best, bestFinish = i, finish
+			}
+		}
+		if best < 0 {
+			break
+		}
+		shares[loads[best].Placement]++
+	}
+	return limit
+}
@@ -0,0 +1,117 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package queue_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/repair/queue"
+)
+
+func TestFairShares(t *testing.T) {
+	type shares = map[storj.PlacementConstraint]int
+
+	for _, tc := range []struct {
+		name     string
+		limit    int
+		weights  queue.PlacementWeights
+		loads    []queue.PlacementLoad
+		expected shares
+	}{
+		{
+			name:     "empty queue",
+			limit:    10,
+			expected: shares{},
+		},
+		{
+			name:  "flooded placement doesn't starve others",
+			limit: 10,
+			loads: []queue.PlacementLoad{
+				{Placement: 0, Waiting: 1000},
+				{Placement: 1, Waiting: 3},
+				{Placement: 2, Waiting: 1000},
+			},
+			expected: shares{0: 4, 1: 3, 2: 3},
+		},
+		{
+			name:    "weights",
+			limit:   12,
+			weights: queue.PlacementWeights{1: 2, 2: 1},
+			loads: []queue.PlacementLoad{
+				{Placement: 1, Waiting: 100},
+				{Placement: 2, Waiting: 100},
+			},
+			expected: shares{1: 8, 2: 4},
+		},
+		{
+			name:  "segments in flight count against the share",
+			limit: 4,
+			loads: []queue.PlacementLoad{
+				{Placement: 0, InFlight: 3, Waiting: 100},
+				{Placement: 1, Waiting: 100},
+			},
+			expected: shares{0: 1, 1: 3},
+		},
+		{
+			name:    "weights and segments in flight",
+			limit:   6,
+			weights: queue.PlacementWeights{1: 2},
+			loads: []queue.PlacementLoad{
+				{Placement: 1, InFlight: 4, Waiting: 16},
+				{Placement: 2, Waiting: 5},
+			},
+			expected: shares{1: 3, 2: 3},
+		},
+		{
+			name:  "past deadline first",
+			limit: 4,
+			loads: []queue.PlacementLoad{
+				{Placement: 0, Waiting: 100},
+				{Placement: 1, InFlight: 50, Waiting: 100, PastDeadline: 3},
+			},
+			expected: shares{0: 1, 1: 3},
+		},
+		{
+			name:    "zero weight gets only segments past deadline",
+			limit:   10,
+			weights: queue.PlacementWeights{1: 0},
+			loads: []queue.PlacementLoad{
+				{Placement: 0, Waiting: 5},
+				{Placement: 1, Waiting: 100, PastDeadline: 2},
+			},
+			expected: shares{0: 5, 1: 2},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, queue.FairShares(tc.limit, tc.weights, tc.loads))
+		})
+	}
+}
+
+func TestUpdateLoads(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+
+	loads := []queue.PlacementLoad{
+		{Placement: 0, Waiting: 3, PastDeadline: 1},
+		{Placement: 1, InFlight: 2, Waiting: 1},
+	}
+	queue.UpdateLoads(loads, []queue.InjuredSegment{
+		{Placement: 0, Deadline: &past},
+		{Placement: 0, Deadline: &future},
+		{Placement: 1},
+		// segments of placements without load are ignored, and waiting never goes below zero.
+		{Placement: 1},
+		{Placement: 2},
+	}, now)
+
+	require.Equal(t, []queue.PlacementLoad{
+		{Placement: 0, InFlight: 2, Waiting: 1},
+		{Placement: 1, InFlight: 4},
+	}, loads)
+}
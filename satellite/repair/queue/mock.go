@@ -47,6 +47,16 @@ func (m *MockRepairQueue) Select(context.Context, int, []storj.PlacementConstrai
 	panic("implement me")
 }
 
+// PlacementLoads implements RepairQueue.
+func (m *MockRepairQueue) PlacementLoads(context.Context, []storj.PlacementConstraint, []storj.PlacementConstraint) ([]PlacementLoad, error) {
+	panic("implement me")
+}
+
+// SelectFair implements RepairQueue.
+func (m *MockRepairQueue) SelectFair(context.Context, int, PlacementWeights, []PlacementLoad) ([]InjuredSegment, error) {
+	panic("implement me")
+}
+
 // Delete implements RepairQueue.
 func (m *MockRepairQueue) Delete(ctx context.Context, s InjuredSegment) error {
 	panic("implement me")
@@ -22,6 +22,9 @@ type InjuredSegment struct {
 	AttemptedAt   *time.Time
 	UpdatedAt     time.Time
 	InsertedAt    time.Time
+	// Deadline is the time until which the segment should be repaired.
+	// Segments past their deadline are selected before all others.
+	Deadline *time.Time
 
 	Placement storj.PlacementConstraint
 }
@@ -36,6 +39,7 @@ type Stat struct {
 	MinAttemptedAt   *time.Time
 	MinSegmentHealth float64
 	MaxSegmentHealth float64
+	PastDeadline     int
 }
 
 // RepairQueue implements queueing for segments that need repairing.
@@ -49,6 +53,10 @@ type RepairQueue interface {
 	InsertBatch(ctx context.Context, segments []*InjuredSegment) (newlyInsertedSegments []*InjuredSegment, err error)
 	// Select gets an injured segments.
 	Select(ctx context.Context, limit int, includedPlacements []storj.PlacementConstraint, excludedPlacements []storj.PlacementConstraint) ([]InjuredSegment, error)
+	// PlacementLoads returns how many segments of every placement are being repaired and waiting for repair.
+	PlacementLoads(ctx context.Context, includedPlacements []storj.PlacementConstraint, excludedPlacements []storj.PlacementConstraint) ([]PlacementLoad, error)
+	// SelectFair gets injured segments, sharing the limit between the placements of the loads according to their weights.
+	SelectFair(ctx context.Context, limit int, weights PlacementWeights, loads []PlacementLoad) ([]InjuredSegment, error)
 	// Delete removes an injured segment.
 	Delete(ctx context.Context, s InjuredSegment) error
 	// Clean removes all segments last updated before a certain time
@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap/zaptest"
 
+	"storj.io/common/storj"
 	"storj.io/common/testcontext"
 	"storj.io/common/testrand"
 	"storj.io/common/uuid"
@@ -280,3 +281,109 @@ func TestCount(t *testing.T) {
 	})
 
 }
+
+func TestOrderDeadline(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		repairQueue := db.RepairQueue()
+
+		now := time.Now()
+		pastDeadline := now.Add(-time.Hour)
+		futureDeadline := now.Add(time.Hour)
+
+		overdue := &queue.InjuredSegment{StreamID: testrand.UUID(), SegmentHealth: 10, Deadline: &pastDeadline}
+		unhealthy := &queue.InjuredSegment{StreamID: testrand.UUID(), SegmentHealth: 1, Deadline: &futureDeadline}
+		noDeadline := &queue.InjuredSegment{StreamID: testrand.UUID(), SegmentHealth: 5}
+
+		for _, segment := range []*queue.InjuredSegment{unhealthy, noDeadline, overdue} {
+			alreadyInserted, err := repairQueue.Insert(ctx, segment)
+			require.NoError(t, err)
+			require.False(t, alreadyInserted)
+		}
+
+		stats, err := repairQueue.Stat(ctx)
+		require.NoError(t, err)
+		pastDeadlineCount := 0
+		for _, stat := range stats {
+			pastDeadlineCount += stat.PastDeadline
+		}
+		require.Equal(t, 1, pastDeadlineCount)
+
+		// reinserting doesn't postpone the deadline
+		later := now.Add(24 * time.Hour)
+		overdue.Deadline = &later
+		alreadyInserted, err := repairQueue.Insert(ctx, overdue)
+		require.NoError(t, err)
+		require.True(t, alreadyInserted)
+
+		// segment past its deadline should be selected first, regardless of health
+		for _, expected := range []*queue.InjuredSegment{overdue, unhealthy, noDeadline} {
+			injuredSegs, err := repairQueue.Select(ctx, 1, nil, nil)
+			require.NoError(t, err)
+			require.Equal(t, expected.StreamID, injuredSegs[0].StreamID)
+		}
+	})
+}
+
+func TestSelectFair(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		repairQueue := db.RepairQueue()
+
+		// placement 1 is flooded with injured segments in worse health
+		var segments []*queue.InjuredSegment
+		for i := 0; i < 20; i++ {
+			segments = append(segments, &queue.InjuredSegment{
+				StreamID:      testrand.UUID(),
+				SegmentHealth: 1,
+				Placement:     1,
+			})
+		}
+		for i := 0; i < 5; i++ {
+			segments = append(segments, &queue.InjuredSegment{
+				StreamID:      testrand.UUID(),
+				SegmentHealth: 10,
+				Placement:     2,
+			})
+		}
+		_, err := repairQueue.InsertBatch(ctx, segments)
+		require.NoError(t, err)
+
+		injuredSegs, err := repairQueue.Select(ctx, 4, nil, nil)
+		require.NoError(t, err)
+		for _, segment := range injuredSegs {
+			require.Equal(t, storj.PlacementConstraint(1), segment.Placement)
+		}
+
+		loads, err := repairQueue.PlacementLoads(ctx, nil, nil)
+		require.NoError(t, err)
+		require.Equal(t, []queue.PlacementLoad{
+			{Placement: 1, InFlight: 4, Waiting: 16},
+			{Placement: 2, Waiting: 5},
+		}, loads)
+
+		// the 4 selected segments are in flight, which offsets the higher weight of placement 1
+		injuredSegs, err = repairQueue.SelectFair(ctx, 6, queue.PlacementWeights{1: 2}, loads)
+		require.NoError(t, err)
+		count := map[storj.PlacementConstraint]int{}
+		for _, segment := range injuredSegs {
+			count[segment.Placement]++
+		}
+		require.Equal(t, map[storj.PlacementConstraint]int{1: 3, 2: 3}, count)
+
+		loads, err = repairQueue.PlacementLoads(ctx, nil, []storj.PlacementConstraint{1})
+		require.NoError(t, err)
+		require.Equal(t, []queue.PlacementLoad{{Placement: 2, InFlight: 3, Waiting: 2}}, loads)
+
+		injuredSegs, err = repairQueue.SelectFair(ctx, 100, nil, loads)
+		require.NoError(t, err)
+		require.Len(t, injuredSegs, 2)
+		for _, segment := range injuredSegs {
+			require.Equal(t, storj.PlacementConstraint(2), segment.Placement)
+		}
+
+		queue.UpdateLoads(loads, injuredSegs, time.Now())
+		require.Equal(t, []queue.PlacementLoad{{Placement: 2, InFlight: 5}}, loads)
+
+		_, err = repairQueue.SelectFair(ctx, 100, nil, loads)
+		require.True(t, queue.ErrEmpty.Has(err))
+	})
+}
@@ -39,6 +39,9 @@ var (
 
 	// ErrDialFailed is the errs class when a failure happens during Dial.
 	ErrDialFailed = errs.Class("dial failure")
+
+	// ErrPieceMoveDownload is the errs class when a piece couldn't be fetched from the old node during a piece move.
+	ErrPieceMoveDownload = errs.Class("piece move download")
 )
 
 // ECRepairer allows the repairer to download, verify, and upload pieces from storagenodes.
@@ -621,6 +624,74 @@ func (ec *ECRepairer) putPiece(ctx, parent context.Context, log *zap.Logger, lim
 	return hash, err
 }
 
+// MovePiece copies a single piece from the node of getLimit to the node of putLimit,
+// without reconstructing the segment. The downloaded piece is verified against the
+// hash signed by the uplink, and the piece stored by the new node has to match it.
+//
+// Errors which happened while fetching the piece from the old node are wrapped with
+// ErrPieceMoveDownload, so they can be attributed to the old node.
+func (ec *ECRepairer) MovePiece(ctx context.Context, log *zap.Logger, getLimit *pb.AddressedOrderLimit, getPrivateKey storj.PiecePrivateKey, cachedNodeInfo overlay.NodeReputation, putLimit *pb.AddressedOrderLimit, putPrivateKey storj.PiecePrivateKey, pieceSize int64, timeout time.Duration) (hash *pb.PieceHash, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if getLimit == nil || putLimit == nil {
+		return nil, Error.New("missing order limit")
+	}
+
+	address := getLimit.GetStorageNodeAddress().GetAddress()
+	triedLastIPPort := false
+	if cachedNodeInfo.LastIPPort != "" && cachedNodeInfo.LastIPPort != address {
+		address = cachedNodeInfo.LastIPPort
+		triedLastIPPort = true
+	}
+
+	pieceReadCloser, originalHash, _, err := ec.downloadAndVerifyPiece(ctx, getLimit, address, getPrivateKey, "", pieceSize)
+	if triedLastIPPort && ErrDialFailed.Has(err) {
+		if pieceReadCloser != nil {
+			_ = pieceReadCloser.Close()
+		}
+		pieceReadCloser, originalHash, _, err = ec.downloadAndVerifyPiece(ctx, getLimit, getLimit.GetStorageNodeAddress().GetAddress(), getPrivateKey, "", pieceSize)
+	}
+	if err != nil {
+		if pieceReadCloser != nil {
+			_ = pieceReadCloser.Close()
+		}
+		return nil, ErrPieceMoveDownload.Wrap(err)
+	}
+
+	// hash the uploaded data with the algorithm of the original piece, so we
+	// can compare it with the hash signed by the uplink.
+	hasher := pb.NewHashFromAlgorithm(originalHash.HashAlgorithm)
+	data := struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: io.TeeReader(pieceReadCloser, hasher),
+		Closer: pieceReadCloser,
+	}
+
+	putCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	hash, err = ec.putPiece(putCtx, ctx, log, putLimit, putPrivateKey, data)
+	if err != nil {
+		return nil, err
+	}
+	if hash == nil {
+		return nil, Error.New("hash was not sent from storagenode")
+	}
+
+	if !bytes.Equal(hasher.Sum(nil), originalHash.Hash) {
+		return nil, ErrPieceHashVerifyFailed.New("uploaded data does not match the original piece")
+	}
+	if hash.HashAlgorithm == originalHash.HashAlgorithm && !bytes.Equal(hash.Hash, originalHash.Hash) {
+		return nil, ErrPieceHashVerifyFailed.New("hash from new storage node, %x, does not match the original hash, %x", hash.Hash, originalHash.Hash)
+	}
+
+	mon.Meter("repair_bytes_moved").Mark64(pieceSize)
+
+	return hash, nil
+}
+
 func nonNilCount(limits []*pb.AddressedOrderLimit) int {
 	total := 0
 	for _, limit := range limits {
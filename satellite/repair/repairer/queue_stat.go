@@ -99,11 +99,13 @@ func (c *QueueStat) Stats(cb func(key monkit.SeriesKey, field string, val float6
 				cb(keyWithDefaultTags, "since_latest_inserted_sec", 0)
 				cb(keyWithDefaultTags, "since_oldest_attempted_sec", 0)
 				cb(keyWithDefaultTags, "since_latest_attempted_sec", 0)
+				cb(keyWithDefaultTags, "past_deadline", 0)
 
 				continue
 			}
 
 			cb(keyWithDefaultTags, "count", float64(stat.Count))
+			cb(keyWithDefaultTags, "past_deadline", float64(stat.PastDeadline))
 			cb(keyWithDefaultTags, "age", time.Since(c.updated).Seconds())
 			cb(keyWithDefaultTags, "since_oldest_inserted_sec", time.Since(stat.MinInsertedAt).Seconds())
 			cb(keyWithDefaultTags, "since_latest_inserted_sec", time.Since(stat.MaxInsertedAt).Seconds())
@@ -6,6 +6,7 @@ package repairer
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -46,9 +47,14 @@ type Config struct {
 	RepairExcludedCountryCodes    []string      `help:"list of country codes to treat node from this country as offline" default:"" hidden:"true"`
 	DoDeclumping                  bool          `help:"repair pieces on the same network to other nodes" default:"true"`
 	DoPlacementCheck              bool          `help:"repair pieces out of segment placement" default:"true"`
+	Restripe                      bool          `help:"re-encode segments with the current redundancy scheme of their placement, when they are repaired" default:"false"`
+	PieceMoveRepair               bool          `help:"move unhealthy but retrievable pieces (e.g. out of placement or clumped) piece by piece to new nodes, instead of reconstructing the segment, when it needs fewer downloads" default:"false"`
 
 	IncludedPlacements PlacementList `help:"comma separated placement IDs (numbers), which should checked by the repairer (other placements are ignored)" default:""`
 	ExcludedPlacements PlacementList `help:"comma separated placement IDs (numbers), placements which should be ignored by the repairer" default:""`
+
+	FairPlacementSelection bool                `help:"share the repair capacity between placements according to placement-weights, instead of selecting strictly by segment health" default:"false"`
+	PlacementWeights       PlacementWeightList `help:"comma separated placement:weight pairs for fair placement selection, placements without weight have weight 1" default:""`
 }
 
 // PlacementList is a configurable, comma separated list of PlacementConstraint IDs.
@@ -89,6 +95,58 @@ func (p PlacementList) Type() string {
 
 var _ pflag.Value = &PlacementList{}
 
+// PlacementWeightList is a configurable, comma separated list of placement:weight pairs.
+type PlacementWeightList struct {
+	Weights queue.PlacementWeights
+}
+
+// String implements pflag.Value.
+func (p *PlacementWeightList) String() string {
+	placements := make([]storj.PlacementConstraint, 0, len(p.Weights))
+	for placement := range p.Weights {
+		placements = append(placements, placement)
+	}
+	slices.Sort(placements)
+
+	var s []string
+	for _, placement := range placements {
+		s = append(s, fmt.Sprintf("%d:%s", placement, strconv.FormatFloat(p.Weights[placement], 'f', -1, 64)))
+	}
+	return strings.Join(s, ",")
+}
+
+// Set implements pflag.Value.
+func (p *PlacementWeightList) Set(s string) error {
+	p.Weights = queue.PlacementWeights{}
+	for _, pair := range strings.Split(s, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		pNumStr, weightStr, ok := strings.Cut(pair, ":")
+		if !ok {
+			return errs.New("Placement weight should be in the form placement:weight: %s", pair)
+		}
+		pNum, err := strconv.Atoi(strings.TrimSpace(pNumStr))
+		if err != nil {
+			return errs.New("Placement should be a number: %s", pair)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(weightStr), 64)
+		if err != nil || weight < 0 {
+			return errs.New("Placement weight should be a non-negative number: %s", pair)
+		}
+		p.Weights[storj.PlacementConstraint(pNum)] = weight
+	}
+	return nil
+}
+
+// Type implements pflag.Value.
+func (p PlacementWeightList) Type() string {
+	return "placement-weight-list"
+}
+
+var _ pflag.Value = &PlacementWeightList{}
+
 // Service contains the information needed to run the repair service.
 //
 // architecture: Worker
@@ -101,6 +159,11 @@ type Service struct {
 	repairer   *SegmentRepairer
 
 	nowFn func() time.Time
+
+	// placementLoads are the loads of the placements in the current selection round of fair
+	// placement selection, loaded at placementLoadsAt.
+	placementLoads   []queue.PlacementLoad
+	placementLoadsAt time.Time
 }
 
 // NewService creates repairing service.
@@ -171,8 +234,13 @@ func (service *Service) process(ctx context.Context) (err error) {
 
 	selectCtx, selectCancel := context.WithTimeout(ctx, service.config.TotalTimeout)
 	defer selectCancel()
-	segments, err := service.queue.Select(selectCtx, service.config.SegmentsSelectBatchSize,
-		service.config.IncludedPlacements.Placements, service.config.ExcludedPlacements.Placements)
+	var segments []queue.InjuredSegment
+	if service.config.FairPlacementSelection {
+		segments, err = service.selectFair(selectCtx)
+	} else {
+		segments, err = service.queue.Select(selectCtx, service.config.SegmentsSelectBatchSize,
+			service.config.IncludedPlacements.Placements, service.config.ExcludedPlacements.Placements)
+	}
 	if err != nil {
 		return err
 	}
@@ -254,6 +322,36 @@ func (service *Service) worker(ctx context.Context, seg queue.InjuredSegment) (e
 	return nil
 }
 
+// selectFair selects segments with fair placement selection. The loads of the placements are
+// counted once per selection round and updated with the selected segments in between, so that
+// every batch doesn't have to count the whole queue. A round lasts until the counted segments
+// run out or for at most the repairer interval.
+func (service *Service) selectFair(ctx context.Context) (_ []queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := service.nowFn()
+	if service.placementLoads == nil || now.Sub(service.placementLoadsAt) >= service.config.Interval {
+		service.placementLoads, err = service.queue.PlacementLoads(ctx,
+			service.config.IncludedPlacements.Placements, service.config.ExcludedPlacements.Placements)
+		if err != nil {
+			return nil, err
+		}
+		service.placementLoadsAt = now
+	}
+
+	segments, err := service.queue.SelectFair(ctx, service.config.SegmentsSelectBatchSize, service.config.PlacementWeights.Weights, service.placementLoads)
+	if err != nil {
+		if queue.ErrEmpty.Has(err) {
+			// the round is over, the next selection counts the segments again.
+			service.placementLoads = nil
+		}
+		return nil, err
+	}
+
+	queue.UpdateLoads(service.placementLoads, segments, now)
+	return segments, nil
+}
+
 // SetNow allows tests to have the server act as if the current time is whatever they want.
 func (service *Service) SetNow(nowFn func() time.Time) {
 	service.nowFn = nowFn
@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/structs"
 
 	"storj.io/common/storj"
+	"storj.io/storj/satellite/repair/queue"
 )
 
 func TestPlacementList(t *testing.T) {
@@ -26,3 +27,22 @@ func TestPlacementList(t *testing.T) {
 
 	require.Equal(t, []storj.PlacementConstraint{1, 3, 5, 6}, pl.ExcludedPlacements.Placements)
 }
+
+func TestPlacementWeightList(t *testing.T) {
+	pl := Config{}
+
+	decode := structs.Decode(map[string]string{
+		"placement-weights": "1:2, 3:0.5,0:0",
+	}, &pl)
+
+	require.NoError(t, decode.Error)
+	require.Len(t, decode.Broken, 0)
+	require.Len(t, decode.Used, 1)
+
+	require.Equal(t, queue.PlacementWeights{0: 0, 1: 2, 3: 0.5}, pl.PlacementWeights.Weights)
+	require.Equal(t, "0:0,1:2,3:0.5", pl.PlacementWeights.String())
+
+	for _, invalid := range []string{"1", "a:1", "1:b", "1:-1"} {
+		require.Error(t, (&PlacementWeightList{}).Set(invalid), invalid)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"math"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/calebcase/tmpfile"
@@ -18,12 +19,14 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 
+	"storj.io/common/encryption"
 	"storj.io/common/pb"
 	"storj.io/common/storj"
 	"storj.io/common/sync2"
 	"storj.io/eventkit"
 	"storj.io/storj/satellite/audit"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metainfo"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -114,6 +117,8 @@ type SegmentRepairer struct {
 	reputationUpdateEnabled bool
 	doDeclumping            bool
 	doPlacementCheck        bool
+	doPieceMove             bool
+	doRestripe              bool
 
 	// multiplierOptimalThreshold is the value that multiplied by the optimal
 	// threshold results in the maximum limit of number of nodes to upload
@@ -124,13 +129,18 @@ type SegmentRepairer struct {
 	repairThresholdOverrides checker.RepairThresholdOverrides
 	// repairTargetOverrides is similar but determines the optimum number of pieces per segment.
 	repairTargetOverrides checker.RepairTargetOverrides
+	// rsConfig is the default redundancy scheme, which is overridden by the
+	// EC parameters of the placements. It's the target of re-striping.
+	rsConfig metainfo.RSConfig
 
 	excludedCountryCodes map[location.CountryCode]struct{}
 
 	nowFn                            func() time.Time
 	OnTestingCheckSegmentAlteredHook func()
 	OnTestingPiecesReportHook        func(pieces FetchResultReport)
-	placements                       nodeselection.PlacementDefinitions
+
+	placementMu sync.RWMutex
+	placements  nodeselection.PlacementDefinitions
 }
 
 // NewSegmentRepairer creates a new instance of SegmentRepairer.
@@ -148,6 +158,7 @@ func NewSegmentRepairer(
 	placements nodeselection.PlacementDefinitions,
 	repairThresholdOverrides checker.RepairThresholdOverrides,
 	repairTargetOverrides checker.RepairTargetOverrides,
+	rsConfig metainfo.RSConfig,
 	config Config,
 ) *SegmentRepairer {
 
@@ -174,17 +185,38 @@ func NewSegmentRepairer(
 		multiplierOptimalThreshold: 1 + excessOptimalThreshold,
 		repairThresholdOverrides:   repairThresholdOverrides,
 		repairTargetOverrides:      repairTargetOverrides,
+		rsConfig:                   rsConfig,
 		excludedCountryCodes:       excludedCountryCodes,
 		reporter:                   reporter,
 		reputationUpdateEnabled:    config.ReputationUpdateEnabled,
 		doDeclumping:               config.DoDeclumping,
 		doPlacementCheck:           config.DoPlacementCheck,
+		doPieceMove:                config.PieceMoveRepair,
+		doRestripe:                 config.Restripe,
 		placements:                 placements,
 
 		nowFn: time.Now,
 	}
 }
 
+// SetPlacements replaces the placement definitions, which are used for the
+// next repairs.
+func (repairer *SegmentRepairer) SetPlacements(ctx context.Context, placements nodeselection.PlacementDefinitions) error {
+	repairer.placementMu.Lock()
+	defer repairer.placementMu.Unlock()
+
+	repairer.placements = placements
+	return nil
+}
+
+// getPlacement returns the current definition of the placement.
+func (repairer *SegmentRepairer) getPlacement(placement storj.PlacementConstraint) nodeselection.Placement {
+	repairer.placementMu.RLock()
+	defer repairer.placementMu.RUnlock()
+
+	return repairer.placements[placement]
+}
+
 // Repair retrieves an at-risk segment and repairs and stores lost pieces on new nodes
 // note that shouldDelete is used even in the case where err is not null
 // note that it will update audit status as failed for nodes that failed piece hash verification during repair downloading.
@@ -239,12 +271,19 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		return false, overlayQueryError.New("GetActiveNodes returned an invalid result")
 	}
 	pieces := segment.Pieces
-	piecesCheck := repair.ClassifySegmentPieces(pieces, selectedNodes, repairer.excludedCountryCodes, repairer.doPlacementCheck, repairer.doDeclumping, repairer.placements[segment.Placement])
+	piecesCheck := repair.ClassifySegmentPieces(pieces, selectedNodes, repairer.excludedCountryCodes, repairer.doPlacementCheck, repairer.doDeclumping, repairer.getPlacement(segment.Placement))
 
 	newRedundancy := repairer.newRedundancy(segment.Redundancy)
 
+	// when re-striping, the segment is re-encoded with the current redundancy
+	// scheme of its placement, and none of the existing pieces are kept.
+	restriping := false
+	if target, ok := repairer.restripeRedundancy(segment); ok {
+		newRedundancy, restriping = target, true
+	}
+
 	// irreparable segment
-	if piecesCheck.Retrievable.Count() < int(newRedundancy.RequiredShares) {
+	if piecesCheck.Retrievable.Count() < int(segment.Redundancy.RequiredShares) {
 		mon.Counter("repairer_segments_below_min_req").Inc(1) //mon:locked
 		stats.repairerSegmentsBelowMinReq.Inc(1)
 		mon.Meter("repair_nodes_unavailable").Mark(1) //mon:locked
@@ -289,7 +328,7 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 	mon.Counter("repairer_segments_below_min_req").Inc(0) //mon:locked
 	stats.repairerSegmentsBelowMinReq.Inc(0)
 
-	if piecesCheck.Healthy.Count() > int(newRedundancy.RepairShares) {
+	if piecesCheck.Healthy.Count() > int(newRedundancy.RepairShares) && !restriping {
 		// No repair is needed (note Healthy does not include pieces in ForcingRepair).
 
 		var dropPieces metabase.Pieces
@@ -384,15 +423,21 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		}
 	}
 
+	// the number of healthy pieces which remain in the segment
+	healthyCount := piecesCheck.Healthy.Count()
+	if restriping {
+		healthyCount = 0
+	}
+
 	var requestCount int
 	{
 		totalNeeded := int(math.Ceil(float64(newRedundancy.OptimalShares) * repairer.multiplierOptimalThreshold))
 		if totalNeeded > int(newRedundancy.TotalShares) {
 			totalNeeded = int(newRedundancy.TotalShares)
 		}
-		requestCount = totalNeeded - piecesCheck.Healthy.Count()
+		requestCount = totalNeeded - healthyCount
 	}
-	minSuccessfulNeeded := int(newRedundancy.OptimalShares) - piecesCheck.Healthy.Count()
+	minSuccessfulNeeded := int(newRedundancy.OptimalShares) - healthyCount
 
 	var alreadySelected []*nodeselection.SelectedNode
 	for i := range selectedNodes {
@@ -413,6 +458,17 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		mon.Counter("repairer_required_downloads", placementTag).Inc(int64(requestCount))
 	}
 
+	if repairer.doPieceMove && !restriping {
+		// when only a few unhealthy-but-retrievable pieces have to be replaced, it's
+		// cheaper to move them one by one than to download enough pieces to
+		// reconstruct the segment.
+		movable := movablePieces(pieces, piecesCheck, getOrderLimits)
+		moveCount := min(requestCount, len(movable))
+		if moveCount >= minSuccessfulNeeded && moveCount < int(segment.Redundancy.RequiredShares) {
+			return repairer.movePieces(ctx, log, segment, newRedundancy, piecesCheck, movable[:moveCount], minSuccessfulNeeded, alreadySelected, getOrderLimits, getPrivateKey, cachedNodesInfo, stats)
+		}
+	}
+
 	// Request Overlay for n-h new storage nodes
 	request := overlay.FindStorageNodesRequest{
 		RequestedCount:  requestCount,
@@ -667,11 +723,17 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 
 	// TODO how to avoid this two loops
 	for _, piece := range pieces {
+		if restriping {
+			break
+		}
 		if piecesCheck.Healthy.Contains(int(piece.Number)) {
 			toKeep[piece.Number] = struct{}{}
 		}
 	}
 	for _, piece := range pieces {
+		if restriping {
+			break
+		}
 		if piecesCheck.InExcludedCountry.Contains(int(piece.Number)) {
 			if len(toKeep) >= maxToKeep {
 				break
@@ -695,8 +757,15 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		zap.Int("minSuccessfulNeeded", minSuccessfulNeeded),
 		zapRS("RS", newRedundancy))
 
+	var repairReader io.Reader = segmentReader
+	if restriping {
+		// the reconstructed segment is padded to the stripe size of the old
+		// redundancy scheme, it has to be padded again for the new one.
+		repairReader = encryption.PadReader(io.NopCloser(io.LimitReader(segmentReader, int64(segment.EncryptedSize))), int(newRedundancy.StripeSize()))
+	}
+
 	// Upload the repaired pieces
-	successfulNodes, _, err := repairer.ec.Repair(ctx, log, putLimits, putPrivateKey, newRedundancyStrategy, segmentReader, repairer.timeout, minSuccessfulNeeded)
+	successfulNodes, _, err := repairer.ec.Repair(ctx, log, putLimits, putPrivateKey, newRedundancyStrategy, repairReader, repairer.timeout, minSuccessfulNeeded)
 	if err != nil {
 		return false, repairPutError.Wrap(err)
 	}
@@ -722,7 +791,265 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 
 	mon.Meter("repair_bytes_uploaded").Mark64(bytesRepaired) //mon:locked
 
-	healthyAfterRepair := piecesCheck.Healthy.Count() + len(repairedPieces)
+	healthyAfterRepair := healthyCount + len(repairedPieces)
+	if restriping && healthyAfterRepair <= int(newRedundancy.RepairShares) {
+		// The old and the new pieces can't be mixed, so the segment keeps its
+		// old redundancy until we manage to upload enough new pieces.
+		mon.Meter("repair_restripe_failed").Mark(1)
+		return false, repairPutError.New("uploaded %d pieces, not enough for re-striping to %s", healthyAfterRepair, redundancySchemePrinter(newRedundancy))
+	}
+	recordRepairResult(stats, newRedundancy, healthyAfterRepair)
+
+	toRemove := unhealthyPiecesToRemove(pieces, piecesCheck, newRedundancy, healthyAfterRepair)
+	if restriping {
+		for _, piece := range pieces {
+			toRemove[piece.Number] = piece
+		}
+	}
+
+	// in any case, we want to remove pieces for which we have replacements now.
+	for _, piece := range pieces {
+		if repairedMap[piece.Number] {
+			toRemove[piece.Number] = piece
+		}
+	}
+
+	// add pieces that failed piece hash verification to the removal list
+	for _, outcome := range piecesReport.Failed {
+		toRemove[outcome.Piece.Number] = outcome.Piece
+	}
+
+	newPieces, err := segment.Pieces.Update(repairedPieces, maps.Values(toRemove))
+	if err != nil {
+		return false, repairPutError.Wrap(err)
+	}
+
+	err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldPieces:     segment.Pieces,
+		NewRedundancy: newRedundancy,
+		NewPieces:     newPieces,
+
+		NewRepairedAt: time.Now(),
+	})
+	if err != nil {
+		return false, metainfoPutError.Wrap(err)
+	}
+
+	if restriping {
+		mon.Meter("repair_restriped").Mark(1)
+		log.Info("re-striped segment", zapRS("old RS", segment.Redundancy), zapRS("new RS", newRedundancy))
+	}
+
+	recordSegmentAge(stats, segment)
+
+	log.Info("repaired segment",
+		zap.Int("clumped pieces", piecesCheck.Clumped.Count()),
+		zap.Int("exiting-node pieces", piecesCheck.Exiting.Count()),
+		zap.Int("out of placement pieces", piecesCheck.OutOfPlacement.Count()),
+		zap.Int("in excluded countries", piecesCheck.InExcludedCountry.Count()),
+		zap.Int("missing pieces", piecesCheck.Missing.Count()),
+		zap.Int("removed pieces", len(toRemove)),
+		zap.Int("repaired pieces", len(repairedPieces)),
+		zap.Int("retrievable pieces", piecesCheck.Retrievable.Count()),
+		zap.Int("healthy before repair", piecesCheck.Healthy.Count()),
+		zap.Int("healthy after repair", healthyAfterRepair),
+		zap.Int("total before repair", len(selectedNodes)),
+		zap.Int("total after repair", len(newPieces)))
+	return true, nil
+}
+
+// movablePieces returns the unhealthy-but-retrievable pieces, which can be moved
+// to new nodes without reconstructing the segment. Pieces forcing a repair are
+// returned first.
+func movablePieces(pieces metabase.Pieces, piecesCheck repair.PiecesCheckResult, getOrderLimits []*pb.AddressedOrderLimit) (movable metabase.Pieces) {
+	for _, forcingRepair := range []bool{true, false} {
+		for _, piece := range pieces {
+			if !piecesCheck.UnhealthyRetrievable.Contains(int(piece.Number)) {
+				continue
+			}
+			if piecesCheck.ForcingRepair.Contains(int(piece.Number)) != forcingRepair {
+				continue
+			}
+			if int(piece.Number) >= len(getOrderLimits) || getOrderLimits[piece.Number] == nil {
+				continue
+			}
+			movable = append(movable, piece)
+		}
+	}
+	return movable
+}
+
+// movePieces repairs the segment by copying the given pieces one by one from
+// their current nodes to new nodes, keeping the piece numbers. Contrary to a
+// full repair, only the moved pieces are downloaded. The repair fails, when
+// fewer than minSuccessfulNeeded pieces could be moved.
+func (repairer *SegmentRepairer) movePieces(ctx context.Context, log *zap.Logger, segment metabase.Segment, newRedundancy storj.RedundancyScheme, piecesCheck repair.PiecesCheckResult, toMove metabase.Pieces, minSuccessfulNeeded int, alreadySelected []*nodeselection.SelectedNode, getOrderLimits []*pb.AddressedOrderLimit, getPrivateKey storj.PiecePrivateKey, cachedNodesInfo map[storj.NodeID]overlay.NodeReputation, stats *stats) (shouldDelete bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	newNodes, err := repairer.overlay.FindStorageNodesForUpload(ctx, overlay.FindStorageNodesRequest{
+		RequestedCount:  len(toMove),
+		AlreadySelected: alreadySelected,
+		Placement:       segment.Placement,
+	})
+	if err != nil {
+		return false, overlayQueryError.Wrap(err)
+	}
+	if len(newNodes) < minSuccessfulNeeded {
+		return false, overlayQueryError.New("found %d nodes for moving pieces, %d needed", len(newNodes), minSuccessfulNeeded)
+	}
+	if len(newNodes) < len(toMove) {
+		toMove = toMove[:len(newNodes)]
+	}
+
+	// The put order limits are assigned to the piece numbers which are not in
+	// the set, so everything except the moved pieces is marked as kept.
+	toKeep := map[uint16]struct{}{}
+	for pieceNum := 0; pieceNum < int(newRedundancy.TotalShares); pieceNum++ {
+		toKeep[uint16(pieceNum)] = struct{}{}
+	}
+	for _, piece := range toMove {
+		delete(toKeep, piece.Number)
+	}
+
+	putLimits, putPrivateKey, err := repairer.orders.CreatePutRepairOrderLimits(ctx, segment, newRedundancy, getOrderLimits, toKeep, newNodes)
+	if err != nil {
+		return false, orderLimitFailureError.New("could not create PUT_REPAIR order limits: %w", err)
+	}
+
+	log.Debug("moving pieces for segment",
+		zap.Int("numPieces", len(toMove)),
+		zapRS("RS", segment.Redundancy))
+
+	pieceSize := segment.PieceSize()
+
+	var mu sync.Mutex
+	var movedPieces metabase.Pieces
+	var report audit.Report
+	toRemove := map[uint16]metabase.Piece{}
+
+	limiter := sync2.NewLimiter(len(toMove))
+	for _, piece := range toMove {
+		limiter.Go(ctx, func() {
+			getLimit, putLimit := getOrderLimits[piece.Number], putLimits[piece.Number]
+			_, err := repairer.ec.MovePiece(ctx, log, getLimit, getPrivateKey, cachedNodesInfo[piece.StorageNode], putLimit, putPrivateKey, pieceSize, repairer.timeout)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err == nil {
+				report.Successes = append(report.Successes, piece.StorageNode)
+				movedPieces = append(movedPieces, metabase.Piece{
+					Number:      piece.Number,
+					StorageNode: putLimit.GetLimit().StorageNodeId,
+				})
+				toRemove[piece.Number] = piece
+				return
+			}
+
+			log.Debug("failed to move piece",
+				zap.Stringer("Node ID", piece.StorageNode),
+				zap.Uint16("Piece Number", piece.Number),
+				zap.Error(err))
+
+			if !ErrPieceMoveDownload.Has(err) {
+				// the upload to the new node failed, the old node is not to blame.
+				return
+			}
+			if ErrPieceHashVerifyFailed.Has(err) {
+				report.Fails = append(report.Fails, piece)
+				toRemove[piece.Number] = piece
+				return
+			}
+			switch audit.PieceAuditFromErr(err) {
+			case audit.PieceAuditFailure:
+				report.Fails = append(report.Fails, piece)
+				toRemove[piece.Number] = piece
+			case audit.PieceAuditOffline:
+				report.Offlines = append(report.Offlines, piece.StorageNode)
+			case audit.PieceAuditUnknown:
+				report.Unknown = append(report.Unknown, piece.StorageNode)
+			}
+		})
+	}
+	limiter.Wait()
+
+	// Check if segment has been altered
+	if err := repairer.checkIfSegmentAltered(ctx, segment); err != nil {
+		if segmentDeletedError.Has(err) {
+			log.Info("segment deleted during Repair")
+			return true, nil
+		}
+		if segmentModifiedError.Has(err) {
+			log.Info("segment modified during Repair")
+			return true, nil
+		}
+		return false, segmentVerificationError.Wrap(err)
+	}
+
+	if repairer.reputationUpdateEnabled {
+		report.Segment = &segment
+		report.NodesReputation = make(map[storj.NodeID]overlay.ReputationStatus, len(cachedNodesInfo))
+		for id, info := range cachedNodesInfo {
+			report.NodesReputation[id] = info.Reputation
+		}
+		repairer.reporter.RecordAudits(ctx, report)
+	}
+
+	if len(movedPieces) == 0 || len(movedPieces) < minSuccessfulNeeded {
+		// like a full repair, a move which doesn't make the segment healthy
+		// enough fails, and the segment stays in the queue.
+		mon.Meter("repair_piece_move_failed").Mark(1)
+		return false, repairPutError.New("moved %d of %d pieces, %d needed", len(movedPieces), len(toMove), minSuccessfulNeeded)
+	}
+
+	mon.Meter("repair_pieces_moved").Mark(len(movedPieces))
+	mon.Meter("repair_bytes_uploaded").Mark64(int64(len(movedPieces)) * pieceSize) //mon:locked
+
+	healthyAfterRepair := piecesCheck.Healthy.Count() + len(movedPieces)
+	recordRepairResult(stats, newRedundancy, healthyAfterRepair)
+
+	for number, piece := range unhealthyPiecesToRemove(segment.Pieces, piecesCheck, newRedundancy, healthyAfterRepair) {
+		toRemove[number] = piece
+	}
+
+	newPieces, err := segment.Pieces.Update(movedPieces, maps.Values(toRemove))
+	if err != nil {
+		return false, repairPutError.Wrap(err)
+	}
+
+	err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
+		StreamID: segment.StreamID,
+		Position: segment.Position,
+
+		OldPieces:     segment.Pieces,
+		NewRedundancy: newRedundancy,
+		NewPieces:     newPieces,
+
+		NewRepairedAt: time.Now(),
+	})
+	if err != nil {
+		return false, metainfoPutError.Wrap(err)
+	}
+
+	recordSegmentAge(stats, segment)
+
+	log.Info("repaired segment by moving pieces",
+		zap.Int("clumped pieces", piecesCheck.Clumped.Count()),
+		zap.Int("out of placement pieces", piecesCheck.OutOfPlacement.Count()),
+		zap.Int("removed pieces", len(toRemove)),
+		zap.Int("moved pieces", len(movedPieces)),
+		zap.Int("healthy before repair", piecesCheck.Healthy.Count()),
+		zap.Int("healthy after repair", healthyAfterRepair),
+		zap.Int("total after repair", len(newPieces)))
+	return true, nil
+}
+
+// recordRepairResult reports the outcome of a repair operation, depending on
+// the number of healthy pieces after the repair.
+func recordRepairResult(stats *stats, newRedundancy storj.RedundancyScheme, healthyAfterRepair int) {
 	switch {
 	case healthyAfterRepair >= int(newRedundancy.OptimalShares):
 		mon.Meter("repair_success").Mark(1) //mon:locked
@@ -747,7 +1074,11 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 
 	mon.FloatVal("healthy_ratio_after_repair").Observe(healthyRatioAfterRepair) //mon:locked
 	stats.healthyRatioAfterRepair.Observe(healthyRatioAfterRepair)
+}
 
+// unhealthyPiecesToRemove returns the unhealthy pieces which can be removed from
+// the segment, depending on the number of healthy pieces after the repair.
+func unhealthyPiecesToRemove(pieces metabase.Pieces, piecesCheck repair.PiecesCheckResult, newRedundancy storj.RedundancyScheme, healthyAfterRepair int) map[uint16]metabase.Piece {
 	toRemove := make(map[uint16]metabase.Piece, piecesCheck.Unhealthy.Count())
 	switch {
 	case healthyAfterRepair >= int(newRedundancy.OptimalShares):
@@ -778,38 +1109,11 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 		// repair threshold (not counting unhealthy-but-retrievable pieces). To be safe,
 		// we will keep unhealthy-but-retrievable pieces in the segment for now.
 	}
+	return toRemove
+}
 
-	// in any case, we want to remove pieces for which we have replacements now.
-	for _, piece := range pieces {
-		if repairedMap[piece.Number] {
-			toRemove[piece.Number] = piece
-		}
-	}
-
-	// add pieces that failed piece hash verification to the removal list
-	for _, outcome := range piecesReport.Failed {
-		toRemove[outcome.Piece.Number] = outcome.Piece
-	}
-
-	newPieces, err := segment.Pieces.Update(repairedPieces, maps.Values(toRemove))
-	if err != nil {
-		return false, repairPutError.Wrap(err)
-	}
-
-	err = repairer.metabase.UpdateSegmentPieces(ctx, metabase.UpdateSegmentPieces{
-		StreamID: segment.StreamID,
-		Position: segment.Position,
-
-		OldPieces:     segment.Pieces,
-		NewRedundancy: newRedundancy,
-		NewPieces:     newPieces,
-
-		NewRepairedAt: time.Now(),
-	})
-	if err != nil {
-		return false, metainfoPutError.Wrap(err)
-	}
-
+// recordSegmentAge reports the time passed since the segment was created or last repaired.
+func recordSegmentAge(stats *stats, segment metabase.Segment) {
 	repairedAt := time.Time{}
 	if segment.RepairedAt != nil {
 		repairedAt = *segment.RepairedAt
@@ -824,21 +1128,6 @@ func (repairer *SegmentRepairer) Repair(ctx context.Context, queueSegment queue.
 
 	mon.IntVal("segment_time_until_repair").Observe(int64(segmentAge.Seconds())) //mon:locked
 	stats.segmentTimeUntilRepair.Observe(int64(segmentAge.Seconds()))
-
-	log.Info("repaired segment",
-		zap.Int("clumped pieces", piecesCheck.Clumped.Count()),
-		zap.Int("exiting-node pieces", piecesCheck.Exiting.Count()),
-		zap.Int("out of placement pieces", piecesCheck.OutOfPlacement.Count()),
-		zap.Int("in excluded countries", piecesCheck.InExcludedCountry.Count()),
-		zap.Int("missing pieces", piecesCheck.Missing.Count()),
-		zap.Int("removed pieces", len(toRemove)),
-		zap.Int("repaired pieces", len(repairedPieces)),
-		zap.Int("retrievable pieces", piecesCheck.Retrievable.Count()),
-		zap.Int("healthy before repair", piecesCheck.Healthy.Count()),
-		zap.Int("healthy after repair", healthyAfterRepair),
-		zap.Int("total before repair", len(selectedNodes)),
-		zap.Int("total after repair", len(newPieces)))
-	return true, nil
 }
 
 // checkIfSegmentAltered checks if oldSegment has been altered since it was selected for audit.
@@ -890,6 +1179,21 @@ func (repairer *SegmentRepairer) newRedundancy(redundancy storj.RedundancyScheme
 	return redundancy
 }
 
+// restripeRedundancy returns the redundancy scheme the segment should be
+// re-encoded with, when re-striping is enabled and the placement of the
+// segment uses a different erasure coding than the segment.
+func (repairer *SegmentRepairer) restripeRedundancy(segment metabase.Segment) (storj.RedundancyScheme, bool) {
+	if !repairer.doRestripe {
+		return storj.RedundancyScheme{}, false
+	}
+
+	target, ok := checker.RestripeRedundancy(repairer.rsConfig, repairer.getPlacement(segment.Placement), segment.Redundancy)
+	if !ok {
+		return storj.RedundancyScheme{}, false
+	}
+	return repairer.newRedundancy(target), true
+}
+
 // SetNow allows tests to have the server act as if the current time is whatever they want.
 func (repairer *SegmentRepairer) SetNow(nowFn func() time.Time) {
 	repairer.nowFn = nowFn
@@ -6,12 +6,14 @@ package repairer_test
 import (
 	"context"
 	"fmt"
+	"math"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest"
 
 	"storj.io/common/identity/testidentity"
 	"storj.io/common/memory"
@@ -24,8 +26,10 @@ import (
 	"storj.io/storj/satellite"
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
 	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/repair/checker"
 	"storj.io/storj/satellite/repair/queue"
 	"storj.io/storj/satellite/repair/repairer"
 	"storj.io/storj/shared/location"
@@ -748,3 +752,267 @@ func TestSegmentRepairPlacementRestrictions(t *testing.T) {
 
 	})
 }
+
+func TestSegmentRepairPieceMove(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 8, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				testplanet.ReconfigureRS(2, 3, 4, 4),
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.DoDeclumping = false
+					config.Repairer.PieceMoveRepair = true
+				},
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		// disable pinging the Satellite so we can control storagenode status.
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "testbucket"))
+
+		_, err := satellite.API.Buckets.Service.UpdateBucket(ctx, buckets.Bucket{
+			ProjectID: planet.Uplinks[0].Projects[0].ID,
+			Name:      "testbucket",
+			Placement: storj.EU,
+		})
+		require.NoError(t, err)
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, node.ID(), location.Poland.String()))
+		}
+		require.NoError(t, satellite.Repairer.Overlay.DownloadSelectionCache.Refresh(ctx))
+
+		expectedData := testrand.Bytes(5 * memory.KiB)
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "object", expectedData))
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Len(t, segments[0].Pieces, 4)
+
+		// a single piece out of placement brings the segment to the repair threshold
+		outOfPlacement := segments[0].Pieces[0]
+		require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, outOfPlacement.StorageNode, "US"))
+		require.NoError(t, satellite.Repairer.Overlay.DownloadSelectionCache.Refresh(ctx))
+
+		// the segment must not be reconstructed
+		satellite.Repairer.SegmentRepairer.OnTestingPiecesReportHook = func(repairer.FetchResultReport) {
+			t.Error("segment was downloaded for repair")
+		}
+		defer func() { satellite.Repairer.SegmentRepairer.OnTestingPiecesReportHook = nil }()
+
+		shouldDelete, err := satellite.Repairer.SegmentRepairer.Repair(ctx, queue.InjuredSegment{
+			StreamID: segments[0].StreamID,
+			Position: segments[0].Position,
+		})
+		require.NoError(t, err)
+		require.True(t, shouldDelete)
+
+		repaired, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, repaired, 1)
+		require.NotNil(t, repaired[0].RepairedAt)
+		require.Len(t, repaired[0].Pieces, 4)
+
+		// the piece keeps its number, but is stored on another node now
+		moved, found := repaired[0].Pieces.FindByNum(int(outOfPlacement.Number))
+		require.True(t, found)
+		require.NotEqual(t, outOfPlacement.StorageNode, moved.StorageNode)
+		for _, piece := range segments[0].Pieces[1:] {
+			require.Contains(t, repaired[0].Pieces, piece)
+		}
+
+		placement, err := satellite.Config.Placement.Parse(satellite.Config.Overlay.Node.CreateDefaultPlacement, nil)
+		require.NoError(t, err)
+		ok, err := allPiecesInPlacement(ctx, satellite.Overlay.Service, repaired[0].Pieces, repaired[0].Placement, placement.CreateFilters)
+		require.NoError(t, err)
+		require.True(t, ok)
+
+		// download only from the moved piece and one other piece
+		for _, node := range planet.StorageNodes {
+			if node.ID() != moved.StorageNode && node.ID() != segments[0].Pieces[1].StorageNode {
+				require.NoError(t, planet.StopNodeAndUpdate(ctx, node))
+			}
+		}
+		require.NoError(t, satellite.API.Overlay.Service.DownloadSelectionCache.Refresh(ctx))
+
+		data, err := planet.Uplinks[0].Download(ctx, satellite, "testbucket", "object")
+		require.NoError(t, err)
+		require.Equal(t, expectedData, data)
+	})
+}
+
+func TestSegmentRepairPieceMoveNotEnough(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 8, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.Combine(
+				testplanet.ReconfigureRS(3, 4, 5, 5),
+				func(log *zap.Logger, index int, config *satellite.Config) {
+					config.Repairer.DoDeclumping = false
+					config.Repairer.PieceMoveRepair = true
+				},
+			),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+
+		// disable pinging the Satellite so we can control storagenode status.
+		for _, node := range planet.StorageNodes {
+			node.Contact.Chore.Pause(ctx)
+		}
+
+		require.NoError(t, planet.Uplinks[0].CreateBucket(ctx, satellite, "testbucket"))
+
+		_, err := satellite.API.Buckets.Service.UpdateBucket(ctx, buckets.Bucket{
+			ProjectID: planet.Uplinks[0].Projects[0].ID,
+			Name:      "testbucket",
+			Placement: storj.EU,
+		})
+		require.NoError(t, err)
+
+		for _, node := range planet.StorageNodes {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, node.ID(), location.Poland.String()))
+		}
+		require.NoError(t, satellite.Repairer.Overlay.DownloadSelectionCache.Refresh(ctx))
+
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "object", testrand.Bytes(5*memory.KiB)))
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.Len(t, segments[0].Pieces, 5)
+
+		// two pieces out of placement have to be moved to bring the segment
+		// back to the success threshold.
+		for _, piece := range segments[0].Pieces[:2] {
+			require.NoError(t, satellite.Overlay.Service.TestNodeCountryCode(ctx, piece.StorageNode, "US"))
+		}
+		require.NoError(t, satellite.Repairer.Overlay.DownloadSelectionCache.Refresh(ctx))
+		require.NoError(t, satellite.Repairer.Overlay.UploadSelectionCache.Refresh(ctx))
+
+		// the uploads to two of the three remaining nodes fail, so at most one piece is moved.
+		holders := map[storj.NodeID]bool{}
+		for _, piece := range segments[0].Pieces {
+			holders[piece.StorageNode] = true
+		}
+		var stopped int
+		for _, node := range planet.StorageNodes {
+			if !holders[node.ID()] && stopped < 2 {
+				require.NoError(t, planet.StopPeer(node))
+				stopped++
+			}
+		}
+
+		shouldDelete, err := satellite.Repairer.SegmentRepairer.Repair(ctx, queue.InjuredSegment{
+			StreamID: segments[0].StreamID,
+			Position: segments[0].Position,
+		})
+		require.Error(t, err)
+		require.False(t, shouldDelete)
+
+		// the segment is unchanged and stays in the queue.
+		repaired, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, repaired, 1)
+		require.Nil(t, repaired[0].RepairedAt)
+		require.Equal(t, segments[0].Pieces, repaired[0].Pieces)
+	})
+}
+
+func TestSegmentRepairRestripe(t *testing.T) {
+	testplanet.Run(t, testplanet.Config{
+		SatelliteCount: 1, StorageNodeCount: 8, UplinkCount: 1,
+		Reconfigure: testplanet.Reconfigure{
+			Satellite: testplanet.ReconfigureRS(2, 3, 4, 4),
+		},
+	}, func(t *testing.T, ctx *testcontext.Context, planet *testplanet.Planet) {
+		satellite := planet.Satellites[0]
+		repairQueue := satellite.DB.RepairQueue()
+
+		expectedData := testrand.Bytes(5 * memory.KiB)
+		require.NoError(t, planet.Uplinks[0].Upload(ctx, satellite, "testbucket", "object", expectedData))
+
+		segments, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, segments, 1)
+		require.EqualValues(t, 2, segments[0].Redundancy.RequiredShares)
+
+		placement, err := satellite.Config.Placement.Parse(satellite.Config.Overlay.Node.CreateDefaultPlacement, nil)
+		require.NoError(t, err)
+
+		// the placement now uses a new redundancy scheme
+		rsConfig := satellite.Config.Metainfo.RS
+		rsConfig.Min, rsConfig.Repair, rsConfig.Success, rsConfig.Total = 3, 4, 6, 6
+
+		checkerConfig := satellite.Config.Checker
+		checkerConfig.Restripe = true
+
+		runChecker := func() {
+			observer := checker.NewObserver(zaptest.NewLogger(t), repairQueue, satellite.Overlay.Service, placement, rsConfig, checkerConfig)
+			ranges := rangedloop.NewMetabaseRangeSplitter(zaptest.NewLogger(t), satellite.Metabase.DB, rangedloop.Config{
+				BatchSize: 100,
+			})
+			loop := rangedloop.NewService(zaptest.NewLogger(t), satellite.Config.RangedLoop, ranges, []rangedloop.Observer{observer})
+			_, err := loop.RunOnce(ctx)
+			require.NoError(t, err)
+		}
+
+		// the segment is healthy, but the checker queues it with the lowest priority
+		runChecker()
+
+		queued, err := repairQueue.SelectN(ctx, 10)
+		require.NoError(t, err)
+		require.Len(t, queued, 1)
+		require.Equal(t, segments[0].StreamID, queued[0].StreamID)
+		require.Equal(t, math.MaxFloat64, queued[0].SegmentHealth)
+		require.Nil(t, queued[0].Deadline)
+
+		config := satellite.Config.Repairer
+		config.Restripe = true
+
+		segmentRepairer := repairer.NewSegmentRepairer(
+			zaptest.NewLogger(t),
+			satellite.Metabase.DB,
+			satellite.Repairer.Orders.Service,
+			satellite.Repairer.Overlay,
+			satellite.Repairer.Audit.Reporter,
+			satellite.Repairer.EcRepairer,
+			placement,
+			satellite.Config.Checker.RepairThresholdOverrides,
+			satellite.Config.Checker.RepairTargetOverrides,
+			rsConfig,
+			config,
+		)
+
+		shouldDelete, err := segmentRepairer.Repair(ctx, queued[0])
+		require.NoError(t, err)
+		require.True(t, shouldDelete)
+		require.NoError(t, repairQueue.Delete(ctx, queued[0]))
+
+		repaired, err := satellite.Metabase.DB.TestingAllSegments(ctx)
+		require.NoError(t, err)
+		require.Len(t, repaired, 1)
+		require.NotNil(t, repaired[0].RepairedAt)
+		require.EqualValues(t, 3, repaired[0].Redundancy.RequiredShares)
+		require.EqualValues(t, 6, repaired[0].Redundancy.TotalShares)
+		require.Equal(t, segments[0].Redundancy.ShareSize, repaired[0].Redundancy.ShareSize)
+		require.Len(t, repaired[0].Pieces, 6)
+
+		// a segment with the new redundancy scheme is not queued again
+		runChecker()
+
+		count, err := repairQueue.Count(ctx)
+		require.NoError(t, err)
+		require.Zero(t, count)
+
+		data, err := planet.Uplinks[0].Download(ctx, satellite, "testbucket", "object")
+		require.NoError(t, err)
+		require.Equal(t, expectedData, data)
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package simulator
+
+import (
+	"math"
+	"time"
+)
+
+// ChurnModel decides how likely nodes leave the network.
+type ChurnModel interface {
+	// FailureProbability returns the probability of a node with the given age
+	// to leave the network within the next step.
+	FailureProbability(age, step time.Duration) float64
+}
+
+// ConstantChurn is a churn model where every node has the same daily failure rate.
+type ConstantChurn struct {
+	// DailyFailureRate is the probability of a node to leave the network on any given day.
+	DailyFailureRate float64
+}
+
+// FailureProbability implements ChurnModel.
+func (churn ConstantChurn) FailureProbability(age, step time.Duration) float64 {
+	return failureProbability(churn.DailyFailureRate, step)
+}
+
+// AgeChurn is a churn model where young nodes are more likely to leave the
+// network than nodes which are already around for a while.
+type AgeChurn struct {
+	// NewNodeDailyFailureRate is the daily failure rate of nodes younger than Maturity.
+	NewNodeDailyFailureRate float64
+	// DailyFailureRate is the daily failure rate of mature nodes.
+	DailyFailureRate float64
+	// Maturity is the age from which nodes are considered mature.
+	Maturity time.Duration
+}
+
+// FailureProbability implements ChurnModel.
+func (churn AgeChurn) FailureProbability(age, step time.Duration) float64 {
+	if age < churn.Maturity {
+		return failureProbability(churn.NewNodeDailyFailureRate, step)
+	}
+	return failureProbability(churn.DailyFailureRate, step)
+}
+
+// failureProbability converts a daily failure rate to the probability of
+// failing within step.
+func failureProbability(dailyFailureRate float64, step time.Duration) float64 {
+	if dailyFailureRate <= 0 {
+		return 0
+	}
+	if dailyFailureRate >= 1 {
+		return 1
+	}
+	days := step.Hours() / 24
+	return 1 - math.Pow(1-dailyFailureRate, days)
+}
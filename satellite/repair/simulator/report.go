@@ -0,0 +1,39 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package simulator
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"storj.io/common/memory"
+)
+
+// WriteReport writes the results of the scenarios as a table. Bandwidth is
+// reported per day of the simulated duration.
+func WriteReport(w io.Writer, duration time.Duration, results []Result) error {
+	days := duration.Hours() / 24
+	if days <= 0 {
+		return Error.New("invalid duration %v", duration)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "SCENARIO\tSEGMENTS\tREPAIRED/DAY\tDOWNLOAD/DAY\tUPLOAD/DAY\tAVG QUEUE\tMAX QUEUE\tLOST\tLOSS PROBABILITY")
+	for _, result := range results {
+		_, _ = fmt.Fprintf(tw, "%s\t%d\t%.1f\t%s\t%s\t%.1f\t%d\t%.2f\t%.3g\n",
+			result.Scenario,
+			result.Segments,
+			result.RepairedSegments/days,
+			memory.Size(result.DownloadedBytes/days).String(),
+			memory.Size(result.UploadedBytes/days).String(),
+			result.AvgQueueLength,
+			result.MaxQueueLength,
+			result.LostSegments,
+			result.LossProbability,
+		)
+	}
+	return Error.Wrap(tw.Flush())
+}
@@ -0,0 +1,353 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+// Package simulator predicts the cost of repair with different repair
+// thresholds, by simulating node churn over a set of segments.
+package simulator
+
+import (
+	"context"
+	"encoding/binary"
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/spacemonkeygo/monkit/v3"
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/repair"
+	"storj.io/storj/satellite/repair/checker"
+)
+
+var (
+	// Error is the error class for this package.
+	Error = errs.Class("repair simulator")
+
+	mon = monkit.Package()
+)
+
+// Config contains the parameters of a simulation.
+type Config struct {
+	// Duration is the simulated time span.
+	Duration time.Duration
+	// Step is the time between two checker runs.
+	Step time.Duration
+	// Runs is the number of simulations per scenario. The results are averaged.
+	Runs int
+	// RepairCapacity is the maximum number of segments repaired per step. 0 means unlimited.
+	RepairCapacity int
+	// TotalNodes is the number of nodes in the network. Only the nodes holding
+	// pieces are known from the segments, the rest is added to the simulation.
+	TotalNodes int
+	// InitialNodeAge is the age of the nodes at the beginning of the simulation.
+	InitialNodeAge time.Duration
+	// NodeFailureRate is used to calculate the segment health, like in the checker.
+	NodeFailureRate float64
+	// Seed is the seed of the random number generator. Every scenario uses
+	// the same seeds, so scenarios are compared with the same churn.
+	Seed int64
+}
+
+// Scenario is a set of repair thresholds to simulate.
+type Scenario struct {
+	Name                     string
+	RepairThresholdOverrides checker.RepairThresholdOverrides
+	RepairTargetOverrides    checker.RepairTargetOverrides
+}
+
+// Result contains the predicted repair cost of a scenario, averaged over all runs.
+type Result struct {
+	Scenario string
+	Segments int
+
+	RepairedSegments float64
+	DownloadedBytes  float64
+	UploadedBytes    float64
+
+	AvgQueueLength float64
+	MaxQueueLength int
+
+	LostSegments float64
+	// LossProbability is the probability of a segment to be lost within the simulated duration.
+	LossProbability float64
+}
+
+type piece struct {
+	number uint16
+	node   int32
+}
+
+type segment struct {
+	redundancy storj.RedundancyScheme
+	pieceSize  int64
+	pieces     []piece
+}
+
+// Simulator simulates the repair of segments under node churn.
+//
+// All nodes are considered online and healthy at the beginning of the
+// simulation, so the simulation starts with the segments as they are stored.
+type Simulator struct {
+	config   Config
+	churn    ChurnModel
+	nodes    map[storj.NodeID]int32
+	segments []segment
+}
+
+// New creates a new simulator.
+func New(config Config, churn ChurnModel) *Simulator {
+	return &Simulator{
+		config: config,
+		churn:  churn,
+		nodes:  map[storj.NodeID]int32{},
+	}
+}
+
+// Add adds a segment to the simulation. Inline and expired segments are ignored.
+func (sim *Simulator) Add(entry *rangedloop.Segment) {
+	if entry.Inline() || len(entry.Pieces) == 0 {
+		return
+	}
+	if entry.ExpiresAt != nil && entry.ExpiresAt.Before(time.Now()) {
+		return
+	}
+
+	seg := segment{
+		redundancy: entry.Redundancy,
+		pieceSize:  entry.Redundancy.PieceSize(int64(entry.EncryptedSize)),
+		pieces:     make([]piece, len(entry.Pieces)),
+	}
+	for i, p := range entry.Pieces {
+		node, ok := sim.nodes[p.StorageNode]
+		if !ok {
+			node = int32(len(sim.nodes))
+			sim.nodes[p.StorageNode] = node
+		}
+		seg.pieces[i] = piece{number: p.Number, node: node}
+	}
+	sim.segments = append(sim.segments, seg)
+}
+
+// Segments returns the number of segments in the simulation.
+func (sim *Simulator) Segments() int {
+	return len(sim.segments)
+}
+
+// Run simulates the scenario.
+func (sim *Simulator) Run(ctx context.Context, scenario Scenario) (result Result, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if sim.config.Step <= 0 || sim.config.Duration < sim.config.Step {
+		return Result{}, Error.New("invalid step %v for duration %v", sim.config.Step, sim.config.Duration)
+	}
+	runs := max(sim.config.Runs, 1)
+
+	result = Result{
+		Scenario: scenario.Name,
+		Segments: len(sim.segments),
+	}
+	for run := 0; run < runs; run++ {
+		if err := ctx.Err(); err != nil {
+			return Result{}, err
+		}
+
+		runResult, err := sim.run(ctx, scenario, rand.New(rand.NewSource(sim.config.Seed+int64(run))))
+		if err != nil {
+			return Result{}, err
+		}
+
+		result.RepairedSegments += runResult.RepairedSegments / float64(runs)
+		result.DownloadedBytes += runResult.DownloadedBytes / float64(runs)
+		result.UploadedBytes += runResult.UploadedBytes / float64(runs)
+		result.AvgQueueLength += runResult.AvgQueueLength / float64(runs)
+		result.LostSegments += runResult.LostSegments / float64(runs)
+		result.MaxQueueLength = max(result.MaxQueueLength, runResult.MaxQueueLength)
+	}
+	if result.Segments > 0 {
+		result.LossProbability = result.LostSegments / float64(result.Segments)
+	}
+	return result, nil
+}
+
+// queued is a segment waiting for repair.
+type queued struct {
+	index  int
+	health float64
+}
+
+// run does a single simulation of the scenario.
+func (sim *Simulator) run(ctx context.Context, scenario Scenario, rng *rand.Rand) (result Result, err error) {
+	net := newNetwork(max(sim.config.TotalNodes, len(sim.nodes)), sim.config.InitialNodeAge)
+
+	segments := make([]segment, len(sim.segments))
+	for i, seg := range sim.segments {
+		segments[i] = seg
+		segments[i].pieces = slices.Clone(seg.pieces)
+	}
+	lost := make([]bool, len(segments))
+
+	var queue []queued
+	var pieces metabase.Pieces
+	var nodes []nodeselection.SelectedNode
+
+	steps := int(sim.config.Duration / sim.config.Step)
+	for step := 0; step < steps; step++ {
+		if err := ctx.Err(); err != nil {
+			return Result{}, err
+		}
+
+		net.churn(sim.churn, sim.config.Step, rng)
+
+		// checker: find the segments which need repair, or which are lost.
+		queue = queue[:0]
+		for i := range segments {
+			if lost[i] {
+				continue
+			}
+			seg := &segments[i]
+
+			pieces, nodes = pieces[:0], nodes[:0]
+			for _, p := range seg.pieces {
+				id := nodeID(p.node)
+				pieces = append(pieces, metabase.Piece{Number: p.number, StorageNode: id})
+				nodes = append(nodes, nodeselection.SelectedNode{ID: id, Online: net.online[p.node]})
+			}
+			piecesCheck := repair.ClassifySegmentPieces(pieces, nodes, nil, false, false, nodeselection.Placement{})
+
+			required, repairThreshold, successThreshold, _ := checker.LoadRedundancy(seg.redundancy, scenario.RepairThresholdOverrides, scenario.RepairTargetOverrides)
+			if piecesCheck.Retrievable.Count() < required {
+				lost[i] = true
+				result.LostSegments++
+				continue
+			}
+
+			numHealthy := piecesCheck.Healthy.Count()
+			dueToHealth, dueToForcing := checker.NeedsRepair(numHealthy, piecesCheck.ForcingRepair.Count(), repairThreshold, successThreshold)
+			if !dueToHealth && !dueToForcing {
+				continue
+			}
+			queue = append(queue, queued{
+				index:  i,
+				health: repair.SegmentHealth(numHealthy, required, len(net.alive), sim.config.NodeFailureRate, piecesCheck.ForcingRepair.Count()),
+			})
+		}
+
+		result.AvgQueueLength += float64(len(queue)) / float64(steps)
+		result.MaxQueueLength = max(result.MaxQueueLength, len(queue))
+
+		// repairer: repair the least healthy segments first.
+		slices.SortStableFunc(queue, func(a, b queued) int {
+			switch {
+			case a.health < b.health:
+				return -1
+			case a.health > b.health:
+				return 1
+			default:
+				return 0
+			}
+		})
+		if sim.config.RepairCapacity > 0 && len(queue) > sim.config.RepairCapacity {
+			queue = queue[:sim.config.RepairCapacity]
+		}
+		for _, q := range queue {
+			downloaded, uploaded := sim.repair(&segments[q.index], scenario, net, rng)
+			result.RepairedSegments++
+			result.DownloadedBytes += float64(downloaded)
+			result.UploadedBytes += float64(uploaded)
+		}
+	}
+
+	return result, nil
+}
+
+// repair drops the pieces on failed nodes and uploads new pieces to random
+// nodes, until the segment reaches the success threshold.
+func (sim *Simulator) repair(seg *segment, scenario Scenario, net *network, rng *rand.Rand) (downloaded, uploaded int64) {
+	_, _, successThreshold, total := checker.LoadRedundancy(seg.redundancy, scenario.RepairThresholdOverrides, scenario.RepairTargetOverrides)
+
+	seg.pieces = slices.DeleteFunc(seg.pieces, func(p piece) bool {
+		return !net.online[p.node]
+	})
+	downloaded = int64(seg.redundancy.RequiredShares) * seg.pieceSize
+
+	used := make(map[uint16]bool, len(seg.pieces))
+	for _, p := range seg.pieces {
+		used[p.number] = true
+	}
+
+	var number uint16
+	for len(seg.pieces) < successThreshold && len(seg.pieces) < len(net.alive) {
+		for used[number] && int(number) < total {
+			number++
+		}
+		if int(number) >= total {
+			break
+		}
+
+		node := net.pick(rng, seg.pieces)
+		seg.pieces = append(seg.pieces, piece{number: number, node: node})
+		used[number] = true
+		uploaded += seg.pieceSize
+	}
+	return downloaded, uploaded
+}
+
+// nodeID returns the identity of a simulated node. Only the identity matters
+// for the classification of the pieces, not the real node ID.
+func nodeID(node int32) (id storj.NodeID) {
+	binary.BigEndian.PutUint32(id[:], uint32(node)+1)
+	return id
+}
+
+// network tracks the nodes of the simulation. Every failed node is replaced
+// by a new node, so the size of the network stays the same.
+type network struct {
+	online  []bool
+	joined  []time.Duration
+	alive   []int32
+	elapsed time.Duration
+}
+
+func newNetwork(size int, initialAge time.Duration) *network {
+	net := &network{
+		online: make([]bool, size),
+		joined: make([]time.Duration, size),
+		alive:  make([]int32, size),
+	}
+	for i := range net.alive {
+		net.online[i] = true
+		net.joined[i] = -initialAge
+		net.alive[i] = int32(i)
+	}
+	return net
+}
+
+// churn lets nodes fail according to the churn model.
+func (net *network) churn(churn ChurnModel, step time.Duration, rng *rand.Rand) {
+	for i, node := range net.alive {
+		if rng.Float64() >= churn.FailureProbability(net.elapsed-net.joined[node], step) {
+			continue
+		}
+		net.online[node] = false
+
+		replacement := int32(len(net.online))
+		net.online = append(net.online, true)
+		net.joined = append(net.joined, net.elapsed)
+		net.alive[i] = replacement
+	}
+	net.elapsed += step
+}
+
+// pick selects a random online node, which doesn't hold any of the pieces yet.
+func (net *network) pick(rng *rand.Rand, pieces []piece) int32 {
+	for {
+		node := net.alive[rng.Intn(len(net.alive))]
+		if !slices.ContainsFunc(pieces, func(p piece) bool { return p.node == node }) {
+			return node
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package simulator_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/memory"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/rangedloop"
+	"storj.io/storj/satellite/repair/simulator"
+)
+
+func TestChurnModels(t *testing.T) {
+	require.Zero(t, simulator.ConstantChurn{}.FailureProbability(0, 24*time.Hour))
+	require.Equal(t, 1.0, simulator.ConstantChurn{DailyFailureRate: 1}.FailureProbability(0, time.Hour))
+	require.InDelta(t, 0.1, simulator.ConstantChurn{DailyFailureRate: 0.1}.FailureProbability(0, 24*time.Hour), 1e-9)
+	require.InDelta(t, 0.19, simulator.ConstantChurn{DailyFailureRate: 0.1}.FailureProbability(0, 48*time.Hour), 1e-9)
+
+	churn := simulator.AgeChurn{NewNodeDailyFailureRate: 0.5, DailyFailureRate: 0.01, Maturity: 30 * 24 * time.Hour}
+	require.InDelta(t, 0.5, churn.FailureProbability(time.Hour, 24*time.Hour), 1e-9)
+	require.InDelta(t, 0.01, churn.FailureProbability(31*24*time.Hour, 24*time.Hour), 1e-9)
+}
+
+func TestSimulator(t *testing.T) {
+	ctx := testcontext.New(t)
+
+	redundancy := storj.RedundancyScheme{
+		Algorithm:      storj.ReedSolomon,
+		ShareSize:      256,
+		RequiredShares: 4,
+		RepairShares:   6,
+		OptimalShares:  8,
+		TotalShares:    10,
+	}
+
+	nodes := make([]storj.NodeID, 40)
+	for i := range nodes {
+		nodes[i] = testrand.NodeID()
+	}
+
+	addSegments := func(sim *simulator.Simulator) {
+		for i := 0; i < 200; i++ {
+			pieces := make(metabase.Pieces, redundancy.OptimalShares)
+			for n := range pieces {
+				pieces[n] = metabase.Piece{Number: uint16(n), StorageNode: nodes[(i+n)%len(nodes)]}
+			}
+			sim.Add(&rangedloop.Segment{
+				StreamID:      testrand.UUID(),
+				RootPieceID:   testrand.PieceID(),
+				EncryptedSize: int32(memory.KiB),
+				Redundancy:    redundancy,
+				Pieces:        pieces,
+			})
+		}
+		// inline segments are ignored
+		sim.Add(&rangedloop.Segment{StreamID: testrand.UUID()})
+	}
+
+	config := simulator.Config{
+		Duration:        30 * 24 * time.Hour,
+		Step:            24 * time.Hour,
+		Runs:            3,
+		TotalNodes:      100,
+		NodeFailureRate: 0.00005435,
+		Seed:            1,
+	}
+
+	t.Run("no churn", func(t *testing.T) {
+		sim := simulator.New(config, simulator.ConstantChurn{})
+		addSegments(sim)
+		require.Equal(t, 200, sim.Segments())
+
+		result, err := sim.Run(ctx, simulator.Scenario{Name: "default"})
+		require.NoError(t, err)
+		require.Equal(t, simulator.Result{Scenario: "default", Segments: 200}, result)
+	})
+
+	t.Run("all nodes fail", func(t *testing.T) {
+		sim := simulator.New(config, simulator.ConstantChurn{DailyFailureRate: 1})
+		addSegments(sim)
+
+		result, err := sim.Run(ctx, simulator.Scenario{Name: "default"})
+		require.NoError(t, err)
+		require.Equal(t, 200.0, result.LostSegments)
+		require.Equal(t, 1.0, result.LossProbability)
+		require.Zero(t, result.RepairedSegments)
+	})
+
+	t.Run("thresholds", func(t *testing.T) {
+		sim := simulator.New(config, simulator.ConstantChurn{DailyFailureRate: 0.05})
+		addSegments(sim)
+
+		var low, high simulator.Scenario
+		low.Name = "low"
+		require.NoError(t, low.RepairThresholdOverrides.Set("4-4"))
+		high.Name = "high"
+		require.NoError(t, high.RepairThresholdOverrides.Set("4-7"))
+		require.NoError(t, high.RepairTargetOverrides.Set("4-10"))
+
+		lowResult, err := sim.Run(ctx, low)
+		require.NoError(t, err)
+		highResult, err := sim.Run(ctx, high)
+		require.NoError(t, err)
+
+		require.Greater(t, lowResult.RepairedSegments, 0.0)
+		require.Greater(t, highResult.RepairedSegments, lowResult.RepairedSegments)
+		require.Greater(t, highResult.UploadedBytes, lowResult.UploadedBytes)
+		require.Less(t, highResult.LostSegments, lowResult.LostSegments)
+
+		var report bytes.Buffer
+		require.NoError(t, simulator.WriteReport(&report, config.Duration, []simulator.Result{lowResult, highResult}))
+		require.Contains(t, report.String(), "LOSS PROBABILITY")
+		require.Contains(t, report.String(), "high")
+	})
+
+	t.Run("repair capacity", func(t *testing.T) {
+		limited := config
+		limited.RepairCapacity = 1
+		sim := simulator.New(limited, simulator.ConstantChurn{DailyFailureRate: 0.05})
+		addSegments(sim)
+
+		result, err := sim.Run(ctx, simulator.Scenario{Name: "limited"})
+		require.NoError(t, err)
+		require.LessOrEqual(t, result.RepairedSegments, float64(config.Duration/config.Step))
+		require.Greater(t, result.MaxQueueLength, 1)
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		sim := simulator.New(simulator.Config{Duration: time.Hour}, simulator.ConstantChurn{})
+		_, err := sim.Run(ctx, simulator.Scenario{})
+		require.Error(t, err)
+	})
+}
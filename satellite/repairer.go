@@ -28,6 +28,7 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/metabase"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
 	"storj.io/storj/satellite/repair/queue"
@@ -57,6 +58,10 @@ type Repairer struct {
 		Server   *debug.Server
 	}
 
+	Placement struct {
+		Reloader *nodeselection.PlacementReloader
+	}
+
 	Overlay    *overlay.Service
 	Reputation *reputation.Service
 	Orders     struct {
@@ -80,6 +85,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	bucketsDB buckets.DB,
 	overlayCache overlay.DB,
 	nodeEvents nodeevents.DB,
+	placementConfigs nodeselection.PlacementConfigDB,
 	reputationdb reputation.DB,
 	containmentDB audit.Containment,
 	versionInfo version.Info, config *Config, atomicLogLevel *zap.AtomicLevel,
@@ -139,12 +145,18 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 		peer.Dialer.DialTimeout = config.Repairer.DialTimeout
 	}
 
-	{ // setup overlay
-		placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
+	{ // setup placement
+		var err error
+		peer.Placement.Reloader, err = nodeselection.LoadPlacementReloader(context.TODO(), log.Named("placement:reloader"), config.Placement, config.Overlay.Node.CreateDefaultPlacement, placementConfigs, config.PlacementReload, nil)
 		if err != nil {
-			return nil, err
+			return nil, errs.Combine(err, peer.Close())
 		}
+	}
+
+	{ // setup overlay
+		_, placement := peer.Placement.Reloader.Placements()
 
+		var err error
 		peer.Overlay, err = overlay.NewService(log.Named("overlay"), overlayCache, nodeEvents, placement, config.Console.ExternalAddress, config.Console.SatelliteName, config.Overlay)
 		if err != nil {
 			return nil, errs.Combine(err, peer.Close())
@@ -178,11 +190,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	}
 
 	{ // setup orders
-		placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
-		if err != nil {
-			return nil, err
-		}
-
+		var err error
 		peer.Orders.Service, err = orders.NewService(
 			log.Named("orders"),
 			signing.SignerFromFullIdentity(peer.Identity),
@@ -191,7 +199,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			// PUT and GET actions which are not used by
 			// repairer so we can set noop implementation.
 			orders.NewNoopDB(),
-			placement.CreateFilters,
+			peer.Placement.Reloader.CreateFilters,
 			config.Orders,
 		)
 		if err != nil {
@@ -211,10 +219,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 	}
 
 	{ // setup repairer
-		placement, err := config.Placement.Parse(config.Overlay.Node.CreateDefaultPlacement, nil)
-		if err != nil {
-			return nil, err
-		}
+		_, placement := peer.Placement.Reloader.Placements()
 
 		peer.EcRepairer = repairer.NewECRepairer(
 			peer.Dialer,
@@ -239,6 +244,7 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 			placement,
 			config.Checker.RepairThresholdOverrides,
 			config.Checker.RepairTargetOverrides,
+			config.Metainfo.RS,
 			config.Repairer,
 		)
 		peer.Repairer = repairer.NewService(log.Named("repairer"), repairQueue, &config.Repairer, peer.SegmentRepairer)
@@ -250,7 +256,19 @@ func NewRepairer(log *zap.Logger, full *identity.FullIdentity,
 		})
 		peer.Debug.Server.Panel.Add(
 			debug.Cycle("Repair Worker", peer.Repairer.Loop))
+	}
+
+	if peer.Placement.Reloader.Enabled() { // setup placement reloader
+		peer.Placement.Reloader.OnReload(peer.Overlay.SetPlacements)
+		peer.Placement.Reloader.OnReload(peer.SegmentRepairer.SetPlacements)
 
+		peer.Services.Add(lifecycle.Item{
+			Name:  "placement:reloader",
+			Run:   peer.Placement.Reloader.Run,
+			Close: peer.Placement.Reloader.Close,
+		})
+		peer.Debug.Server.Panel.Add(
+			debug.Cycle("Placement Reloader", peer.Placement.Reloader.Loop))
 	}
 
 	return peer, nil
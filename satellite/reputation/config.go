@@ -38,6 +38,7 @@ type Config struct {
 	ErrorRetryInterval    time.Duration `help:"the amount of time that should elapse before the cache retries failed database operations" releaseDefault:"1m" devDefault:"5s"`
 	InitialAlpha          float64       `help:"the value to which an alpha reputation value should be initialized" default:"1000"`
 	InitialBeta           float64       `help:"the value to which a beta reputation value should be initialized" default:"0"`
+	Model                 ModelFlag     `help:"the model used to calculate the audit reputation of the nodes from the audit results, beta or windowed" default:"beta"`
 }
 
 // UpdateRequest is used to update a node's reputation status.
@@ -0,0 +1,173 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import "math"
+
+// Reputation contains the audit reputation values of a node.
+type Reputation struct {
+	AuditAlpha        float64
+	AuditBeta         float64
+	UnknownAuditAlpha float64
+	UnknownAuditBeta  float64
+}
+
+// AuditScore returns the audit score, which is compared to Config.AuditDQ.
+func (rep Reputation) AuditScore() float64 {
+	return rep.AuditAlpha / (rep.AuditAlpha + rep.AuditBeta)
+}
+
+// UnknownAuditScore returns the unknown audit score, which is compared to Config.UnknownAuditDQ.
+func (rep Reputation) UnknownAuditScore() float64 {
+	return rep.UnknownAuditAlpha / (rep.UnknownAuditAlpha + rep.UnknownAuditBeta)
+}
+
+// ReputationModel calculates the audit reputation of the nodes from the audit results.
+type ReputationModel interface {
+	// Apply applies the successful, failed and unknown audit results of the
+	// updates to the reputation. Offline results don't affect the reputation.
+	Apply(rep Reputation, updates Mutations, config Config) Reputation
+}
+
+// GetReputationModel returns the reputation model with the given name. The
+// bool return value is false if the name is unknown.
+func GetReputationModel(name string) (ReputationModel, bool) {
+	switch name {
+	case "", "beta":
+		return BetaModel{}, true
+	case "windowed":
+		return WindowedModel{}, true
+	default:
+		return nil, false
+	}
+}
+
+// ModelFlag is a reputation model configured by its name. The name is
+// validated when the configuration is loaded.
+type ModelFlag struct {
+	name  string
+	model ReputationModel
+}
+
+// Set implements pflag.Value.
+func (flag *ModelFlag) Set(s string) error {
+	model, ok := GetReputationModel(s)
+	if !ok {
+		return Error.New("unknown reputation model %q", s)
+	}
+	flag.name, flag.model = s, model
+	return nil
+}
+
+// String implements pflag.Value.
+func (flag *ModelFlag) String() string {
+	return flag.name
+}
+
+// Type implements pflag.Value.
+func (flag *ModelFlag) Type() string {
+	return "reputation.ModelFlag"
+}
+
+// Get returns the configured reputation model, or the BetaModel if it's not
+// configured.
+func (flag ModelFlag) Get() ReputationModel {
+	if flag.model == nil {
+		return BetaModel{}
+	}
+	return flag.model
+}
+
+// BetaModel is the beta distribution based reputation model, where the old
+// results are forgotten with the lambda forgetting factors.
+//
+// Here we rely on the observation that, conceptually, if we have collected
+// some list of successes and failures while auditing a node during some short
+// time period, it might reasonably have happened that the events occurred in a
+// different order. To be as fair as possible, the failures are always applied
+// _before_ the successes. That ordering will always yield the highest possible
+// result alpha and the lowest possible result beta, assuming weight > 0 and
+// 0 < λ < 1.
+type BetaModel struct{}
+
+// Apply implements ReputationModel.
+func (BetaModel) Apply(rep Reputation, updates Mutations, config Config) Reputation {
+	// for audit failure, only update normal alpha/beta
+	rep.AuditBeta, rep.AuditAlpha = UpdateReputationMultiple(
+		updates.FailureResults,
+		rep.AuditBeta,
+		rep.AuditAlpha,
+		config.AuditLambda,
+		config.AuditWeight,
+	)
+	// for audit unknown, only update unknown alpha/beta
+	rep.UnknownAuditBeta, rep.UnknownAuditAlpha = UpdateReputationMultiple(
+		updates.UnknownResults,
+		rep.UnknownAuditBeta,
+		rep.UnknownAuditAlpha,
+		config.UnknownAuditLambda,
+		config.AuditWeight,
+	)
+
+	// for a successful audit, increase reputation for normal *and* unknown audits
+	rep.AuditAlpha, rep.AuditBeta = UpdateReputationMultiple(
+		updates.PositiveResults,
+		rep.AuditAlpha,
+		rep.AuditBeta,
+		config.AuditLambda,
+		config.AuditWeight,
+	)
+	rep.UnknownAuditAlpha, rep.UnknownAuditBeta = UpdateReputationMultiple(
+		updates.PositiveResults,
+		rep.UnknownAuditAlpha,
+		rep.UnknownAuditBeta,
+		config.UnknownAuditLambda,
+		config.AuditWeight,
+	)
+	return rep
+}
+
+// WindowedModel counts the (weighted) successful and failed audits in a sliding
+// window, alpha being the successes and beta being the failures. The window
+// is as long as the steady state total of the BetaModel (w / (1-λ)), and when
+// it is full, the old results are evicted proportionally. Unlike the BetaModel,
+// old failures are not forgotten until newer results push them out of the
+// window. Failures are applied before the successes, like in the BetaModel.
+type WindowedModel struct{}
+
+// Apply implements ReputationModel.
+func (WindowedModel) Apply(rep Reputation, updates Mutations, config Config) Reputation {
+	auditWindow := windowSize(config.AuditLambda, config.AuditWeight)
+	unknownAuditWindow := windowSize(config.UnknownAuditLambda, config.AuditWeight)
+
+	rep.AuditBeta, rep.AuditAlpha = addToWindow(updates.FailureResults, rep.AuditBeta, rep.AuditAlpha, config.AuditWeight, auditWindow)
+	rep.UnknownAuditBeta, rep.UnknownAuditAlpha = addToWindow(updates.UnknownResults, rep.UnknownAuditBeta, rep.UnknownAuditAlpha, config.AuditWeight, unknownAuditWindow)
+
+	rep.AuditAlpha, rep.AuditBeta = addToWindow(updates.PositiveResults, rep.AuditAlpha, rep.AuditBeta, config.AuditWeight, auditWindow)
+	rep.UnknownAuditAlpha, rep.UnknownAuditBeta = addToWindow(updates.PositiveResults, rep.UnknownAuditAlpha, rep.UnknownAuditBeta, config.AuditWeight, unknownAuditWindow)
+	return rep
+}
+
+// windowSize returns the size of the window equivalent to the forgetting factor.
+func windowSize(lambda, w float64) float64 {
+	if lambda >= 1 {
+		return math.Inf(1)
+	}
+	return w / (1 - lambda)
+}
+
+// addToWindow adds count results to the counter, and evicts the old results
+// from both counters proportionally, if the window is full.
+func addToWindow(count int, counter, other, w, window float64) (newCounter, newOther float64) {
+	if count <= 0 {
+		return counter, other
+	}
+	counter += w * float64(count)
+	if total := counter + other; total > window {
+		ratio := window / total
+		counter *= ratio
+		other *= ratio
+	}
+	return counter, other
+}
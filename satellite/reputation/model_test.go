@@ -0,0 +1,95 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/satellite/reputation"
+)
+
+func TestGetReputationModel(t *testing.T) {
+	for _, name := range []string{"", "beta", "windowed"} {
+		model, ok := reputation.GetReputationModel(name)
+		require.True(t, ok, name)
+		require.NotNil(t, model, name)
+	}
+
+	_, ok := reputation.GetReputationModel("unknown")
+	require.False(t, ok)
+
+	// the model is the beta model, if it's not configured
+	require.Equal(t, reputation.BetaModel{}, reputation.Config{}.Model.Get())
+
+	var flag reputation.ModelFlag
+	require.Error(t, flag.Set("unknown"))
+	require.NoError(t, flag.Set("windowed"))
+	require.Equal(t, "windowed", flag.String())
+	require.Equal(t, reputation.WindowedModel{}, flag.Get())
+}
+
+func TestBetaModel(t *testing.T) {
+	config := reputation.Config{
+		AuditLambda:        0.99,
+		UnknownAuditLambda: 0.95,
+		AuditWeight:        1,
+	}
+	rep := reputation.Reputation{AuditAlpha: 100, AuditBeta: 1, UnknownAuditAlpha: 20, UnknownAuditBeta: 1}
+
+	got := reputation.BetaModel{}.Apply(rep, reputation.Mutations{
+		PositiveResults: 3,
+		FailureResults:  2,
+		UnknownResults:  1,
+		OfflineResults:  5,
+	}, config)
+
+	// failures are applied before the successes
+	beta, alpha := reputation.UpdateReputationMultiple(2, 1, 100, 0.99, 1)
+	alpha, beta = reputation.UpdateReputationMultiple(3, alpha, beta, 0.99, 1)
+	unknownBeta, unknownAlpha := reputation.UpdateReputationMultiple(1, 1, 20, 0.95, 1)
+	unknownAlpha, unknownBeta = reputation.UpdateReputationMultiple(3, unknownAlpha, unknownBeta, 0.95, 1)
+
+	require.Equal(t, reputation.Reputation{
+		AuditAlpha:        alpha,
+		AuditBeta:         beta,
+		UnknownAuditAlpha: unknownAlpha,
+		UnknownAuditBeta:  unknownBeta,
+	}, got)
+}
+
+func TestWindowedModel(t *testing.T) {
+	config := reputation.Config{
+		AuditLambda:        0.99, // window of 100 audits
+		UnknownAuditLambda: 0.9,  // window of 10 audits
+		AuditWeight:        1,
+	}
+	model := reputation.WindowedModel{}
+
+	rep := model.Apply(reputation.Reputation{}, reputation.Mutations{PositiveResults: 50}, config)
+	require.InDelta(t, 50, rep.AuditAlpha, 1e-9)
+	require.InDelta(t, 10, rep.UnknownAuditAlpha, 1e-9)
+	require.InDelta(t, 1, rep.AuditScore(), 1e-9)
+
+	// the window is full, old results are evicted proportionally
+	rep = model.Apply(rep, reputation.Mutations{PositiveResults: 48, FailureResults: 4, UnknownResults: 5}, config)
+	require.InDelta(t, 100, rep.AuditAlpha+rep.AuditBeta, 1e-9)
+	require.InDelta(t, 10, rep.UnknownAuditAlpha+rep.UnknownAuditBeta, 1e-9)
+	require.InDelta(t, 0.96, rep.AuditScore(), 1e-2)
+	require.Less(t, rep.AuditScore(), 0.97)
+
+	// the failures are pushed out of the window by the successes
+	for i := 0; i < 100; i++ {
+		rep = model.Apply(rep, reputation.Mutations{PositiveResults: 10}, config)
+	}
+	require.Greater(t, rep.AuditScore(), 0.999)
+	require.Greater(t, rep.UnknownAuditScore(), 0.999)
+
+	// without forgetting, the window is unlimited
+	config.AuditLambda = 1
+	rep = model.Apply(reputation.Reputation{AuditAlpha: 1000}, reputation.Mutations{FailureResults: 10}, config)
+	require.InDelta(t, 1000, rep.AuditAlpha, 1e-9)
+	require.InDelta(t, 10, rep.AuditBeta, 1e-9)
+}
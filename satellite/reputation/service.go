@@ -125,6 +125,13 @@ func (service *Service) ApplyAudit(ctx context.Context, nodeID storj.NodeID, rep
 	}
 
 	now := time.Now()
+
+	// Nodes are not penalized for being offline during their planned maintenance window.
+	if result == AuditOffline && reputation.Maintenance != nil && reputation.Maintenance.Active(now) {
+		mon.Event("audit_offline_during_maintenance")
+		return nil
+	}
+
 	statusUpdate, err := service.db.Update(ctx, UpdateRequest{
 		NodeID:       nodeID,
 		AuditOutcome: result,
@@ -0,0 +1,111 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reputation
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/satellite/overlay"
+)
+
+// UpdateStatus applies the disqualification and suspension rules to the node,
+// after its reputation and online score were updated at now. It returns true
+// if the node got newly disqualified for failing audits.
+func (info *Info) UpdateStatus(logger *zap.Logger, config Config, trackingPeriodFull bool, now time.Time) (newlyDisqualified bool) {
+	// update audit score
+	newAuditScore := info.AuditReputationAlpha / (info.AuditReputationAlpha + info.AuditReputationBeta)
+	// disqualification case a
+	//   a) Success/fail audit reputation falls below audit DQ threshold
+	if newAuditScore <= config.AuditDQ {
+		if info.Disqualified == nil {
+			info.Disqualified = &now
+			info.DisqualificationReason = overlay.DisqualificationReasonAuditFailure
+			logger.Info("Disqualified", zap.String("dq-type", "audit failure"))
+			newlyDisqualified = true
+		}
+	}
+
+	// check unknown-audits score
+	unknownAuditRep := info.UnknownAuditReputationAlpha / (info.UnknownAuditReputationAlpha + info.UnknownAuditReputationBeta)
+	if unknownAuditRep <= config.UnknownAuditDQ {
+		if info.UnknownAuditSuspended == nil {
+			logger.Info("Suspended", zap.String("category", "unknown-result audits"))
+			info.UnknownAuditSuspended = &now
+		}
+
+		// disqualification case b
+		//   b) Node is suspended (success/unknown reputation below audit DQ threshold)
+		//        AND the suspended grace period has elapsed
+		//        AND audit outcome is unknown or failed
+
+		// if suspended grace period has elapsed and unknown audit rep is still
+		// too low, disqualify node. Set suspended to nil if node is disqualified
+		if info.UnknownAuditSuspended != nil &&
+			now.Sub(*info.UnknownAuditSuspended) > config.SuspensionGracePeriod &&
+			config.SuspensionDQEnabled {
+			logger.Info("Disqualified", zap.String("dq-type", "suspension grace period expired for unknown-result audits"))
+			info.Disqualified = &now
+			info.DisqualificationReason = overlay.DisqualificationReasonSuspension
+			info.UnknownAuditSuspended = nil
+		}
+	} else if info.UnknownAuditSuspended != nil {
+		logger.Info("Suspension lifted", zap.String("category", "unknown-result audits"))
+		info.UnknownAuditSuspended = nil
+	}
+
+	// if suspension not enabled, skip penalization and unsuspend node if applicable
+	if !config.AuditHistory.OfflineSuspensionEnabled {
+		if info.OfflineSuspended != nil {
+			info.OfflineSuspended = nil
+		}
+		if info.UnderReview != nil {
+			info.UnderReview = nil
+		}
+		return newlyDisqualified
+	}
+
+	// only penalize node if online score is below threshold and
+	// if it has enough completed windows to fill a tracking period
+	penalizeOfflineNode := false
+	if info.OnlineScore < config.AuditHistory.OfflineThreshold && trackingPeriodFull {
+		penalizeOfflineNode = true
+	}
+
+	// Suspension and disqualification for offline nodes
+	if info.UnderReview != nil {
+		// move node in and out of suspension as needed during review period
+		if !penalizeOfflineNode && info.OfflineSuspended != nil {
+			info.OfflineSuspended = nil
+		} else if penalizeOfflineNode && info.OfflineSuspended == nil {
+			info.OfflineSuspended = &now
+		}
+
+		gracePeriodEnd := info.UnderReview.Add(config.AuditHistory.GracePeriod)
+		trackingPeriodEnd := gracePeriodEnd.Add(config.AuditHistory.TrackingPeriod)
+		trackingPeriodPassed := now.After(trackingPeriodEnd)
+
+		// after tracking period has elapsed, if score is good, clear under review
+		// otherwise, disqualify node (if OfflineDQEnabled feature flag is true)
+		if trackingPeriodPassed {
+			if penalizeOfflineNode {
+				if config.AuditHistory.OfflineDQEnabled {
+					logger.Info("Disqualified", zap.String("dq-type", "node offline"))
+					info.Disqualified = &now
+					info.DisqualificationReason = overlay.DisqualificationReasonNodeOffline
+				}
+			} else {
+				logger.Info("Suspension lifted", zap.String("category", "node offline"))
+				info.UnderReview = nil
+				info.OfflineSuspended = nil
+			}
+		}
+	} else if penalizeOfflineNode {
+		// suspend node for being offline and begin review period
+		info.UnderReview = &now
+		info.OfflineSuspended = &now
+	}
+	return newlyDisqualified
+}
@@ -193,38 +193,14 @@ func (cdb *CachingDB) ApplyUpdates(ctx context.Context, nodeID storj.NodeID, upd
 			doRequestSync = true
 		}
 
-		// for audit failure, only update normal alpha/beta
-		cachedInfo.AuditReputationBeta, cachedInfo.AuditReputationAlpha = UpdateReputationMultiple(
-			updates.FailureResults,
-			cachedInfo.AuditReputationBeta,
-			cachedInfo.AuditReputationAlpha,
-			config.AuditLambda,
-			config.AuditWeight,
-		)
-		// for audit unknown, only update unknown alpha/beta
-		cachedInfo.UnknownAuditReputationBeta, cachedInfo.UnknownAuditReputationAlpha = UpdateReputationMultiple(
-			updates.UnknownResults,
-			cachedInfo.UnknownAuditReputationBeta,
-			cachedInfo.UnknownAuditReputationAlpha,
-			config.UnknownAuditLambda,
-			config.AuditWeight,
-		)
-
-		// for a successful audit, increase reputation for normal *and* unknown audits
-		cachedInfo.AuditReputationAlpha, cachedInfo.AuditReputationBeta = UpdateReputationMultiple(
-			updates.PositiveResults,
-			cachedInfo.AuditReputationAlpha,
-			cachedInfo.AuditReputationBeta,
-			config.AuditLambda,
-			config.AuditWeight,
-		)
-		cachedInfo.UnknownAuditReputationAlpha, cachedInfo.UnknownAuditReputationBeta = UpdateReputationMultiple(
-			updates.PositiveResults,
-			cachedInfo.UnknownAuditReputationAlpha,
-			cachedInfo.UnknownAuditReputationBeta,
-			config.UnknownAuditLambda,
-			config.AuditWeight,
-		)
+		rep := config.Model.Get().Apply(Reputation{
+			AuditAlpha:        cachedInfo.AuditReputationAlpha,
+			AuditBeta:         cachedInfo.AuditReputationBeta,
+			UnknownAuditAlpha: cachedInfo.UnknownAuditReputationAlpha,
+			UnknownAuditBeta:  cachedInfo.UnknownAuditReputationBeta,
+		}, updates, config)
+		cachedInfo.AuditReputationAlpha, cachedInfo.AuditReputationBeta = rep.AuditAlpha, rep.AuditBeta
+		cachedInfo.UnknownAuditReputationAlpha, cachedInfo.UnknownAuditReputationBeta = rep.UnknownAuditAlpha, rep.UnknownAuditBeta
 
 		mon.FloatVal("cached_audit_reputation_alpha").Observe(cachedInfo.AuditReputationAlpha)
 		mon.FloatVal("cached_audit_reputation_beta").Observe(cachedInfo.AuditReputationBeta)
@@ -239,101 +215,11 @@ func (cdb *CachingDB) ApplyUpdates(ctx context.Context, nodeID storj.NodeID, upd
 		// from what is in the backing store. If that happens, the cache
 		// will get synced back to the source of truth the next time
 		// this node is synchronized.
-
-		// update audit score
-		newAuditScore := cachedInfo.AuditReputationAlpha / (cachedInfo.AuditReputationAlpha + cachedInfo.AuditReputationBeta)
-		// disqualification case a
-		//   a) Success/fail audit reputation falls below audit DQ threshold
-		if newAuditScore <= config.AuditDQ {
-			if cachedInfo.Disqualified == nil {
-				cachedInfo.Disqualified = &now
-				cachedInfo.DisqualificationReason = overlay.DisqualificationReasonAuditFailure
-				logger.Info("Disqualified", zap.String("dq-type", "audit failure"))
-				// if we think the node is newly disqualified, perform a sync
-				// to have the best chance of propagating that information to
-				// other satellite services.
-				doRequestSync = true
-			}
-		}
-
-		// check unknown-audits score
-		unknownAuditRep := cachedInfo.UnknownAuditReputationAlpha / (cachedInfo.UnknownAuditReputationAlpha + cachedInfo.UnknownAuditReputationBeta)
-		if unknownAuditRep <= config.UnknownAuditDQ {
-			if cachedInfo.UnknownAuditSuspended == nil {
-				logger.Info("Suspended", zap.String("category", "unknown-result audits"))
-				cachedInfo.UnknownAuditSuspended = &now
-			}
-
-			// disqualification case b
-			//   b) Node is suspended (success/unknown reputation below audit DQ threshold)
-			//        AND the suspended grace period has elapsed
-			//        AND audit outcome is unknown or failed
-
-			// if suspended grace period has elapsed and unknown audit rep is still
-			// too low, disqualify node. Set suspended to nil if node is disqualified
-			if cachedInfo.UnknownAuditSuspended != nil &&
-				now.Sub(*cachedInfo.UnknownAuditSuspended) > config.SuspensionGracePeriod &&
-				config.SuspensionDQEnabled {
-				logger.Info("Disqualified", zap.String("dq-type", "suspension grace period expired for unknown-result audits"))
-				cachedInfo.Disqualified = &now
-				cachedInfo.DisqualificationReason = overlay.DisqualificationReasonSuspension
-				cachedInfo.UnknownAuditSuspended = nil
-			}
-		} else if cachedInfo.UnknownAuditSuspended != nil {
-			logger.Info("Suspension lifted", zap.String("category", "unknown-result audits"))
-			cachedInfo.UnknownAuditSuspended = nil
-		}
-
-		// if suspension not enabled, skip penalization and unsuspend node if applicable
-		if !config.AuditHistory.OfflineSuspensionEnabled {
-			if cachedInfo.OfflineSuspended != nil {
-				cachedInfo.OfflineSuspended = nil
-			}
-			if cachedInfo.UnderReview != nil {
-				cachedInfo.UnderReview = nil
-			}
-			return
-		}
-
-		// only penalize node if online score is below threshold and
-		// if it has enough completed windows to fill a tracking period
-		penalizeOfflineNode := false
-		if cachedInfo.OnlineScore < config.AuditHistory.OfflineThreshold && trackingPeriodFull {
-			penalizeOfflineNode = true
-		}
-
-		// Suspension and disqualification for offline nodes
-		if cachedInfo.UnderReview != nil {
-			// move node in and out of suspension as needed during review period
-			if !penalizeOfflineNode && cachedInfo.OfflineSuspended != nil {
-				cachedInfo.OfflineSuspended = nil
-			} else if penalizeOfflineNode && cachedInfo.OfflineSuspended == nil {
-				cachedInfo.OfflineSuspended = &now
-			}
-
-			gracePeriodEnd := cachedInfo.UnderReview.Add(config.AuditHistory.GracePeriod)
-			trackingPeriodEnd := gracePeriodEnd.Add(config.AuditHistory.TrackingPeriod)
-			trackingPeriodPassed := now.After(trackingPeriodEnd)
-
-			// after tracking period has elapsed, if score is good, clear under review
-			// otherwise, disqualify node (if OfflineDQEnabled feature flag is true)
-			if trackingPeriodPassed {
-				if penalizeOfflineNode {
-					if config.AuditHistory.OfflineDQEnabled {
-						logger.Info("Disqualified", zap.String("dq-type", "node offline"))
-						cachedInfo.Disqualified = &now
-						cachedInfo.DisqualificationReason = overlay.DisqualificationReasonNodeOffline
-					}
-				} else {
-					logger.Info("Suspension lifted", zap.String("category", "node offline"))
-					cachedInfo.UnderReview = nil
-					cachedInfo.OfflineSuspended = nil
-				}
-			}
-		} else if penalizeOfflineNode {
-			// suspend node for being offline and begin review period
-			cachedInfo.UnderReview = &now
-			cachedInfo.OfflineSuspended = &now
+		if cachedInfo.UpdateStatus(logger, config, trackingPeriodFull, now) {
+			// if we think the node is newly disqualified, perform a sync
+			// to have the best chance of propagating that information to
+			// other satellite services.
+			doRequestSync = true
 		}
 	})
 
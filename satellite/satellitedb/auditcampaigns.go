@@ -0,0 +1,340 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/storj"
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ audit.CampaignDB = (*auditCampaigns)(nil)
+
+type auditCampaigns struct {
+	db *satelliteDB
+}
+
+// Create stores a new campaign.
+func (campaigns *auditCampaigns) Create(ctx context.Context, campaign audit.Campaign) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch campaigns.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		query = `
+			INSERT INTO audit_campaigns (id, description, nodes, segments, queued, status, error, created_at, queued_at)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		`
+	case dbutil.Spanner:
+		query = `
+			INSERT INTO audit_campaigns (id, description, nodes, segments, queued, status, error, created_at, queued_at)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+		`
+	default:
+		return errs.New("unsupported database dialect: %s", campaigns.db.impl)
+	}
+
+	_, err = campaigns.db.ExecContext(ctx, query,
+		campaign.ID, campaign.Description, encodeNodeIDs(campaign.Nodes), campaign.Segments, campaign.Queued,
+		string(campaign.Status), campaign.Error, campaign.CreatedAt, campaign.QueuedAt)
+	return Error.Wrap(err)
+}
+
+// Get returns the campaign with the given ID.
+func (campaigns *auditCampaigns) Get(ctx context.Context, id uuid.UUID) (_ audit.Campaign, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch campaigns.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		query = `
+			SELECT id, description, nodes, segments, queued, status, error, created_at, queued_at
+			FROM audit_campaigns
+			WHERE id = $1
+		`
+	case dbutil.Spanner:
+		query = `
+			SELECT id, description, nodes, segments, queued, status, error, created_at, queued_at
+			FROM audit_campaigns
+			WHERE id = ?
+		`
+	default:
+		return audit.Campaign{}, errs.New("unsupported database dialect: %s", campaigns.db.impl)
+	}
+
+	campaign, err := scanAuditCampaign(campaigns.db.QueryRowContext(ctx, query, id))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return audit.Campaign{}, audit.ErrCampaignNotFound.New("%s", id)
+		}
+		return audit.Campaign{}, Error.Wrap(err)
+	}
+	return campaign, nil
+}
+
+// List returns all campaigns, the most recent first.
+func (campaigns *auditCampaigns) List(ctx context.Context) (list []audit.Campaign, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(campaigns.db.QueryContext(ctx, `
+		SELECT id, description, nodes, segments, queued, status, error, created_at, queued_at
+		FROM audit_campaigns
+		ORDER BY created_at DESC
+	`))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			campaign, err := scanAuditCampaign(rows)
+			if err != nil {
+				return err
+			}
+			list = append(list, campaign)
+		}
+		return nil
+	})
+	return list, Error.Wrap(err)
+}
+
+// Update stores the number of queued segments, the status, the error and the
+// queue time of the campaign.
+func (campaigns *auditCampaigns) Update(ctx context.Context, campaign audit.Campaign) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch campaigns.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		query = `
+			UPDATE audit_campaigns
+			SET queued = $1, status = $2, error = $3, queued_at = $4
+			WHERE id = $5
+		`
+	case dbutil.Spanner:
+		query = `
+			UPDATE audit_campaigns
+			SET queued = ?, status = ?, error = ?, queued_at = ?
+			WHERE id = ?
+		`
+	default:
+		return errs.New("unsupported database dialect: %s", campaigns.db.impl)
+	}
+
+	result, err := campaigns.db.ExecContext(ctx, query,
+		campaign.Queued, string(campaign.Status), campaign.Error, campaign.QueuedAt, campaign.ID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if affected == 0 {
+		return audit.ErrCampaignNotFound.New("%s", campaign.ID)
+	}
+	return nil
+}
+
+// AddPieces stores the pieces of the targeted nodes in the segments queued by
+// the campaign.
+func (campaigns *auditCampaigns) AddPieces(ctx context.Context, campaignID uuid.UUID, pieces []audit.CampaignPiece) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(pieces) == 0 {
+		return nil
+	}
+
+	switch campaigns.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		streamIDs := make([]uuid.UUID, len(pieces))
+		positions := make([]int64, len(pieces))
+		nodeIDs := make([]storj.NodeID, len(pieces))
+		for i, piece := range pieces {
+			streamIDs[i] = piece.StreamID
+			positions[i] = int64(piece.Position.Encode())
+			nodeIDs[i] = piece.NodeID
+		}
+
+		_, err = campaigns.db.ExecContext(ctx, `
+			INSERT INTO audit_campaign_pieces (stream_id, position, node_id, campaign_id)
+			SELECT unnest($1::bytea[]), unnest($2::int8[]), unnest($3::bytea[]), $4
+			ON CONFLICT DO NOTHING
+		`, pgutil.UUIDArray(streamIDs), pgutil.Int8Array(positions), pgutil.NodeIDArray(nodeIDs), campaignID)
+
+	case dbutil.Spanner:
+		type campaignPiece struct {
+			StreamID []byte
+			Position int64
+			NodeID   []byte
+		}
+
+		rows := make([]campaignPiece, len(pieces))
+		for i, piece := range pieces {
+			rows[i] = campaignPiece{
+				StreamID: piece.StreamID.Bytes(),
+				Position: int64(piece.Position.Encode()),
+				NodeID:   piece.NodeID.Bytes(),
+			}
+		}
+
+		_, err = campaigns.db.ExecContext(ctx, `
+			INSERT OR IGNORE INTO audit_campaign_pieces (stream_id, position, node_id, campaign_id)
+			(SELECT StreamID, Position, NodeID, ? FROM UNNEST(?))
+		`, campaignID.Bytes(), rows)
+
+	default:
+		return errs.New("unsupported database dialect: %s", campaigns.db.impl)
+	}
+	return Error.Wrap(err)
+}
+
+// RecordOutcomes stores the outcomes of an audit of the segment for the
+// pieces of all campaigns, which weren't audited yet. The pieces of the nodes
+// missing from outcomes get the other outcome.
+func (campaigns *auditCampaigns) RecordOutcomes(ctx context.Context, streamID uuid.UUID, position metabase.SegmentPosition, outcomes map[storj.NodeID]audit.Outcome, other audit.Outcome) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodesByOutcome := map[audit.Outcome][]storj.NodeID{}
+	for node, outcome := range outcomes {
+		nodesByOutcome[outcome] = append(nodesByOutcome[outcome], node)
+	}
+
+	var updateNodes, updateOthers string
+	switch campaigns.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		updateNodes = `
+			UPDATE audit_campaign_pieces
+			SET outcome = $1, audited_at = now()
+			WHERE stream_id = $2 AND position = $3 AND node_id = ANY($4::bytea[]) AND outcome IS NULL
+		`
+		updateOthers = `
+			UPDATE audit_campaign_pieces
+			SET outcome = $1, audited_at = now()
+			WHERE stream_id = $2 AND position = $3 AND outcome IS NULL
+		`
+	case dbutil.Spanner:
+		updateNodes = `
+			UPDATE audit_campaign_pieces
+			SET outcome = ?, audited_at = CURRENT_TIMESTAMP()
+			WHERE stream_id = ? AND position = ? AND node_id IN UNNEST(?) AND outcome IS NULL
+		`
+		updateOthers = `
+			UPDATE audit_campaign_pieces
+			SET outcome = ?, audited_at = CURRENT_TIMESTAMP()
+			WHERE stream_id = ? AND position = ? AND outcome IS NULL
+		`
+	default:
+		return errs.New("unsupported database dialect: %s", campaigns.db.impl)
+	}
+
+	// the outcomes of the reported nodes are stored before the other outcome.
+	for _, outcome := range slices.Sorted(maps.Keys(nodesByOutcome)) {
+		var nodes any = pgutil.NodeIDArray(nodesByOutcome[outcome])
+		if campaigns.db.impl == dbutil.Spanner {
+			nodes = storj.NodeIDList(nodesByOutcome[outcome]).Bytes()
+		}
+		_, err = campaigns.db.ExecContext(ctx, updateNodes, int64(outcome), streamID, int64(position.Encode()), nodes)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+
+	_, err = campaigns.db.ExecContext(ctx, updateOthers, int64(other), streamID, int64(position.Encode()))
+	return Error.Wrap(err)
+}
+
+// CountPieces returns the number of pieces of the campaign per node and outcome.
+func (campaigns *auditCampaigns) CountPieces(ctx context.Context, campaignID uuid.UUID) (counts []audit.CampaignPieceCount, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var query string
+	switch campaigns.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		query = `
+			SELECT node_id, outcome, count(*)
+			FROM audit_campaign_pieces
+			WHERE campaign_id = $1
+			GROUP BY node_id, outcome
+		`
+	case dbutil.Spanner:
+		query = `
+			SELECT node_id, outcome, count(*)
+			FROM audit_campaign_pieces
+			WHERE campaign_id = ?
+			GROUP BY node_id, outcome
+		`
+	default:
+		return nil, errs.New("unsupported database dialect: %s", campaigns.db.impl)
+	}
+
+	err = withRows(campaigns.db.QueryContext(ctx, query, campaignID))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var count audit.CampaignPieceCount
+			var outcome sql.NullInt64
+			if err := rows.Scan(&count.NodeID, &outcome, &count.Count); err != nil {
+				return err
+			}
+			count.Audited = outcome.Valid
+			count.Outcome = audit.Outcome(outcome.Int64)
+			counts = append(counts, count)
+		}
+		return nil
+	})
+	return counts, Error.Wrap(err)
+}
+
+// scanAuditCampaign scans a row of audit_campaigns.
+func scanAuditCampaign(row interface{ Scan(dest ...any) error }) (campaign audit.Campaign, err error) {
+	var nodes []byte
+	var status string
+	var queuedAt *time.Time
+	err = row.Scan(&campaign.ID, &campaign.Description, &nodes, &campaign.Segments, &campaign.Queued,
+		&status, &campaign.Error, &campaign.CreatedAt, &queuedAt)
+	if err != nil {
+		return audit.Campaign{}, err
+	}
+	campaign.Nodes, err = decodeNodeIDs(nodes)
+	if err != nil {
+		return audit.Campaign{}, err
+	}
+	campaign.Status = audit.CampaignStatus(status)
+	campaign.QueuedAt = queuedAt
+	return campaign, nil
+}
+
+// encodeNodeIDs concatenates the node IDs.
+func encodeNodeIDs(nodes []storj.NodeID) []byte {
+	encoded := make([]byte, 0, len(nodes)*len(storj.NodeID{}))
+	for _, node := range nodes {
+		encoded = append(encoded, node.Bytes()...)
+	}
+	return encoded
+}
+
+// decodeNodeIDs splits concatenated node IDs.
+func decodeNodeIDs(encoded []byte) ([]storj.NodeID, error) {
+	size := len(storj.NodeID{})
+	if len(encoded)%size != 0 {
+		return nil, errs.New("invalid node ID list length: %d", len(encoded))
+	}
+	nodes := make([]storj.NodeID, 0, len(encoded)/size)
+	for len(encoded) > 0 {
+		node, err := storj.NodeIDFromBytes(encoded[:size])
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+		encoded = encoded[size:]
+	}
+	return nodes, nil
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/audit"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestAuditCampaigns(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		campaigns := db.AuditCampaigns()
+
+		_, err := campaigns.Get(ctx, testrand.UUID())
+		require.True(t, audit.ErrCampaignNotFound.Has(err))
+
+		nodeA, nodeB := testrand.NodeID(), testrand.NodeID()
+		campaign := audit.Campaign{
+			ID:          testrand.UUID(),
+			Description: "suspicious nodes",
+			Nodes:       []storj.NodeID{nodeA, nodeB},
+			Segments:    2,
+			Status:      audit.CampaignPending,
+			CreatedAt:   time.Now().Truncate(time.Second),
+		}
+		require.NoError(t, campaigns.Create(ctx, campaign))
+
+		queuedAt := campaign.CreatedAt.Add(time.Minute)
+		campaign.Queued = 2
+		campaign.Status = audit.CampaignQueued
+		campaign.QueuedAt = &queuedAt
+		require.NoError(t, campaigns.Update(ctx, campaign))
+
+		stored, err := campaigns.Get(ctx, campaign.ID)
+		require.NoError(t, err)
+		require.Equal(t, campaign.Nodes, stored.Nodes)
+		require.Equal(t, 2, stored.Queued)
+		require.Equal(t, audit.CampaignQueued, stored.Status)
+		require.WithinDuration(t, campaign.CreatedAt, stored.CreatedAt, time.Second)
+		require.NotNil(t, stored.QueuedAt)
+		require.WithinDuration(t, queuedAt, *stored.QueuedAt, time.Second)
+
+		list, err := campaigns.List(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		require.Equal(t, campaign.ID, list[0].ID)
+
+		first := audit.CampaignPiece{StreamID: testrand.UUID(), Position: metabase.SegmentPosition{Index: 1}}
+		second := audit.CampaignPiece{StreamID: testrand.UUID()}
+		pieces := []audit.CampaignPiece{first, first, second}
+		pieces[0].NodeID, pieces[1].NodeID, pieces[2].NodeID = nodeA, nodeB, nodeA
+		require.NoError(t, campaigns.AddPieces(ctx, campaign.ID, pieces))
+		// adding the same pieces again is ignored.
+		require.NoError(t, campaigns.AddPieces(ctx, campaign.ID, pieces[:1]))
+
+		// nodeB isn't reported, so its piece gets the other outcome.
+		require.NoError(t, campaigns.RecordOutcomes(ctx, first.StreamID, first.Position,
+			map[storj.NodeID]audit.Outcome{nodeA: audit.OutcomeFailure}, audit.OutcomeNotPerformed))
+		// audited pieces keep their first outcome.
+		require.NoError(t, campaigns.RecordOutcomes(ctx, first.StreamID, first.Position,
+			map[storj.NodeID]audit.Outcome{nodeA: audit.OutcomeSuccess}, audit.OutcomeNotPerformed))
+
+		counts, err := campaigns.CountPieces(ctx, campaign.ID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []audit.CampaignPieceCount{
+			{NodeID: nodeA, Audited: true, Outcome: audit.OutcomeFailure, Count: 1},
+			{NodeID: nodeA, Audited: false, Count: 1},
+			{NodeID: nodeB, Audited: true, Outcome: audit.OutcomeNotPerformed, Count: 1},
+		}, counts)
+	})
+}
@@ -85,7 +85,7 @@ func (keys *apikeys) GetPagedByProjectID(ctx context.Context, projectID uuid.UUI
 	}
 
 	repoundQuery := keys.db.Rebind(`
-		SELECT ak.id, ak.project_id, ak.name, ak.user_agent, ak.created_at, ak.version, p.public_id
+		SELECT ak.id, ak.project_id, ak.name, ak.head, ak.user_agent, ak.created_at, ak.version, p.public_id
 		FROM api_keys ak, projects p
 		WHERE ak.project_id = ?
 		AND ak.project_id = p.id
@@ -109,7 +109,7 @@ func (keys *apikeys) GetPagedByProjectID(ctx context.Context, projectID uuid.UUI
 	for rows.Next() {
 		ak := console.APIKeyInfo{}
 
-		err = rows.Scan(&ak.ID, &ak.ProjectID, &ak.Name, &ak.UserAgent, &ak.CreatedAt, &ak.Version, &ak.ProjectPublicID)
+		err = rows.Scan(&ak.ID, &ak.ProjectID, &ak.Name, &ak.Head, &ak.UserAgent, &ak.CreatedAt, &ak.Version, &ak.ProjectPublicID)
 		if err != nil {
 			return nil, err
 		}
@@ -19,8 +19,12 @@ import (
 	"storj.io/storj/satellite/buckets"
 	"storj.io/storj/satellite/compensation"
 	"storj.io/storj/satellite/console"
+	"storj.io/storj/satellite/durability"
+	"storj.io/storj/satellite/metabase/inventory"
 	"storj.io/storj/satellite/nodeapiversion"
 	"storj.io/storj/satellite/nodeevents"
+	"storj.io/storj/satellite/nodehistory"
+	"storj.io/storj/satellite/nodeselection"
 	"storj.io/storj/satellite/oidc"
 	"storj.io/storj/satellite/orders"
 	"storj.io/storj/satellite/overlay"
@@ -202,6 +206,21 @@ func (dbc *satelliteDBCollection) NodeEvents() nodeevents.DB {
 	return &nodeEvents{db: dbc.getByName("nodeevents")}
 }
 
+// NodeHistory is a getter for node history repository.
+func (dbc *satelliteDBCollection) NodeHistory() nodehistory.DB {
+	return &nodeHistory{db: dbc.getByName("nodehistory")}
+}
+
+// DurabilityRisks is a getter for durability risk report repository.
+func (dbc *satelliteDBCollection) DurabilityRisks() durability.DB {
+	return &durabilityRisks{db: dbc.getByName("durabilityrisks")}
+}
+
+// InventoryReports is a getter for bucket inventory reports repository.
+func (dbc *satelliteDBCollection) InventoryReports() inventory.DB {
+	return &inventoryReports{db: dbc.getByName("inventoryreports")}
+}
+
 // Reputation is a getter for overlay cache repository.
 func (dbc *satelliteDBCollection) Reputation() reputation.DB {
 	return &reputations{db: dbc.getByName("reputations")}
@@ -217,6 +236,16 @@ func (dbc *satelliteDBCollection) VerifyQueue() audit.VerifyQueue {
 	return &verifyQueue{db: dbc.getByName("verifyqueue")}
 }
 
+// AuditCampaigns is a getter for audit campaigns repository.
+func (dbc *satelliteDBCollection) AuditCampaigns() audit.CampaignDB {
+	return &auditCampaigns{db: dbc.getByName("auditcampaigns")}
+}
+
+// PlacementConfigs is a getter for the stored placement configurations.
+func (dbc *satelliteDBCollection) PlacementConfigs() nodeselection.PlacementConfigDB {
+	return &placementConfigs{db: dbc.getByName("placementconfigs")}
+}
+
 // ReverifyQueue is a getter for ReverifyQueue database.
 func (dbc *satelliteDBCollection) ReverifyQueue() audit.ReverifyQueue {
 	return &reverifyQueue{db: dbc.getByName("reverifyqueue")}
@@ -385,6 +385,29 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE audit_campaigns (
+	id bytea NOT NULL,
+	description text NOT NULL,
+	nodes bytea NOT NULL,
+	segments integer NOT NULL,
+	queued integer NOT NULL DEFAULT 0,
+	status text NOT NULL,
+	error text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	queued_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE audit_campaign_pieces (
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	node_id bytea NOT NULL,
+	campaign_id bytea NOT NULL,
+	outcome integer,
+	audited_at timestamp with time zone,
+	PRIMARY KEY ( stream_id, position, node_id, campaign_id )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -431,6 +454,13 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 )`,
 
+		`CREATE TABLE bucket_inventory_reports (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	generated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -459,6 +489,16 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( id )
 )`,
 
+		`CREATE TABLE durability_risks (
+	class text NOT NULL,
+	class_value text NOT NULL,
+	node_count integer NOT NULL,
+	segments_below_repair bigint NOT NULL,
+	segments_irreparable bigint NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( class, class_value )
+)`,
+
 		`CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -525,6 +565,8 @@ func (obj *pgxDB) Schema() []string {
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	maintenance_start timestamp with time zone,
+	maintenance_end timestamp with time zone,
 	PRIMARY KEY ( id )
 )`,
 
@@ -548,6 +590,24 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( id )
 )`,
 
+		`CREATE TABLE node_history (
+	node_id bytea NOT NULL,
+	recorded_at timestamp with time zone NOT NULL,
+	kind integer NOT NULL,
+	address text NOT NULL,
+	version text NOT NULL,
+	free_disk bigint NOT NULL,
+	audit_score double precision NOT NULL,
+	unknown_audit_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	vetted boolean NOT NULL,
+	suspended boolean NOT NULL,
+	exiting boolean NOT NULL,
+	disqualified boolean NOT NULL,
+	details text NOT NULL,
+	PRIMARY KEY ( node_id, recorded_at, kind )
+)`,
+
 		`CREATE TABLE node_tags (
 	node_id bytea NOT NULL,
 	name text NOT NULL,
@@ -600,6 +660,13 @@ func (obj *pgxDB) Schema() []string {
 	PRIMARY KEY ( node_id )
 )`,
 
+		`CREATE TABLE placement_configurations (
+	version text NOT NULL,
+	config bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( version )
+)`,
+
 		`CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -663,6 +730,7 @@ func (obj *pgxDB) Schema() []string {
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
 	placement integer,
+	deadline timestamp with time zone,
 	PRIMARY KEY ( stream_id, position )
 )`,
 
@@ -924,6 +992,7 @@ func (obj *pgxDB) Schema() []string {
 	position bigint NOT NULL,
 	expires_at timestamp with time zone,
 	encrypted_size integer NOT NULL,
+	priority integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( inserted_at, stream_id, position )
 )`,
 
@@ -1003,6 +1072,8 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX audit_campaign_pieces_campaign_id_index ON audit_campaign_pieces ( campaign_id )`,
+
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -1021,6 +1092,8 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL`,
 
+		`CREATE INDEX node_history_recorded_at_index ON node_history ( recorded_at )`,
+
 		`CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id )`,
 
 		`CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id )`,
@@ -1067,6 +1140,8 @@ func (obj *pgxDB) Schema() []string {
 
 		`CREATE INDEX users_external_id_index ON users ( external_id ) WHERE users.external_id is not NULL`,
 
+		`CREATE INDEX verification_audits_priority_index ON verification_audits ( priority )`,
+
 		`CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id )`,
 
 		`CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id )`,
@@ -1140,6 +1215,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS projects`,
 
+		`DROP TABLE IF EXISTS placement_configurations`,
+
 		`DROP TABLE IF EXISTS peer_identities`,
 
 		`DROP TABLE IF EXISTS oauth_tokens`,
@@ -1150,6 +1227,8 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS node_tags`,
 
+		`DROP TABLE IF EXISTS node_history`,
+
 		`DROP TABLE IF EXISTS node_events`,
 
 		`DROP TABLE IF EXISTS node_api_versions`,
@@ -1160,10 +1239,14 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS graceful_exit_progress`,
 
+		`DROP TABLE IF EXISTS durability_risks`,
+
 		`DROP TABLE IF EXISTS coinpayments_transactions`,
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`DROP TABLE IF EXISTS bucket_inventory_reports`,
+
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollup_archives`,
 
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollups`,
@@ -1172,6 +1255,10 @@ func (obj *pgxDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS audit_campaign_pieces`,
+
+		`DROP TABLE IF EXISTS audit_campaigns`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -1308,6 +1395,29 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( name )
 )`,
 
+		`CREATE TABLE audit_campaigns (
+	id bytea NOT NULL,
+	description text NOT NULL,
+	nodes bytea NOT NULL,
+	segments integer NOT NULL,
+	queued integer NOT NULL DEFAULT 0,
+	status text NOT NULL,
+	error text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	queued_at timestamp with time zone,
+	PRIMARY KEY ( id )
+)`,
+
+		`CREATE TABLE audit_campaign_pieces (
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	node_id bytea NOT NULL,
+	campaign_id bytea NOT NULL,
+	outcome integer,
+	audited_at timestamp with time zone,
+	PRIMARY KEY ( stream_id, position, node_id, campaign_id )
+)`,
+
 		`CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -1354,6 +1464,13 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 )`,
 
+		`CREATE TABLE bucket_inventory_reports (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	generated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+)`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -1382,6 +1499,16 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( id )
 )`,
 
+		`CREATE TABLE durability_risks (
+	class text NOT NULL,
+	class_value text NOT NULL,
+	node_count integer NOT NULL,
+	segments_below_repair bigint NOT NULL,
+	segments_irreparable bigint NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( class, class_value )
+)`,
+
 		`CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -1448,6 +1575,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	maintenance_start timestamp with time zone,
+	maintenance_end timestamp with time zone,
 	PRIMARY KEY ( id )
 )`,
 
@@ -1471,6 +1600,24 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( id )
 )`,
 
+		`CREATE TABLE node_history (
+	node_id bytea NOT NULL,
+	recorded_at timestamp with time zone NOT NULL,
+	kind integer NOT NULL,
+	address text NOT NULL,
+	version text NOT NULL,
+	free_disk bigint NOT NULL,
+	audit_score double precision NOT NULL,
+	unknown_audit_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	vetted boolean NOT NULL,
+	suspended boolean NOT NULL,
+	exiting boolean NOT NULL,
+	disqualified boolean NOT NULL,
+	details text NOT NULL,
+	PRIMARY KEY ( node_id, recorded_at, kind )
+)`,
+
 		`CREATE TABLE node_tags (
 	node_id bytea NOT NULL,
 	name text NOT NULL,
@@ -1523,6 +1670,13 @@ func (obj *pgxcockroachDB) Schema() []string {
 	PRIMARY KEY ( node_id )
 )`,
 
+		`CREATE TABLE placement_configurations (
+	version text NOT NULL,
+	config bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( version )
+)`,
+
 		`CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -1586,6 +1740,7 @@ func (obj *pgxcockroachDB) Schema() []string {
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
 	placement integer,
+	deadline timestamp with time zone,
 	PRIMARY KEY ( stream_id, position )
 )`,
 
@@ -1847,6 +2002,7 @@ func (obj *pgxcockroachDB) Schema() []string {
 	position bigint NOT NULL,
 	expires_at timestamp with time zone,
 	encrypted_size integer NOT NULL,
+	priority integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( inserted_at, stream_id, position )
 )`,
 
@@ -1926,6 +2082,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX audit_campaign_pieces_campaign_id_index ON audit_campaign_pieces ( campaign_id )`,
+
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -1944,6 +2102,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL`,
 
+		`CREATE INDEX node_history_recorded_at_index ON node_history ( recorded_at )`,
+
 		`CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id )`,
 
 		`CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id )`,
@@ -1990,6 +2150,8 @@ func (obj *pgxcockroachDB) Schema() []string {
 
 		`CREATE INDEX users_external_id_index ON users ( external_id ) WHERE users.external_id is not NULL`,
 
+		`CREATE INDEX verification_audits_priority_index ON verification_audits ( priority )`,
+
 		`CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id )`,
 
 		`CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id )`,
@@ -2063,6 +2225,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS projects`,
 
+		`DROP TABLE IF EXISTS placement_configurations`,
+
 		`DROP TABLE IF EXISTS peer_identities`,
 
 		`DROP TABLE IF EXISTS oauth_tokens`,
@@ -2073,6 +2237,8 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS node_tags`,
 
+		`DROP TABLE IF EXISTS node_history`,
+
 		`DROP TABLE IF EXISTS node_events`,
 
 		`DROP TABLE IF EXISTS node_api_versions`,
@@ -2083,10 +2249,14 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS graceful_exit_progress`,
 
+		`DROP TABLE IF EXISTS durability_risks`,
+
 		`DROP TABLE IF EXISTS coinpayments_transactions`,
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`DROP TABLE IF EXISTS bucket_inventory_reports`,
+
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollup_archives`,
 
 		`DROP TABLE IF EXISTS bucket_bandwidth_rollups`,
@@ -2095,6 +2265,10 @@ func (obj *pgxcockroachDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`DROP TABLE IF EXISTS audit_campaign_pieces`,
+
+		`DROP TABLE IF EXISTS audit_campaigns`,
+
 		`DROP TABLE IF EXISTS accounting_timestamps`,
 
 		`DROP TABLE IF EXISTS accounting_rollups`,
@@ -2228,6 +2402,27 @@ func (obj *spannerDB) Schema() []string {
 	value TIMESTAMP NOT NULL
 ) PRIMARY KEY ( name )`,
 
+		`CREATE TABLE audit_campaigns (
+	id BYTES(MAX) NOT NULL,
+	description STRING(MAX) NOT NULL,
+	nodes BYTES(MAX) NOT NULL,
+	segments INT64 NOT NULL,
+	queued INT64 NOT NULL DEFAULT (0),
+	status STRING(MAX) NOT NULL,
+	error STRING(MAX) NOT NULL DEFAULT (""),
+	created_at TIMESTAMP NOT NULL,
+	queued_at TIMESTAMP
+) PRIMARY KEY ( id )`,
+
+		`CREATE TABLE audit_campaign_pieces (
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	node_id BYTES(MAX) NOT NULL,
+	campaign_id BYTES(MAX) NOT NULL,
+	outcome INT64,
+	audited_at TIMESTAMP
+) PRIMARY KEY ( stream_id, position, node_id, campaign_id )`,
+
 		`CREATE TABLE billing_balances (
 	user_id BYTES(MAX) NOT NULL,
 	balance INT64 NOT NULL,
@@ -2272,6 +2467,12 @@ func (obj *spannerDB) Schema() []string {
 	settled INT64 NOT NULL
 ) PRIMARY KEY ( bucket_name, project_id, interval_start, action )`,
 
+		`CREATE TABLE bucket_inventory_reports (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	generated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name )`,
+
 		`CREATE TABLE bucket_storage_tallies (
 	bucket_name BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -2298,6 +2499,15 @@ func (obj *spannerDB) Schema() []string {
 	created_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( id )`,
 
+		`CREATE TABLE durability_risks (
+	class STRING(MAX) NOT NULL,
+	class_value STRING(MAX) NOT NULL,
+	node_count INT64 NOT NULL,
+	segments_below_repair INT64 NOT NULL,
+	segments_irreparable INT64 NOT NULL,
+	reported_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( class, class_value )`,
+
 		`CREATE TABLE graceful_exit_progress (
 	node_id BYTES(MAX) NOT NULL,
 	bytes_transferred INT64 NOT NULL,
@@ -2361,7 +2571,9 @@ func (obj *spannerDB) Schema() []string {
 	noise_proto INT64,
 	noise_public_key BYTES(MAX),
 	debounce_limit INT64 NOT NULL DEFAULT (0),
-	features INT64 NOT NULL DEFAULT (0)
+	features INT64 NOT NULL DEFAULT (0),
+	maintenance_start TIMESTAMP,
+	maintenance_end TIMESTAMP
 ) PRIMARY KEY ( id )`,
 
 		`CREATE TABLE node_api_versions (
@@ -2382,6 +2594,23 @@ func (obj *spannerDB) Schema() []string {
 	email_sent TIMESTAMP
 ) PRIMARY KEY ( id )`,
 
+		`CREATE TABLE node_history (
+	node_id BYTES(MAX) NOT NULL,
+	recorded_at TIMESTAMP NOT NULL,
+	kind INT64 NOT NULL,
+	address STRING(MAX) NOT NULL,
+	version STRING(MAX) NOT NULL,
+	free_disk INT64 NOT NULL,
+	audit_score FLOAT64 NOT NULL,
+	unknown_audit_score FLOAT64 NOT NULL,
+	online_score FLOAT64 NOT NULL,
+	vetted BOOL NOT NULL,
+	suspended BOOL NOT NULL,
+	exiting BOOL NOT NULL,
+	disqualified BOOL NOT NULL,
+	details STRING(MAX) NOT NULL
+) PRIMARY KEY ( node_id, recorded_at, kind )`,
+
 		`CREATE TABLE node_tags (
 	node_id BYTES(MAX) NOT NULL,
 	name STRING(MAX) NOT NULL,
@@ -2429,6 +2658,12 @@ func (obj *spannerDB) Schema() []string {
 	updated_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( node_id )`,
 
+		`CREATE TABLE placement_configurations (
+	version STRING(MAX) NOT NULL,
+	config BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( version )`,
+
 		`CREATE TABLE projects (
 	id BYTES(MAX) NOT NULL,
 	public_id BYTES(MAX),
@@ -2489,7 +2724,8 @@ func (obj *spannerDB) Schema() []string {
 	updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
 	inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
 	segment_health FLOAT64 NOT NULL DEFAULT (1),
-	placement INT64
+	placement INT64,
+	deadline TIMESTAMP
 ) PRIMARY KEY ( stream_id, position )`,
 
 		`CREATE TABLE reputations (
@@ -2735,7 +2971,8 @@ func (obj *spannerDB) Schema() []string {
 	stream_id BYTES(MAX) NOT NULL,
 	position INT64 NOT NULL,
 	expires_at TIMESTAMP,
-	encrypted_size INT64 NOT NULL
+	encrypted_size INT64 NOT NULL,
+	priority INT64 NOT NULL DEFAULT (0)
 ) PRIMARY KEY ( inserted_at, stream_id, position )`,
 
 		`CREATE TABLE webapp_sessions (
@@ -2819,6 +3056,8 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time )`,
 
+		`CREATE INDEX audit_campaign_pieces_campaign_id_index ON audit_campaign_pieces ( campaign_id )`,
+
 		`CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp )`,
 
 		`CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start )`,
@@ -2837,6 +3076,8 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at )`,
 
+		`CREATE INDEX node_history_recorded_at_index ON node_history ( recorded_at )`,
+
 		`CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id )`,
 
 		`CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id )`,
@@ -2883,6 +3124,8 @@ func (obj *spannerDB) Schema() []string {
 
 		`CREATE INDEX users_external_id_index ON users ( external_id )`,
 
+		`CREATE INDEX verification_audits_priority_index ON verification_audits ( priority )`,
+
 		`CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id )`,
 
 		`CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id )`,
@@ -2928,6 +3171,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS accounting_rollups_start_time_index`,
 
+		`DROP INDEX IF EXISTS audit_campaign_pieces_campaign_id_index`,
+
 		`DROP INDEX IF EXISTS billing_transactions_tx_timestamp_index`,
 
 		`DROP INDEX IF EXISTS bucket_bandwidth_rollups_project_id_action_interval_index`,
@@ -2946,6 +3191,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS node_events_email_event_created_at_index`,
 
+		`DROP INDEX IF EXISTS node_history_recorded_at_index`,
+
 		`DROP INDEX IF EXISTS oauth_clients_user_id_index`,
 
 		`DROP INDEX IF EXISTS oauth_codes_user_id_index`,
@@ -2992,6 +3239,8 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP INDEX IF EXISTS users_external_id_index`,
 
+		`DROP INDEX IF EXISTS verification_audits_priority_index`,
+
 		`DROP INDEX IF EXISTS webapp_sessions_user_id_index`,
 
 		`DROP INDEX IF EXISTS project_invitations_project_id_index`,
@@ -3260,6 +3509,12 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS projects`,
 
+		`ALTER TABLE  placement_configurations ALTER version SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS placement_configurations_version`,
+
+		`DROP TABLE IF EXISTS placement_configurations`,
+
 		`ALTER TABLE  peer_identities ALTER node_id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS peer_identities_node_id`,
@@ -3298,6 +3553,20 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS node_tags`,
 
+		`ALTER TABLE  node_history ALTER node_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS node_history_node_id`,
+
+		`ALTER TABLE  node_history ALTER recorded_at SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS node_history_recorded_at`,
+
+		`ALTER TABLE  node_history ALTER kind SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS node_history_kind`,
+
+		`DROP TABLE IF EXISTS node_history`,
+
 		`ALTER TABLE  node_events ALTER id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS node_events_id`,
@@ -3340,6 +3609,16 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS graceful_exit_progress`,
 
+		`ALTER TABLE  durability_risks ALTER class SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS durability_risks_class`,
+
+		`ALTER TABLE  durability_risks ALTER class_value SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS durability_risks_class_value`,
+
+		`DROP TABLE IF EXISTS durability_risks`,
+
 		`ALTER TABLE  coinpayments_transactions ALTER id SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS coinpayments_transactions_id`,
@@ -3360,6 +3639,16 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS bucket_storage_tallies`,
 
+		`ALTER TABLE  bucket_inventory_reports ALTER project_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_inventory_reports_project_id`,
+
+		`ALTER TABLE  bucket_inventory_reports ALTER bucket_name SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS bucket_inventory_reports_bucket_name`,
+
+		`DROP TABLE IF EXISTS bucket_inventory_reports`,
+
 		`ALTER TABLE  bucket_bandwidth_rollup_archives ALTER bucket_name SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS bucket_bandwidth_rollup_archives_bucket_name`,
@@ -3408,6 +3697,30 @@ func (obj *spannerDB) DropSchema() []string {
 
 		`DROP TABLE IF EXISTS billing_balances`,
 
+		`ALTER TABLE  audit_campaign_pieces ALTER stream_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS audit_campaign_pieces_stream_id`,
+
+		`ALTER TABLE  audit_campaign_pieces ALTER position SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS audit_campaign_pieces_position`,
+
+		`ALTER TABLE  audit_campaign_pieces ALTER node_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS audit_campaign_pieces_node_id`,
+
+		`ALTER TABLE  audit_campaign_pieces ALTER campaign_id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS audit_campaign_pieces_campaign_id`,
+
+		`DROP TABLE IF EXISTS audit_campaign_pieces`,
+
+		`ALTER TABLE  audit_campaigns ALTER id SET DEFAULT (null)`,
+
+		`DROP SEQUENCE IF EXISTS audit_campaigns_id`,
+
+		`DROP TABLE IF EXISTS audit_campaigns`,
+
 		`ALTER TABLE  accounting_timestamps ALTER name SET DEFAULT (null)`,
 
 		`DROP SEQUENCE IF EXISTS accounting_timestamps_name`,
@@ -3886,6 +4199,354 @@ func (f AccountingTimestamps_Value_Field) value() any {
 	return f._value
 }
 
+type AuditCampaign struct {
+	Id          []byte
+	Description string
+	Nodes       []byte
+	Segments    int
+	Queued      int
+	Status      string
+	Error       string
+	CreatedAt   time.Time
+	QueuedAt    *time.Time
+}
+
+func (AuditCampaign) _Table() string { return "audit_campaigns" }
+
+type AuditCampaign_Create_Fields struct {
+	Queued   AuditCampaign_Queued_Field
+	Error    AuditCampaign_Error_Field
+	QueuedAt AuditCampaign_QueuedAt_Field
+}
+
+type AuditCampaign_Update_Fields struct {
+	Queued   AuditCampaign_Queued_Field
+	Status   AuditCampaign_Status_Field
+	Error    AuditCampaign_Error_Field
+	QueuedAt AuditCampaign_QueuedAt_Field
+}
+
+type AuditCampaign_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditCampaign_Id(v []byte) AuditCampaign_Id_Field {
+	return AuditCampaign_Id_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaign_Id_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaign_Description_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AuditCampaign_Description(v string) AuditCampaign_Description_Field {
+	return AuditCampaign_Description_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaign_Description_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaign_Nodes_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditCampaign_Nodes(v []byte) AuditCampaign_Nodes_Field {
+	return AuditCampaign_Nodes_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaign_Nodes_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaign_Segments_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AuditCampaign_Segments(v int) AuditCampaign_Segments_Field {
+	return AuditCampaign_Segments_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaign_Segments_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaign_Queued_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func AuditCampaign_Queued(v int) AuditCampaign_Queued_Field {
+	return AuditCampaign_Queued_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaign_Queued_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaign_Status_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AuditCampaign_Status(v string) AuditCampaign_Status_Field {
+	return AuditCampaign_Status_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaign_Status_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaign_Error_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func AuditCampaign_Error(v string) AuditCampaign_Error_Field {
+	return AuditCampaign_Error_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaign_Error_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaign_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func AuditCampaign_CreatedAt(v time.Time) AuditCampaign_CreatedAt_Field {
+	return AuditCampaign_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaign_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaign_QueuedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func AuditCampaign_QueuedAt(v time.Time) AuditCampaign_QueuedAt_Field {
+	return AuditCampaign_QueuedAt_Field{_set: true, _value: &v}
+}
+
+func AuditCampaign_QueuedAt_Raw(v *time.Time) AuditCampaign_QueuedAt_Field {
+	if v == nil {
+		return AuditCampaign_QueuedAt_Null()
+	}
+	return AuditCampaign_QueuedAt(*v)
+}
+
+func AuditCampaign_QueuedAt_Null() AuditCampaign_QueuedAt_Field {
+	return AuditCampaign_QueuedAt_Field{_set: true, _null: true}
+}
+
+func (f AuditCampaign_QueuedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AuditCampaign_QueuedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaignPiece struct {
+	StreamId   []byte
+	Position   uint64
+	NodeId     []byte
+	CampaignId []byte
+	Outcome    *int
+	AuditedAt  *time.Time
+}
+
+func (AuditCampaignPiece) _Table() string { return "audit_campaign_pieces" }
+
+type AuditCampaignPiece_Create_Fields struct {
+	Outcome   AuditCampaignPiece_Outcome_Field
+	AuditedAt AuditCampaignPiece_AuditedAt_Field
+}
+
+type AuditCampaignPiece_Update_Fields struct {
+	Outcome   AuditCampaignPiece_Outcome_Field
+	AuditedAt AuditCampaignPiece_AuditedAt_Field
+}
+
+type AuditCampaignPiece_StreamId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditCampaignPiece_StreamId(v []byte) AuditCampaignPiece_StreamId_Field {
+	return AuditCampaignPiece_StreamId_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaignPiece_StreamId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaignPiece_Position_Field struct {
+	_set   bool
+	_null  bool
+	_value uint64
+}
+
+func AuditCampaignPiece_Position(v uint64) AuditCampaignPiece_Position_Field {
+	return AuditCampaignPiece_Position_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaignPiece_Position_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaignPiece_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditCampaignPiece_NodeId(v []byte) AuditCampaignPiece_NodeId_Field {
+	return AuditCampaignPiece_NodeId_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaignPiece_NodeId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaignPiece_CampaignId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func AuditCampaignPiece_CampaignId(v []byte) AuditCampaignPiece_CampaignId_Field {
+	return AuditCampaignPiece_CampaignId_Field{_set: true, _value: v}
+}
+
+func (f AuditCampaignPiece_CampaignId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaignPiece_Outcome_Field struct {
+	_set   bool
+	_null  bool
+	_value *int
+}
+
+func AuditCampaignPiece_Outcome(v int) AuditCampaignPiece_Outcome_Field {
+	return AuditCampaignPiece_Outcome_Field{_set: true, _value: &v}
+}
+
+func AuditCampaignPiece_Outcome_Raw(v *int) AuditCampaignPiece_Outcome_Field {
+	if v == nil {
+		return AuditCampaignPiece_Outcome_Null()
+	}
+	return AuditCampaignPiece_Outcome(*v)
+}
+
+func AuditCampaignPiece_Outcome_Null() AuditCampaignPiece_Outcome_Field {
+	return AuditCampaignPiece_Outcome_Field{_set: true, _null: true}
+}
+
+func (f AuditCampaignPiece_Outcome_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AuditCampaignPiece_Outcome_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type AuditCampaignPiece_AuditedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func AuditCampaignPiece_AuditedAt(v time.Time) AuditCampaignPiece_AuditedAt_Field {
+	return AuditCampaignPiece_AuditedAt_Field{_set: true, _value: &v}
+}
+
+func AuditCampaignPiece_AuditedAt_Raw(v *time.Time) AuditCampaignPiece_AuditedAt_Field {
+	if v == nil {
+		return AuditCampaignPiece_AuditedAt_Null()
+	}
+	return AuditCampaignPiece_AuditedAt(*v)
+}
+
+func AuditCampaignPiece_AuditedAt_Null() AuditCampaignPiece_AuditedAt_Field {
+	return AuditCampaignPiece_AuditedAt_Field{_set: true, _null: true}
+}
+
+func (f AuditCampaignPiece_AuditedAt_Field) isnull() bool {
+	return !f._set || f._null || f._value == nil
+}
+
+func (f AuditCampaignPiece_AuditedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type BillingBalance struct {
 	UserId      []byte
 	Balance     int64
@@ -4467,6 +5128,68 @@ func (f BucketBandwidthRollupArchive_Settled_Field) value() any {
 	return f._value
 }
 
+type BucketInventoryReport struct {
+	ProjectId   []byte
+	BucketName  []byte
+	GeneratedAt time.Time
+}
+
+func (BucketInventoryReport) _Table() string { return "bucket_inventory_reports" }
+
+type BucketInventoryReport_Update_Fields struct {
+}
+
+type BucketInventoryReport_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketInventoryReport_ProjectId(v []byte) BucketInventoryReport_ProjectId_Field {
+	return BucketInventoryReport_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f BucketInventoryReport_ProjectId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketInventoryReport_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func BucketInventoryReport_BucketName(v []byte) BucketInventoryReport_BucketName_Field {
+	return BucketInventoryReport_BucketName_Field{_set: true, _value: v}
+}
+
+func (f BucketInventoryReport_BucketName_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type BucketInventoryReport_GeneratedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func BucketInventoryReport_GeneratedAt(v time.Time) BucketInventoryReport_GeneratedAt_Field {
+	return BucketInventoryReport_GeneratedAt_Field{_set: true, _value: v}
+}
+
+func (f BucketInventoryReport_GeneratedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type BucketStorageTally struct {
 	BucketName          []byte
 	ProjectId           []byte
@@ -4850,6 +5573,122 @@ func (f CoinpaymentsTransaction_CreatedAt_Field) value() any {
 	return f._value
 }
 
+type DurabilityRisk struct {
+	Class               string
+	ClassValue          string
+	NodeCount           int
+	SegmentsBelowRepair int64
+	SegmentsIrreparable int64
+	ReportedAt          time.Time
+}
+
+func (DurabilityRisk) _Table() string { return "durability_risks" }
+
+type DurabilityRisk_Update_Fields struct {
+}
+
+type DurabilityRisk_Class_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func DurabilityRisk_Class(v string) DurabilityRisk_Class_Field {
+	return DurabilityRisk_Class_Field{_set: true, _value: v}
+}
+
+func (f DurabilityRisk_Class_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityRisk_ClassValue_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func DurabilityRisk_ClassValue(v string) DurabilityRisk_ClassValue_Field {
+	return DurabilityRisk_ClassValue_Field{_set: true, _value: v}
+}
+
+func (f DurabilityRisk_ClassValue_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityRisk_NodeCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func DurabilityRisk_NodeCount(v int) DurabilityRisk_NodeCount_Field {
+	return DurabilityRisk_NodeCount_Field{_set: true, _value: v}
+}
+
+func (f DurabilityRisk_NodeCount_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityRisk_SegmentsBelowRepair_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func DurabilityRisk_SegmentsBelowRepair(v int64) DurabilityRisk_SegmentsBelowRepair_Field {
+	return DurabilityRisk_SegmentsBelowRepair_Field{_set: true, _value: v}
+}
+
+func (f DurabilityRisk_SegmentsBelowRepair_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityRisk_SegmentsIrreparable_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func DurabilityRisk_SegmentsIrreparable(v int64) DurabilityRisk_SegmentsIrreparable_Field {
+	return DurabilityRisk_SegmentsIrreparable_Field{_set: true, _value: v}
+}
+
+func (f DurabilityRisk_SegmentsIrreparable_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type DurabilityRisk_ReportedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func DurabilityRisk_ReportedAt(v time.Time) DurabilityRisk_ReportedAt_Field {
+	return DurabilityRisk_ReportedAt_Field{_set: true, _value: v}
+}
+
+func (f DurabilityRisk_ReportedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type GracefulExitProgress struct {
 	NodeId            []byte
 	BytesTransferred  int64
@@ -5340,6 +6179,8 @@ type Node struct {
 	NoisePublicKey          []byte
 	DebounceLimit           int
 	Features                int
+	MaintenanceStart        *time.Time
+	MaintenanceEnd          *time.Time
 }
 
 func (Node) _Table() string { return "nodes" }
@@ -5377,6 +6218,8 @@ type Node_Create_Fields struct {
 	NoisePublicKey          Node_NoisePublicKey_Field
 	DebounceLimit           Node_DebounceLimit_Field
 	Features                Node_Features_Field
+	MaintenanceStart        Node_MaintenanceStart_Field
+	MaintenanceEnd          Node_MaintenanceEnd_Field
 }
 
 type Node_Update_Fields struct {
@@ -5416,6 +6259,8 @@ type Node_Update_Fields struct {
 	NoisePublicKey          Node_NoisePublicKey_Field
 	DebounceLimit           Node_DebounceLimit_Field
 	Features                Node_Features_Field
+	MaintenanceStart        Node_MaintenanceStart_Field
+	MaintenanceEnd          Node_MaintenanceEnd_Field
 }
 
 type Node_Id_Field struct {
@@ -6293,6 +7138,66 @@ func (f Node_Features_Field) value() any {
 	return f._value
 }
 
+type Node_MaintenanceStart_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_MaintenanceStart(v time.Time) Node_MaintenanceStart_Field {
+	return Node_MaintenanceStart_Field{_set: true, _value: &v}
+}
+
+func Node_MaintenanceStart_Raw(v *time.Time) Node_MaintenanceStart_Field {
+	if v == nil {
+		return Node_MaintenanceStart_Null()
+	}
+	return Node_MaintenanceStart(*v)
+}
+
+func Node_MaintenanceStart_Null() Node_MaintenanceStart_Field {
+	return Node_MaintenanceStart_Field{_set: true, _null: true}
+}
+
+func (f Node_MaintenanceStart_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_MaintenanceStart_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type Node_MaintenanceEnd_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func Node_MaintenanceEnd(v time.Time) Node_MaintenanceEnd_Field {
+	return Node_MaintenanceEnd_Field{_set: true, _value: &v}
+}
+
+func Node_MaintenanceEnd_Raw(v *time.Time) Node_MaintenanceEnd_Field {
+	if v == nil {
+		return Node_MaintenanceEnd_Null()
+	}
+	return Node_MaintenanceEnd(*v)
+}
+
+func Node_MaintenanceEnd_Null() Node_MaintenanceEnd_Field {
+	return Node_MaintenanceEnd_Field{_set: true, _null: true}
+}
+
+func (f Node_MaintenanceEnd_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f Node_MaintenanceEnd_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type NodeApiVersion struct {
 	Id         []byte
 	ApiVersion int
@@ -6574,6 +7479,266 @@ func (f NodeEvent_EmailSent_Field) value() any {
 	return f._value
 }
 
+type NodeHistory struct {
+	NodeId            []byte
+	RecordedAt        time.Time
+	Kind              int
+	Address           string
+	Version           string
+	FreeDisk          int64
+	AuditScore        float64
+	UnknownAuditScore float64
+	OnlineScore       float64
+	Vetted            bool
+	Suspended         bool
+	Exiting           bool
+	Disqualified      bool
+	Details           string
+}
+
+func (NodeHistory) _Table() string { return "node_history" }
+
+type NodeHistory_Update_Fields struct {
+}
+
+type NodeHistory_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func NodeHistory_NodeId(v []byte) NodeHistory_NodeId_Field {
+	return NodeHistory_NodeId_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_NodeId_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_RecordedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func NodeHistory_RecordedAt(v time.Time) NodeHistory_RecordedAt_Field {
+	return NodeHistory_RecordedAt_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_RecordedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_Kind_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func NodeHistory_Kind(v int) NodeHistory_Kind_Field {
+	return NodeHistory_Kind_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_Kind_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_Address_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeHistory_Address(v string) NodeHistory_Address_Field {
+	return NodeHistory_Address_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_Address_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_Version_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeHistory_Version(v string) NodeHistory_Version_Field {
+	return NodeHistory_Version_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_Version_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_FreeDisk_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func NodeHistory_FreeDisk(v int64) NodeHistory_FreeDisk_Field {
+	return NodeHistory_FreeDisk_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_FreeDisk_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_AuditScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func NodeHistory_AuditScore(v float64) NodeHistory_AuditScore_Field {
+	return NodeHistory_AuditScore_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_AuditScore_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_UnknownAuditScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func NodeHistory_UnknownAuditScore(v float64) NodeHistory_UnknownAuditScore_Field {
+	return NodeHistory_UnknownAuditScore_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_UnknownAuditScore_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_OnlineScore_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func NodeHistory_OnlineScore(v float64) NodeHistory_OnlineScore_Field {
+	return NodeHistory_OnlineScore_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_OnlineScore_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_Vetted_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func NodeHistory_Vetted(v bool) NodeHistory_Vetted_Field {
+	return NodeHistory_Vetted_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_Vetted_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_Suspended_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func NodeHistory_Suspended(v bool) NodeHistory_Suspended_Field {
+	return NodeHistory_Suspended_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_Suspended_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_Exiting_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func NodeHistory_Exiting(v bool) NodeHistory_Exiting_Field {
+	return NodeHistory_Exiting_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_Exiting_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_Disqualified_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func NodeHistory_Disqualified(v bool) NodeHistory_Disqualified_Field {
+	return NodeHistory_Disqualified_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_Disqualified_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type NodeHistory_Details_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func NodeHistory_Details(v string) NodeHistory_Details_Field {
+	return NodeHistory_Details_Field{_set: true, _value: v}
+}
+
+func (f NodeHistory_Details_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type NodeTags struct {
 	NodeId   []byte
 	Name     string
@@ -7215,6 +8380,69 @@ func (f PeerIdentity_UpdatedAt_Field) value() any {
 	return f._value
 }
 
+type PlacementConfiguration struct {
+	Version   string
+	Config    []byte
+	CreatedAt time.Time
+}
+
+func (PlacementConfiguration) _Table() string { return "placement_configurations" }
+
+type PlacementConfiguration_Update_Fields struct {
+	CreatedAt PlacementConfiguration_CreatedAt_Field
+}
+
+type PlacementConfiguration_Version_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func PlacementConfiguration_Version(v string) PlacementConfiguration_Version_Field {
+	return PlacementConfiguration_Version_Field{_set: true, _value: v}
+}
+
+func (f PlacementConfiguration_Version_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type PlacementConfiguration_Config_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func PlacementConfiguration_Config(v []byte) PlacementConfiguration_Config_Field {
+	return PlacementConfiguration_Config_Field{_set: true, _value: v}
+}
+
+func (f PlacementConfiguration_Config_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+type PlacementConfiguration_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func PlacementConfiguration_CreatedAt(v time.Time) PlacementConfiguration_CreatedAt_Field {
+	return PlacementConfiguration_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f PlacementConfiguration_CreatedAt_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type Project struct {
 	Id                          []byte
 	PublicId                    []byte
@@ -8417,6 +9645,7 @@ type RepairQueue struct {
 	InsertedAt    time.Time
 	SegmentHealth float64
 	Placement     *int
+	Deadline      *time.Time
 }
 
 func (RepairQueue) _Table() string { return "repair_queue" }
@@ -8427,12 +9656,14 @@ type RepairQueue_Create_Fields struct {
 	InsertedAt    RepairQueue_InsertedAt_Field
 	SegmentHealth RepairQueue_SegmentHealth_Field
 	Placement     RepairQueue_Placement_Field
+	Deadline      RepairQueue_Deadline_Field
 }
 
 type RepairQueue_Update_Fields struct {
 	AttemptedAt RepairQueue_AttemptedAt_Field
 	UpdatedAt   RepairQueue_UpdatedAt_Field
 	Placement   RepairQueue_Placement_Field
+	Deadline    RepairQueue_Deadline_Field
 }
 
 type RepairQueue_StreamId_Field struct {
@@ -8580,6 +9811,36 @@ func (f RepairQueue_Placement_Field) value() any {
 	return f._value
 }
 
+type RepairQueue_Deadline_Field struct {
+	_set   bool
+	_null  bool
+	_value *time.Time
+}
+
+func RepairQueue_Deadline(v time.Time) RepairQueue_Deadline_Field {
+	return RepairQueue_Deadline_Field{_set: true, _value: &v}
+}
+
+func RepairQueue_Deadline_Raw(v *time.Time) RepairQueue_Deadline_Field {
+	if v == nil {
+		return RepairQueue_Deadline_Null()
+	}
+	return RepairQueue_Deadline(*v)
+}
+
+func RepairQueue_Deadline_Null() RepairQueue_Deadline_Field {
+	return RepairQueue_Deadline_Field{_set: true, _null: true}
+}
+
+func (f RepairQueue_Deadline_Field) isnull() bool { return !f._set || f._null || f._value == nil }
+
+func (f RepairQueue_Deadline_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type Reputation struct {
 	Id                          []byte
 	AuditSuccessCount           int64
@@ -12626,6 +13887,7 @@ type VerificationAudits struct {
 	Position      uint64
 	ExpiresAt     *time.Time
 	EncryptedSize int
+	Priority      int
 }
 
 func (VerificationAudits) _Table() string { return "verification_audits" }
@@ -12633,6 +13895,7 @@ func (VerificationAudits) _Table() string { return "verification_audits" }
 type VerificationAudits_Create_Fields struct {
 	InsertedAt VerificationAudits_InsertedAt_Field
 	ExpiresAt  VerificationAudits_ExpiresAt_Field
+	Priority   VerificationAudits_Priority_Field
 }
 
 type VerificationAudits_Update_Fields struct {
@@ -12738,6 +14001,23 @@ func (f VerificationAudits_EncryptedSize_Field) value() any {
 	return f._value
 }
 
+type VerificationAudits_Priority_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func VerificationAudits_Priority(v int) VerificationAudits_Priority_Field {
+	return VerificationAudits_Priority_Field{_set: true, _value: v}
+}
+
+func (f VerificationAudits_Priority_Field) value() any {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
 type WebappSession struct {
 	Id        []byte
 	UserId    []byte
@@ -17548,7 +18828,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end FROM nodes WHERE nodes.id = ?")
 
 	var __values []any
 	__values = append(__values, node_id.value())
@@ -17557,7 +18837,7 @@ func (obj *pgxImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.MaintenanceStart, &node.MaintenanceEnd)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -17616,9 +18896,9 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 
@@ -17645,7 +18925,7 @@ func (obj *pgxImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.MaintenanceStart, &node.MaintenanceEnd, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -20703,7 +21983,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -20889,6 +22169,16 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -20903,7 +22193,7 @@ func (obj *pgxImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.MaintenanceStart, &node.MaintenanceEnd)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -21110,6 +22400,16 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -21327,6 +22627,16 @@ func (obj *pgxImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitF
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -24021,6 +25331,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM placement_configurations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -24071,6 +25391,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_history;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -24121,6 +25451,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_risks;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -24131,6 +25471,16 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_inventory_reports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -24181,6 +25531,26 @@ func (obj *pgxImpl) deleteAll(ctx context.Context) (count int64, err error) {
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_campaign_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_campaigns;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -27393,7 +28763,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end FROM nodes WHERE nodes.id = ?")
 
 	var __values []any
 	__values = append(__values, node_id.value())
@@ -27402,7 +28772,7 @@ func (obj *pgxcockroachImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.MaintenanceStart, &node.MaintenanceEnd)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -27461,9 +28831,9 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end, nodes.id FROM nodes WHERE (nodes.id) > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 
@@ -27490,7 +28860,7 @@ func (obj *pgxcockroachImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.MaintenanceStart, &node.MaintenanceEnd, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -30548,7 +31918,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -30734,6 +32104,16 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -30748,7 +32128,7 @@ func (obj *pgxcockroachImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.MaintenanceStart, &node.MaintenanceEnd)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -30955,6 +32335,16 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -31172,6 +32562,16 @@ func (obj *pgxcockroachImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -33866,6 +35266,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM placement_configurations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -33916,6 +35326,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_history;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -33966,6 +35386,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_risks;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -33976,6 +35406,16 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_inventory_reports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -34026,6 +35466,26 @@ func (obj *pgxcockroachImpl) deleteAll(ctx context.Context) (count int64, err er
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_campaign_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_campaigns;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -37502,7 +38962,7 @@ func (obj *spannerImpl) Get_Node_By_Id(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end FROM nodes WHERE nodes.id = ?")
 
 	var __values []any
 	__values = append(__values, node_id.value())
@@ -37511,7 +38971,7 @@ func (obj *spannerImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.queryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.MaintenanceStart, &node.MaintenanceEnd)
 	if err != nil {
 		return (*Node)(nil), obj.makeErr(err)
 	}
@@ -37570,9 +39030,9 @@ func (obj *spannerImpl) Paged_Node(ctx context.Context,
 		panic("using DB when inside of a transaction")
 	}
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes WHERE nodes.id > ? ORDER BY nodes.id LIMIT ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end, nodes.id FROM nodes WHERE nodes.id > ? ORDER BY nodes.id LIMIT ?")
 
-	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
+	var __embed_first_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end, nodes.id FROM nodes ORDER BY nodes.id LIMIT ?")
 
 	var __values []any
 
@@ -37602,7 +39062,7 @@ func (obj *spannerImpl) Paged_Node(ctx context.Context,
 
 			for __rows.Next() {
 				node := &Node{}
-				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &__continuation._value_id)
+				err = __rows.Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.MaintenanceStart, &node.MaintenanceEnd, &__continuation._value_id)
 				if err != nil {
 					return nil, nil, err
 				}
@@ -40655,7 +42115,7 @@ func (obj *spannerImpl) Update_Node_By_Id(ctx context.Context,
 
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? THEN RETURN nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? THEN RETURN nodes.id, nodes.address, nodes.last_net, nodes.last_ip_port, nodes.country_code, nodes.protocol, nodes.email, nodes.wallet, nodes.wallet_features, nodes.free_disk, nodes.piece_count, nodes.major, nodes.minor, nodes.patch, nodes.commit_hash, nodes.release_timestamp, nodes.release, nodes.latency_90, nodes.vetted_at, nodes.created_at, nodes.updated_at, nodes.last_contact_success, nodes.last_contact_failure, nodes.disqualified, nodes.disqualification_reason, nodes.unknown_audit_suspended, nodes.offline_suspended, nodes.under_review, nodes.exit_initiated_at, nodes.exit_loop_completed_at, nodes.exit_finished_at, nodes.exit_success, nodes.contained, nodes.last_offline_email, nodes.last_software_update_email, nodes.noise_proto, nodes.noise_public_key, nodes.debounce_limit, nodes.features, nodes.maintenance_start, nodes.maintenance_end")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []any
@@ -40806,6 +42266,16 @@ func (obj *spannerImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -40820,7 +42290,7 @@ func (obj *spannerImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features)
+	err = obj.driver.QueryRowContext(ctx, __stmt, __values...).Scan(&node.Id, &node.Address, &node.LastNet, &node.LastIpPort, &node.CountryCode, &node.Protocol, &node.Email, &node.Wallet, &node.WalletFeatures, &node.FreeDisk, &node.PieceCount, &node.Major, &node.Minor, &node.Patch, &node.CommitHash, &node.ReleaseTimestamp, &node.Release, &node.Latency90, &node.VettedAt, &node.CreatedAt, &node.UpdatedAt, &node.LastContactSuccess, &node.LastContactFailure, &node.Disqualified, &node.DisqualificationReason, &node.UnknownAuditSuspended, &node.OfflineSuspended, &node.UnderReview, &node.ExitInitiatedAt, &node.ExitLoopCompletedAt, &node.ExitFinishedAt, &node.ExitSuccess, &node.Contained, &node.LastOfflineEmail, &node.LastSoftwareUpdateEmail, &node.NoiseProto, &node.NoisePublicKey, &node.DebounceLimit, &node.Features, &node.MaintenanceStart, &node.MaintenanceEnd)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, nil
 	}
@@ -40992,6 +42462,16 @@ func (obj *spannerImpl) UpdateNoReturn_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -41174,6 +42654,16 @@ func (obj *spannerImpl) UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_E
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("features = ?"))
 	}
 
+	if update.MaintenanceStart._set {
+		__values = append(__values, update.MaintenanceStart.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_start = ?"))
+	}
+
+	if update.MaintenanceEnd._set {
+		__values = append(__values, update.MaintenanceEnd.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("maintenance_end = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -43704,6 +45194,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM placement_configurations;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -43754,6 +45254,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM node_history;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -43804,6 +45314,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM durability_risks;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -43814,6 +45334,16 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM bucket_inventory_reports;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -43864,6 +45394,26 @@ func (obj *spannerImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_campaign_pieces;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.ExecContext(ctx, "DELETE FROM audit_campaigns;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
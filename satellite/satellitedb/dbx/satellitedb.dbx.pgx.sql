@@ -26,6 +26,27 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 ) ;
+CREATE TABLE audit_campaigns (
+	id bytea NOT NULL,
+	description text NOT NULL,
+	nodes bytea NOT NULL,
+	segments integer NOT NULL,
+	queued integer NOT NULL DEFAULT 0,
+	status text NOT NULL,
+	error text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	queued_at timestamp with time zone,
+	PRIMARY KEY ( id )
+) ;
+CREATE TABLE audit_campaign_pieces (
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	node_id bytea NOT NULL,
+	campaign_id bytea NOT NULL,
+	outcome integer,
+	audited_at timestamp with time zone,
+	PRIMARY KEY ( stream_id, position, node_id, campaign_id )
+) ;
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -68,6 +89,12 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 ) ;
+CREATE TABLE bucket_inventory_reports (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	generated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+) ;
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -94,6 +121,15 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 ) ;
+CREATE TABLE durability_risks (
+	class text NOT NULL,
+	class_value text NOT NULL,
+	node_count integer NOT NULL,
+	segments_below_repair bigint NOT NULL,
+	segments_irreparable bigint NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( class, class_value )
+) ;
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -158,6 +194,8 @@ CREATE TABLE nodes (
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	maintenance_start timestamp with time zone,
+	maintenance_end timestamp with time zone,
 	PRIMARY KEY ( id )
 ) ;
 CREATE TABLE node_api_versions (
@@ -178,6 +216,23 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 ) ;
+CREATE TABLE node_history (
+	node_id bytea NOT NULL,
+	recorded_at timestamp with time zone NOT NULL,
+	kind integer NOT NULL,
+	address text NOT NULL,
+	version text NOT NULL,
+	free_disk bigint NOT NULL,
+	audit_score double precision NOT NULL,
+	unknown_audit_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	vetted boolean NOT NULL,
+	suspended boolean NOT NULL,
+	exiting boolean NOT NULL,
+	disqualified boolean NOT NULL,
+	details text NOT NULL,
+	PRIMARY KEY ( node_id, recorded_at, kind )
+) ;
 CREATE TABLE node_tags (
 	node_id bytea NOT NULL,
 	name text NOT NULL,
@@ -225,6 +280,12 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 ) ;
+CREATE TABLE placement_configurations (
+	version text NOT NULL,
+	config bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( version )
+) ;
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -285,6 +346,7 @@ CREATE TABLE repair_queue (
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
 	placement integer,
+	deadline timestamp with time zone,
 	PRIMARY KEY ( stream_id, position )
 ) ;
 CREATE TABLE reputations (
@@ -526,6 +588,7 @@ CREATE TABLE verification_audits (
 	position bigint NOT NULL,
 	expires_at timestamp with time zone,
 	encrypted_size integer NOT NULL,
+	priority integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( inserted_at, stream_id, position )
 ) ;
 CREATE TABLE webapp_sessions (
@@ -597,6 +660,7 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	PRIMARY KEY ( tx_id )
 ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_campaign_pieces_campaign_id_index ON audit_campaign_pieces ( campaign_id ) ;
 CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
@@ -606,6 +670,7 @@ CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_st
 CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_tallies ( interval_start ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL ;
+CREATE INDEX node_history_recorded_at_index ON node_history ( recorded_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
@@ -629,6 +694,7 @@ CREATE INDEX stripecoinpayments_invoice_project_records_unbilled_project_id_inde
 CREATE INDEX users_email_status_index ON users ( normalized_email, status ) ;
 CREATE INDEX trial_expiration_index ON users ( trial_expiration ) ;
 CREATE INDEX users_external_id_index ON users ( external_id ) WHERE users.external_id is not NULL ;
+CREATE INDEX verification_audits_priority_index ON verification_audits ( priority ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id ) ;
 CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
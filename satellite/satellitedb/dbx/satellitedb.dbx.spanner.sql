@@ -23,6 +23,25 @@ CREATE TABLE accounting_timestamps (
 	name STRING(MAX) NOT NULL,
 	value TIMESTAMP NOT NULL
 ) PRIMARY KEY ( name ) ;
+CREATE TABLE audit_campaigns (
+	id BYTES(MAX) NOT NULL,
+	description STRING(MAX) NOT NULL,
+	nodes BYTES(MAX) NOT NULL,
+	segments INT64 NOT NULL,
+	queued INT64 NOT NULL DEFAULT (0),
+	status STRING(MAX) NOT NULL,
+	error STRING(MAX) NOT NULL DEFAULT (""),
+	created_at TIMESTAMP NOT NULL,
+	queued_at TIMESTAMP
+) PRIMARY KEY ( id ) ;
+CREATE TABLE audit_campaign_pieces (
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	node_id BYTES(MAX) NOT NULL,
+	campaign_id BYTES(MAX) NOT NULL,
+	outcome INT64,
+	audited_at TIMESTAMP
+) PRIMARY KEY ( stream_id, position, node_id, campaign_id ) ;
 CREATE TABLE billing_balances (
 	user_id BYTES(MAX) NOT NULL,
 	balance INT64 NOT NULL,
@@ -62,6 +81,11 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	allocated INT64 NOT NULL,
 	settled INT64 NOT NULL
 ) PRIMARY KEY ( bucket_name, project_id, interval_start, action ) ;
+CREATE TABLE bucket_inventory_reports (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	generated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name ) ;
 CREATE TABLE bucket_storage_tallies (
 	bucket_name BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -86,6 +110,14 @@ CREATE TABLE coinpayments_transactions (
 	timeout INT64 NOT NULL,
 	created_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( id ) ;
+CREATE TABLE durability_risks (
+	class STRING(MAX) NOT NULL,
+	class_value STRING(MAX) NOT NULL,
+	node_count INT64 NOT NULL,
+	segments_below_repair INT64 NOT NULL,
+	segments_irreparable INT64 NOT NULL,
+	reported_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( class, class_value ) ;
 CREATE TABLE graceful_exit_progress (
 	node_id BYTES(MAX) NOT NULL,
 	bytes_transferred INT64 NOT NULL,
@@ -147,7 +179,9 @@ CREATE TABLE nodes (
 	noise_proto INT64,
 	noise_public_key BYTES(MAX),
 	debounce_limit INT64 NOT NULL DEFAULT (0),
-	features INT64 NOT NULL DEFAULT (0)
+	features INT64 NOT NULL DEFAULT (0),
+	maintenance_start TIMESTAMP,
+	maintenance_end TIMESTAMP
 ) PRIMARY KEY ( id ) ;
 CREATE TABLE node_api_versions (
 	id BYTES(MAX) NOT NULL,
@@ -165,6 +199,22 @@ CREATE TABLE node_events (
 	last_attempted TIMESTAMP,
 	email_sent TIMESTAMP
 ) PRIMARY KEY ( id ) ;
+CREATE TABLE node_history (
+	node_id BYTES(MAX) NOT NULL,
+	recorded_at TIMESTAMP NOT NULL,
+	kind INT64 NOT NULL,
+	address STRING(MAX) NOT NULL,
+	version STRING(MAX) NOT NULL,
+	free_disk INT64 NOT NULL,
+	audit_score FLOAT64 NOT NULL,
+	unknown_audit_score FLOAT64 NOT NULL,
+	online_score FLOAT64 NOT NULL,
+	vetted BOOL NOT NULL,
+	suspended BOOL NOT NULL,
+	exiting BOOL NOT NULL,
+	disqualified BOOL NOT NULL,
+	details STRING(MAX) NOT NULL
+) PRIMARY KEY ( node_id, recorded_at, kind ) ;
 CREATE TABLE node_tags (
 	node_id BYTES(MAX) NOT NULL,
 	name STRING(MAX) NOT NULL,
@@ -207,6 +257,11 @@ CREATE TABLE peer_identities (
 	chain BYTES(MAX) NOT NULL,
 	updated_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( node_id ) ;
+CREATE TABLE placement_configurations (
+	version STRING(MAX) NOT NULL,
+	config BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( version ) ;
 CREATE TABLE projects (
 	id BYTES(MAX) NOT NULL,
 	public_id BYTES(MAX),
@@ -263,7 +318,8 @@ CREATE TABLE repair_queue (
 	updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
 	inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
 	segment_health FLOAT64 NOT NULL DEFAULT (1),
-	placement INT64
+	placement INT64,
+	deadline TIMESTAMP
 ) PRIMARY KEY ( stream_id, position ) ;
 CREATE TABLE reputations (
 	id BYTES(MAX) NOT NULL,
@@ -485,7 +541,8 @@ CREATE TABLE verification_audits (
 	stream_id BYTES(MAX) NOT NULL,
 	position INT64 NOT NULL,
 	expires_at TIMESTAMP,
-	encrypted_size INT64 NOT NULL
+	encrypted_size INT64 NOT NULL,
+	priority INT64 NOT NULL DEFAULT (0)
 ) PRIMARY KEY ( inserted_at, stream_id, position ) ;
 CREATE TABLE webapp_sessions (
 	id BYTES(MAX) NOT NULL,
@@ -559,6 +616,7 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	CONSTRAINT stripecoinpayments_apply_balance_intents_tx_id_fkey FOREIGN KEY (tx_id) REFERENCES coinpayments_transactions (id) ON DELETE CASCADE 
 ) PRIMARY KEY ( tx_id ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_campaign_pieces_campaign_id_index ON audit_campaign_pieces ( campaign_id ) ;
 CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
@@ -568,6 +626,7 @@ CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_st
 CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_tallies ( interval_start ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) ;
+CREATE INDEX node_history_recorded_at_index ON node_history ( recorded_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
@@ -591,6 +650,7 @@ CREATE INDEX stripecoinpayments_invoice_project_records_unbilled_project_id_inde
 CREATE INDEX users_email_status_index ON users ( normalized_email, status ) ;
 CREATE INDEX trial_expiration_index ON users ( trial_expiration ) ;
 CREATE INDEX users_external_id_index ON users ( external_id ) ;
+CREATE INDEX verification_audits_priority_index ON verification_audits ( priority ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id ) ;
 CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
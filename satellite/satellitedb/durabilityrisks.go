@@ -0,0 +1,68 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+
+	"storj.io/storj/satellite/durability"
+	"storj.io/storj/satellite/satellitedb/dbx"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ durability.DB = (*durabilityRisks)(nil)
+
+type durabilityRisks struct {
+	db *satelliteDB
+}
+
+// ReplaceRisks replaces the stored risk report of the class.
+func (risks *durabilityRisks) ReplaceRisks(ctx context.Context, class string, entries []durability.Risk) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return Error.Wrap(risks.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
+		_, err := tx.Tx.ExecContext(ctx, risks.db.Rebind(`DELETE FROM durability_risks WHERE class = ?`), class)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range entries {
+			_, err := tx.Tx.ExecContext(ctx, risks.db.Rebind(`
+				INSERT INTO durability_risks (
+					class, class_value, node_count, segments_below_repair, segments_irreparable, reported_at
+				) VALUES (?, ?, ?, ?, ?, ?)
+			`), class, entry.Value, int64(entry.NodeCount), entry.SegmentsBelowRepair, entry.SegmentsIrreparable, entry.ReportedAt)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+}
+
+// GetRisks returns the stored risk report of the class, the highest risks first.
+func (risks *durabilityRisks) GetRisks(ctx context.Context, class string, limit int) (entries []durability.Risk, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(risks.db.QueryContext(ctx, risks.db.Rebind(`
+		SELECT class, class_value, node_count, segments_below_repair, segments_irreparable, reported_at
+		FROM durability_risks
+		WHERE class = ?
+		ORDER BY segments_irreparable DESC, segments_below_repair DESC, class_value
+		LIMIT ?
+	`), class, limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var entry durability.Risk
+			var nodeCount int64
+			err := rows.Scan(&entry.Class, &entry.Value, &nodeCount, &entry.SegmentsBelowRepair, &entry.SegmentsIrreparable, &entry.ReportedAt)
+			if err != nil {
+				return err
+			}
+			entry.NodeCount = int(nodeCount)
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	return entries, Error.Wrap(err)
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/durability"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestDurabilityRisks(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		risks := db.DurabilityRisks()
+
+		reportedAt := time.Now().Truncate(time.Second)
+		report := []durability.Risk{
+			{Class: "email", Value: "a@mail.test", NodeCount: 3, SegmentsBelowRepair: 1, SegmentsIrreparable: 0, ReportedAt: reportedAt},
+			{Class: "email", Value: "b@mail.test", NodeCount: 5, SegmentsBelowRepair: 4, SegmentsIrreparable: 2, ReportedAt: reportedAt},
+			{Class: "email", Value: "c@mail.test", NodeCount: 1, SegmentsBelowRepair: 7, SegmentsIrreparable: 0, ReportedAt: reportedAt},
+		}
+		require.NoError(t, risks.ReplaceRisks(ctx, "email", report))
+		require.NoError(t, risks.ReplaceRisks(ctx, "last_net", []durability.Risk{
+			{Class: "last_net", Value: "1.2.3.0", NodeCount: 10, SegmentsBelowRepair: 100, SegmentsIrreparable: 10, ReportedAt: reportedAt},
+		}))
+
+		got, err := risks.GetRisks(ctx, "email", 10)
+		require.NoError(t, err)
+		require.Len(t, got, 3)
+		for i, value := range []string{"b@mail.test", "c@mail.test", "a@mail.test"} {
+			require.Equal(t, value, got[i].Value)
+			require.Equal(t, "email", got[i].Class)
+			require.WithinDuration(t, reportedAt, got[i].ReportedAt, time.Second)
+		}
+		require.Equal(t, 5, got[0].NodeCount)
+		require.EqualValues(t, 4, got[0].SegmentsBelowRepair)
+		require.EqualValues(t, 2, got[0].SegmentsIrreparable)
+
+		got, err = risks.GetRisks(ctx, "email", 1)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+
+		// the new report replaces the old one of the same class only
+		require.NoError(t, risks.ReplaceRisks(ctx, "email", report[:1]))
+		got, err = risks.GetRisks(ctx, "email", 10)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		require.Equal(t, "a@mail.test", got[0].Value)
+
+		got, err = risks.GetRisks(ctx, "last_net", 10)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+
+		require.NoError(t, risks.ReplaceRisks(ctx, "email", nil))
+		got, err = risks.GetRisks(ctx, "email", 10)
+		require.NoError(t, err)
+		require.Empty(t, got)
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/common/uuid"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/metabase/inventory"
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ inventory.DB = (*inventoryReports)(nil)
+
+type inventoryReports struct {
+	db *satelliteDB
+}
+
+// GetGenerated returns when the reports of the buckets were generated for the last time.
+func (reports *inventoryReports) GetGenerated(ctx context.Context) (generated map[metabase.BucketLocation]time.Time, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	generated = map[metabase.BucketLocation]time.Time{}
+	err = withRows(reports.db.QueryContext(ctx, `
+		SELECT project_id, bucket_name, generated_at
+		FROM bucket_inventory_reports
+	`))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var projectID uuid.UUID
+			var bucketName []byte
+			var generatedAt time.Time
+			if err := rows.Scan(&projectID, &bucketName, &generatedAt); err != nil {
+				return err
+			}
+			generated[metabase.BucketLocation{
+				ProjectID:  projectID,
+				BucketName: metabase.BucketName(bucketName),
+			}] = generatedAt
+		}
+		return nil
+	})
+	return generated, Error.Wrap(err)
+}
+
+// SetGenerated records when the report of the bucket was generated.
+func (reports *inventoryReports) SetGenerated(ctx context.Context, bucket metabase.BucketLocation, generatedAt time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch reports.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		_, err = reports.db.ExecContext(ctx, `
+			INSERT INTO bucket_inventory_reports (project_id, bucket_name, generated_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (project_id, bucket_name) DO UPDATE SET generated_at = EXCLUDED.generated_at
+		`, bucket.ProjectID, []byte(bucket.BucketName), generatedAt)
+	case dbutil.Spanner:
+		_, err = reports.db.ExecContext(ctx, `
+			INSERT OR UPDATE INTO bucket_inventory_reports (project_id, bucket_name, generated_at)
+			VALUES (?, ?, ?)
+		`, bucket.ProjectID, []byte(bucket.BucketName), generatedAt)
+	default:
+		return errs.New("unsupported database dialect: %s", reports.db.impl)
+	}
+	return Error.Wrap(err)
+}
@@ -0,0 +1,43 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/metabase"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestInventoryReports(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		reports := db.InventoryReports()
+
+		generated, err := reports.GetGenerated(ctx)
+		require.NoError(t, err)
+		require.Empty(t, generated)
+
+		photos := metabase.BucketLocation{ProjectID: testrand.UUID(), BucketName: "photos"}
+		videos := metabase.BucketLocation{ProjectID: photos.ProjectID, BucketName: "videos"}
+
+		first := time.Now().Truncate(time.Second)
+		require.NoError(t, reports.SetGenerated(ctx, photos, first))
+		require.NoError(t, reports.SetGenerated(ctx, videos, first))
+
+		second := first.Add(24 * time.Hour)
+		require.NoError(t, reports.SetGenerated(ctx, photos, second))
+
+		generated, err = reports.GetGenerated(ctx)
+		require.NoError(t, err)
+		require.Len(t, generated, 2)
+		require.WithinDuration(t, second, generated[photos], time.Second)
+		require.WithinDuration(t, first, generated[videos], time.Second)
+	})
+}
@@ -897,6 +897,115 @@ func (db *satelliteDB) productionMigrationSpanner() *migrate.Migration {
 					`ALTER TABLE users ADD COLUMN hubspot_object_id STRING(MAX)`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add deadline column to repair_queue",
+				Version:     287,
+				Action: migrate.SQL{
+					`ALTER TABLE repair_queue ADD COLUMN deadline TIMESTAMP`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add maintenance window columns to nodes",
+				Version:     288,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN maintenance_start TIMESTAMP`,
+					`ALTER TABLE nodes ADD COLUMN maintenance_end TIMESTAMP`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create table for node history",
+				Version:     289,
+				Action: migrate.SQL{
+					`CREATE TABLE node_history (
+						node_id BYTES(MAX) NOT NULL,
+						recorded_at TIMESTAMP NOT NULL,
+						kind INT64 NOT NULL,
+						address STRING(MAX) NOT NULL,
+						version STRING(MAX) NOT NULL,
+						free_disk INT64 NOT NULL,
+						audit_score FLOAT64 NOT NULL,
+						unknown_audit_score FLOAT64 NOT NULL,
+						online_score FLOAT64 NOT NULL,
+						vetted BOOL NOT NULL,
+						suspended BOOL NOT NULL,
+						exiting BOOL NOT NULL,
+						disqualified BOOL NOT NULL,
+						details STRING(MAX) NOT NULL
+					) PRIMARY KEY ( node_id, recorded_at, kind )`,
+					`CREATE INDEX node_history_recorded_at_index ON node_history ( recorded_at )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create table for durability risk reports",
+				Version:     290,
+				Action: migrate.SQL{
+					`CREATE TABLE durability_risks (
+						class STRING(MAX) NOT NULL,
+						class_value STRING(MAX) NOT NULL,
+						node_count INT64 NOT NULL,
+						segments_below_repair INT64 NOT NULL,
+						segments_irreparable INT64 NOT NULL,
+						reported_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( class, class_value )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create table for bucket inventory report runs",
+				Version:     291,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_inventory_reports (
+						project_id BYTES(MAX) NOT NULL,
+						bucket_name BYTES(MAX) NOT NULL,
+						generated_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( project_id, bucket_name )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add verify queue priority and audit campaign tables",
+				Version:     292,
+				Action: migrate.SQL{
+					`ALTER TABLE verification_audits ADD COLUMN priority INT64 NOT NULL DEFAULT (0)`,
+					`CREATE INDEX verification_audits_priority_index ON verification_audits ( priority )`,
+					`CREATE TABLE audit_campaigns (
+						id BYTES(MAX) NOT NULL,
+						description STRING(MAX) NOT NULL,
+						nodes BYTES(MAX) NOT NULL,
+						segments INT64 NOT NULL,
+						queued INT64 NOT NULL DEFAULT (0),
+						status STRING(MAX) NOT NULL,
+						error STRING(MAX) NOT NULL DEFAULT (""),
+						created_at TIMESTAMP NOT NULL,
+						queued_at TIMESTAMP
+					) PRIMARY KEY ( id )`,
+					`CREATE TABLE audit_campaign_pieces (
+						stream_id BYTES(MAX) NOT NULL,
+						position INT64 NOT NULL,
+						node_id BYTES(MAX) NOT NULL,
+						campaign_id BYTES(MAX) NOT NULL,
+						outcome INT64,
+						audited_at TIMESTAMP
+					) PRIMARY KEY ( stream_id, position, node_id, campaign_id )`,
+					`CREATE INDEX audit_campaign_pieces_campaign_id_index ON audit_campaign_pieces ( campaign_id )`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add placement configurations table",
+				Version:     293,
+				Action: migrate.SQL{
+					`CREATE TABLE placement_configurations (
+						version STRING(MAX) NOT NULL,
+						config BYTES(MAX) NOT NULL,
+						created_at TIMESTAMP NOT NULL
+					) PRIMARY KEY ( version )`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -3636,6 +3745,121 @@ func (db *satelliteDB) productionMigrationPostgres() *migrate.Migration {
 					`ALTER TABLE users ADD COLUMN hubspot_object_id TEXT`,
 				},
 			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add deadline column to repair_queue",
+				Version:     287,
+				Action: migrate.SQL{
+					`ALTER TABLE repair_queue ADD COLUMN deadline timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add maintenance window columns to nodes",
+				Version:     288,
+				Action: migrate.SQL{
+					`ALTER TABLE nodes ADD COLUMN maintenance_start timestamp with time zone;`,
+					`ALTER TABLE nodes ADD COLUMN maintenance_end timestamp with time zone;`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create table for node history",
+				Version:     289,
+				Action: migrate.SQL{
+					`CREATE TABLE node_history (
+						node_id bytea NOT NULL,
+						recorded_at timestamp with time zone NOT NULL,
+						kind integer NOT NULL,
+						address text NOT NULL,
+						version text NOT NULL,
+						free_disk bigint NOT NULL,
+						audit_score double precision NOT NULL,
+						unknown_audit_score double precision NOT NULL,
+						online_score double precision NOT NULL,
+						vetted boolean NOT NULL,
+						suspended boolean NOT NULL,
+						exiting boolean NOT NULL,
+						disqualified boolean NOT NULL,
+						details text NOT NULL,
+						PRIMARY KEY ( node_id, recorded_at, kind )
+					);`,
+					`CREATE INDEX node_history_recorded_at_index ON node_history ( recorded_at );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create table for durability risk reports",
+				Version:     290,
+				Action: migrate.SQL{
+					`CREATE TABLE durability_risks (
+						class text NOT NULL,
+						class_value text NOT NULL,
+						node_count integer NOT NULL,
+						segments_below_repair bigint NOT NULL,
+						segments_irreparable bigint NOT NULL,
+						reported_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( class, class_value )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "create table for bucket inventory report runs",
+				Version:     291,
+				Action: migrate.SQL{
+					`CREATE TABLE bucket_inventory_reports (
+						project_id bytea NOT NULL,
+						bucket_name bytea NOT NULL,
+						generated_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( project_id, bucket_name )
+					);`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add verify queue priority and audit campaign tables",
+				Version:     292,
+				Action: migrate.SQL{
+					`ALTER TABLE verification_audits ADD COLUMN priority integer NOT NULL DEFAULT 0;`,
+					`CREATE INDEX verification_audits_priority_index ON verification_audits ( priority );`,
+					`CREATE TABLE audit_campaigns (
+						id bytea NOT NULL,
+						description text NOT NULL,
+						nodes bytea NOT NULL,
+						segments integer NOT NULL,
+						queued integer NOT NULL DEFAULT 0,
+						status text NOT NULL,
+						error text NOT NULL DEFAULT '',
+						created_at timestamp with time zone NOT NULL,
+						queued_at timestamp with time zone,
+						PRIMARY KEY ( id )
+					);`,
+					`CREATE TABLE audit_campaign_pieces (
+						stream_id bytea NOT NULL,
+						position bigint NOT NULL,
+						node_id bytea NOT NULL,
+						campaign_id bytea NOT NULL,
+						outcome integer,
+						audited_at timestamp with time zone,
+						PRIMARY KEY ( stream_id, position, node_id, campaign_id )
+					);`,
+					`CREATE INDEX audit_campaign_pieces_campaign_id_index ON audit_campaign_pieces ( campaign_id );`,
+				},
+			},
+			{
+				DB:          &db.migrationDB,
+				Description: "add placement configurations table",
+				Version:     293,
+				Action: migrate.SQL{
+					`CREATE TABLE placement_configurations (
+						version text NOT NULL,
+						config bytea NOT NULL,
+						created_at timestamp with time zone NOT NULL,
+						PRIMARY KEY ( version )
+					);`,
+				},
+			},
 			// NB: after updating testdata in `testdata`, run
 			//     `go generate` to update `migratez.go`.
 		},
@@ -21,7 +21,7 @@ func (db *satelliteDB) testMigrationSpanner() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     286,
+				Version:     293,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE account_freeze_events (
@@ -50,6 +50,27 @@ CREATE TABLE accounting_timestamps (
 	value TIMESTAMP NOT NULL
 ) PRIMARY KEY ( name );
 
+CREATE TABLE audit_campaigns (
+	id BYTES(MAX) NOT NULL,
+	description STRING(MAX) NOT NULL,
+	nodes BYTES(MAX) NOT NULL,
+	segments INT64 NOT NULL,
+	queued INT64 NOT NULL DEFAULT (0),
+	status STRING(MAX) NOT NULL,
+	error STRING(MAX) NOT NULL DEFAULT (""),
+	created_at TIMESTAMP NOT NULL,
+	queued_at TIMESTAMP
+) PRIMARY KEY ( id );
+
+CREATE TABLE audit_campaign_pieces (
+	stream_id BYTES(MAX) NOT NULL,
+	position INT64 NOT NULL,
+	node_id BYTES(MAX) NOT NULL,
+	campaign_id BYTES(MAX) NOT NULL,
+	outcome INT64,
+	audited_at TIMESTAMP
+) PRIMARY KEY ( stream_id, position, node_id, campaign_id );
+
 CREATE TABLE billing_balances (
 	user_id BYTES(MAX) NOT NULL,
 	balance INT64 NOT NULL,
@@ -94,6 +115,11 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled INT64 NOT NULL
 ) PRIMARY KEY ( bucket_name, project_id, interval_start, action );
 
+CREATE TABLE bucket_inventory_reports (
+	project_id BYTES(MAX) NOT NULL,
+	bucket_name BYTES(MAX) NOT NULL,
+	generated_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( project_id, bucket_name );
 CREATE TABLE bucket_storage_tallies (
 	bucket_name BYTES(MAX) NOT NULL,
 	project_id BYTES(MAX) NOT NULL,
@@ -120,6 +146,14 @@ CREATE TABLE coinpayments_transactions (
 	created_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( id );
 
+CREATE TABLE durability_risks (
+	class STRING(MAX) NOT NULL,
+	class_value STRING(MAX) NOT NULL,
+	node_count INT64 NOT NULL,
+	segments_below_repair INT64 NOT NULL,
+	segments_irreparable INT64 NOT NULL,
+	reported_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( class, class_value );
 CREATE TABLE graceful_exit_progress (
 	node_id BYTES(MAX) NOT NULL,
 	bytes_transferred INT64 NOT NULL,
@@ -183,7 +217,9 @@ CREATE TABLE nodes (
 	noise_proto INT64,
 	noise_public_key BYTES(MAX),
 	debounce_limit INT64 NOT NULL DEFAULT (0),
-	features INT64 NOT NULL DEFAULT (0)
+	features INT64 NOT NULL DEFAULT (0),
+	maintenance_start TIMESTAMP,
+	maintenance_end TIMESTAMP
 ) PRIMARY KEY ( id );
 
 CREATE TABLE node_api_versions (
@@ -204,6 +240,22 @@ CREATE TABLE node_events (
 	email_sent TIMESTAMP
 ) PRIMARY KEY ( id );
 
+CREATE TABLE node_history (
+	node_id BYTES(MAX) NOT NULL,
+	recorded_at TIMESTAMP NOT NULL,
+	kind INT64 NOT NULL,
+	address STRING(MAX) NOT NULL,
+	version STRING(MAX) NOT NULL,
+	free_disk INT64 NOT NULL,
+	audit_score FLOAT64 NOT NULL,
+	unknown_audit_score FLOAT64 NOT NULL,
+	online_score FLOAT64 NOT NULL,
+	vetted BOOL NOT NULL,
+	suspended BOOL NOT NULL,
+	exiting BOOL NOT NULL,
+	disqualified BOOL NOT NULL,
+	details STRING(MAX) NOT NULL
+) PRIMARY KEY ( node_id, recorded_at, kind ) ;
 CREATE TABLE node_tags (
 	node_id BYTES(MAX) NOT NULL,
 	name STRING(MAX) NOT NULL,
@@ -251,6 +303,12 @@ CREATE TABLE peer_identities (
 	updated_at TIMESTAMP NOT NULL
 ) PRIMARY KEY ( node_id );
 
+CREATE TABLE placement_configurations (
+	version STRING(MAX) NOT NULL,
+	config BYTES(MAX) NOT NULL,
+	created_at TIMESTAMP NOT NULL
+) PRIMARY KEY ( version );
+
 CREATE TABLE projects (
 	id BYTES(MAX) NOT NULL,
 	public_id BYTES(MAX),
@@ -311,7 +369,8 @@ CREATE TABLE repair_queue (
 	updated_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
 	inserted_at TIMESTAMP NOT NULL DEFAULT (current_timestamp),
 	segment_health FLOAT64 NOT NULL DEFAULT (1),
-	placement INT64
+	placement INT64,
+	deadline TIMESTAMP
 ) PRIMARY KEY ( stream_id, position );
 
 CREATE TABLE reputations (
@@ -557,7 +616,8 @@ CREATE TABLE verification_audits (
 	stream_id BYTES(MAX) NOT NULL,
 	position INT64 NOT NULL,
 	expires_at TIMESTAMP,
-	encrypted_size INT64 NOT NULL
+	encrypted_size INT64 NOT NULL,
+	priority INT64 NOT NULL DEFAULT (0)
 ) PRIMARY KEY ( inserted_at, stream_id, position );
 
 CREATE TABLE webapp_sessions (
@@ -641,6 +701,7 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time );
 
+CREATE INDEX audit_campaign_pieces_campaign_id_index ON audit_campaign_pieces ( campaign_id ));
 CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp );
 
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start );
@@ -658,6 +719,7 @@ CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_talli
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at );
 
 CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at );
+CREATE INDEX node_history_recorded_at_index ON node_history ( recorded_at ) ;
 
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id );
 
@@ -705,6 +767,7 @@ CREATE INDEX trial_expiration_index ON users ( trial_expiration );
 
 CREATE INDEX users_external_id_index ON users ( external_id );
 
+CREATE INDEX verification_audits_priority_index ON verification_audits ( priority ));
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id );
 
 CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id );
@@ -727,7 +790,7 @@ func (db *satelliteDB) testMigrationPostgres() *migrate.Migration {
 			{
 				DB:          &db.migrationDB,
 				Description: "Testing setup",
-				Version:     286,
+				Version:     293,
 				Action: migrate.SQL{`-- AUTOGENERATED BY storj.io/dbx
 -- DO NOT EDIT
 CREATE TABLE account_freeze_events (
@@ -756,6 +819,27 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 ) ;
+CREATE TABLE audit_campaigns (
+	id bytea NOT NULL,
+	description text NOT NULL,
+	nodes bytea NOT NULL,
+	segments integer NOT NULL,
+	queued integer NOT NULL DEFAULT 0,
+	status text NOT NULL,
+	error text NOT NULL DEFAULT '',
+	created_at timestamp with time zone NOT NULL,
+	queued_at timestamp with time zone,
+	PRIMARY KEY ( id )
+) ;
+CREATE TABLE audit_campaign_pieces (
+	stream_id bytea NOT NULL,
+	position bigint NOT NULL,
+	node_id bytea NOT NULL,
+	campaign_id bytea NOT NULL,
+	outcome integer,
+	audited_at timestamp with time zone,
+	PRIMARY KEY ( stream_id, position, node_id, campaign_id )
+) ;
 CREATE TABLE billing_balances (
 	user_id bytea NOT NULL,
 	balance bigint NOT NULL,
@@ -798,6 +882,12 @@ CREATE TABLE bucket_bandwidth_rollup_archives (
 	settled bigint NOT NULL,
 	PRIMARY KEY ( bucket_name, project_id, interval_start, action )
 ) ;
+CREATE TABLE bucket_inventory_reports (
+	project_id bytea NOT NULL,
+	bucket_name bytea NOT NULL,
+	generated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id, bucket_name )
+);
 CREATE TABLE bucket_storage_tallies (
 	bucket_name bytea NOT NULL,
 	project_id bytea NOT NULL,
@@ -824,6 +914,15 @@ CREATE TABLE coinpayments_transactions (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 ) ;
+CREATE TABLE durability_risks (
+	class text NOT NULL,
+	class_value text NOT NULL,
+	node_count integer NOT NULL,
+	segments_below_repair bigint NOT NULL,
+	segments_irreparable bigint NOT NULL,
+	reported_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( class, class_value )
+);
 CREATE TABLE graceful_exit_progress (
 	node_id bytea NOT NULL,
 	bytes_transferred bigint NOT NULL,
@@ -888,6 +987,8 @@ CREATE TABLE nodes (
 	noise_public_key bytea,
 	debounce_limit integer NOT NULL DEFAULT 0,
 	features integer NOT NULL DEFAULT 0,
+	maintenance_start timestamp with time zone,
+	maintenance_end timestamp with time zone,
 	PRIMARY KEY ( id )
 ) ;
 CREATE TABLE node_api_versions (
@@ -908,6 +1009,23 @@ CREATE TABLE node_events (
 	email_sent timestamp with time zone,
 	PRIMARY KEY ( id )
 ) ;
+CREATE TABLE node_history (
+	node_id bytea NOT NULL,
+	recorded_at timestamp with time zone NOT NULL,
+	kind integer NOT NULL,
+	address text NOT NULL,
+	version text NOT NULL,
+	free_disk bigint NOT NULL,
+	audit_score double precision NOT NULL,
+	unknown_audit_score double precision NOT NULL,
+	online_score double precision NOT NULL,
+	vetted boolean NOT NULL,
+	suspended boolean NOT NULL,
+	exiting boolean NOT NULL,
+	disqualified boolean NOT NULL,
+	details text NOT NULL,
+	PRIMARY KEY ( node_id, recorded_at, kind )
+) ;
 CREATE TABLE node_tags (
 	node_id bytea NOT NULL,
 	name text NOT NULL,
@@ -955,6 +1073,12 @@ CREATE TABLE peer_identities (
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( node_id )
 ) ;
+CREATE TABLE placement_configurations (
+	version text NOT NULL,
+	config bytea NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( version )
+) ;
 CREATE TABLE projects (
 	id bytea NOT NULL,
 	public_id bytea,
@@ -1015,6 +1139,7 @@ CREATE TABLE repair_queue (
 	inserted_at timestamp with time zone NOT NULL DEFAULT current_timestamp,
 	segment_health double precision NOT NULL DEFAULT 1,
 	placement integer,
+	deadline timestamp with time zone,
 	PRIMARY KEY ( stream_id, position )
 ) ;
 CREATE TABLE reputations (
@@ -1256,6 +1381,7 @@ CREATE TABLE verification_audits (
 	position bigint NOT NULL,
 	expires_at timestamp with time zone,
 	encrypted_size integer NOT NULL,
+	priority integer NOT NULL DEFAULT 0,
 	PRIMARY KEY ( inserted_at, stream_id, position )
 ) ;
 CREATE TABLE webapp_sessions (
@@ -1327,6 +1453,7 @@ CREATE TABLE stripecoinpayments_apply_balance_intents (
 	PRIMARY KEY ( tx_id )
 ) ;
 CREATE INDEX accounting_rollups_start_time_index ON accounting_rollups ( start_time ) ;
+CREATE INDEX audit_campaign_pieces_campaign_id_index ON audit_campaign_pieces ( campaign_id ) ;
 CREATE INDEX billing_transactions_tx_timestamp_index ON billing_transactions ( tx_timestamp ) ;
 CREATE INDEX bucket_bandwidth_rollups_project_id_action_interval_index ON bucket_bandwidth_rollups ( project_id, action, interval_start ) ;
 CREATE INDEX bucket_bandwidth_rollups_action_interval_project_id_index ON bucket_bandwidth_rollups ( action, interval_start, project_id ) ;
@@ -1336,6 +1463,7 @@ CREATE INDEX bucket_storage_tallies_project_id_interval_start_index ON bucket_st
 CREATE INDEX bucket_storage_tallies_interval_start_index ON bucket_storage_tallies ( interval_start ) ;
 CREATE INDEX graceful_exit_segment_transfer_nid_dr_qa_fa_lfa_index ON graceful_exit_segment_transfer_queue ( node_id, durability_ratio, queued_at, finished_at, last_failed_at ) ;
 CREATE INDEX node_events_email_event_created_at_index ON node_events ( email, event, created_at ) WHERE node_events.email_sent is NULL ;
+CREATE INDEX node_history_recorded_at_index ON node_history ( recorded_at ) ;
 CREATE INDEX oauth_clients_user_id_index ON oauth_clients ( user_id ) ;
 CREATE INDEX oauth_codes_user_id_index ON oauth_codes ( user_id ) ;
 CREATE INDEX oauth_codes_client_id_index ON oauth_codes ( client_id ) ;
@@ -1359,6 +1487,7 @@ CREATE INDEX stripecoinpayments_invoice_project_records_unbilled_project_id_inde
 CREATE INDEX users_email_status_index ON users ( normalized_email, status ) ;
 CREATE INDEX trial_expiration_index ON users ( trial_expiration ) ;
 CREATE INDEX users_external_id_index ON users ( external_id ) WHERE users.external_id is not NULL ;
+CREATE INDEX verification_audits_priority_index ON verification_audits ( priority ) ;
 CREATE INDEX webapp_sessions_user_id_index ON webapp_sessions ( user_id ) ;
 CREATE INDEX project_invitations_project_id_index ON project_invitations ( project_id ) ;
 CREATE INDEX project_invitations_email_index ON project_invitations ( email ) ;
@@ -0,0 +1,235 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"storj.io/common/storj"
+	"storj.io/storj/satellite/nodehistory"
+	"storj.io/storj/shared/dbutil"
+	"storj.io/storj/shared/dbutil/pgutil"
+	"storj.io/storj/shared/tagsql"
+)
+
+var _ nodehistory.DB = (*nodeHistory)(nil)
+
+type nodeHistory struct {
+	db *satelliteDB
+}
+
+// RecordSnapshots records the current state of every node which is neither
+// disqualified nor exited, batchSize nodes at a time.
+func (history *nodeHistory) RecordSnapshots(ctx context.Context, recordedAt time.Time, batchSize int) (count int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	var cursor storj.NodeID
+	for {
+		var nodeIDs []storj.NodeID
+		err = withRows(history.db.QueryContext(ctx, history.db.Rebind(`
+			SELECT id FROM nodes
+			WHERE id > ?
+				AND disqualified IS NULL
+				AND exit_finished_at IS NULL
+			ORDER BY id
+			LIMIT ?
+		`), cursor.Bytes(), batchSize))(func(rows tagsql.Rows) error {
+			for rows.Next() {
+				var id storj.NodeID
+				if err := rows.Scan(&id); err != nil {
+					return err
+				}
+				nodeIDs = append(nodeIDs, id)
+			}
+			return nil
+		})
+		if err != nil {
+			return count, Error.Wrap(err)
+		}
+		if len(nodeIDs) == 0 {
+			return count, nil
+		}
+
+		if err := history.record(ctx, nodeIDs, recordedAt, nodehistory.Snapshot, ""); err != nil {
+			return count, err
+		}
+		count += len(nodeIDs)
+
+		if len(nodeIDs) < batchSize {
+			return count, nil
+		}
+		cursor = nodeIDs[len(nodeIDs)-1]
+	}
+}
+
+// RecordTransitions records the current state of the nodes after their state changed.
+func (history *nodeHistory) RecordTransitions(ctx context.Context, nodeIDs []storj.NodeID, recordedAt time.Time, details string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(nodeIDs) == 0 {
+		return nil
+	}
+	return history.record(ctx, nodeIDs, recordedAt, nodehistory.Transition, details)
+}
+
+// record copies the current state of the nodes, from the nodes and the
+// reputations tables, into the node history.
+func (history *nodeHistory) record(ctx context.Context, nodeIDs []storj.NodeID, recordedAt time.Time, kind nodehistory.Kind, details string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch history.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		_, err = history.db.ExecContext(ctx, `
+			INSERT INTO node_history (
+				node_id, recorded_at, kind, address, version, free_disk,
+				audit_score, unknown_audit_score, online_score,
+				vetted, suspended, exiting, disqualified, details
+			)
+			SELECT
+				n.id, $2, $3, n.address, concat('v', n.major, '.', n.minor, '.', n.patch), n.free_disk,
+				COALESCE(r.audit_reputation_alpha / NULLIF(r.audit_reputation_alpha + r.audit_reputation_beta, 0), 1),
+				COALESCE(r.unknown_audit_reputation_alpha / NULLIF(r.unknown_audit_reputation_alpha + r.unknown_audit_reputation_beta, 0), 1),
+				COALESCE(r.online_score, 1),
+				n.vetted_at IS NOT NULL,
+				n.unknown_audit_suspended IS NOT NULL OR n.offline_suspended IS NOT NULL,
+				n.exit_initiated_at IS NOT NULL AND n.exit_finished_at IS NULL,
+				n.disqualified IS NOT NULL,
+				$4
+			FROM nodes n
+			LEFT JOIN reputations r ON r.id = n.id
+			WHERE n.id = ANY($1::bytea[])
+			ON CONFLICT DO NOTHING
+		`, pgutil.NodeIDArray(nodeIDs), recordedAt, int(kind), details)
+	case dbutil.Spanner:
+		_, err = history.db.ExecContext(ctx, `
+			INSERT OR IGNORE INTO node_history (
+				node_id, recorded_at, kind, address, version, free_disk,
+				audit_score, unknown_audit_score, online_score,
+				vetted, suspended, exiting, disqualified, details
+			)
+			SELECT
+				n.id, ?, ?, n.address,
+				CONCAT('v', CAST(n.major AS STRING), '.', CAST(n.minor AS STRING), '.', CAST(n.patch AS STRING)),
+				n.free_disk,
+				COALESCE(r.audit_reputation_alpha / NULLIF(r.audit_reputation_alpha + r.audit_reputation_beta, 0), 1),
+				COALESCE(r.unknown_audit_reputation_alpha / NULLIF(r.unknown_audit_reputation_alpha + r.unknown_audit_reputation_beta, 0), 1),
+				COALESCE(r.online_score, 1),
+				n.vetted_at IS NOT NULL,
+				n.unknown_audit_suspended IS NOT NULL OR n.offline_suspended IS NOT NULL,
+				n.exit_initiated_at IS NOT NULL AND n.exit_finished_at IS NULL,
+				n.disqualified IS NOT NULL,
+				?
+			FROM nodes n
+			LEFT JOIN reputations r ON r.id = n.id
+			WHERE n.id IN UNNEST(?)
+		`, recordedAt, int64(kind), details, storj.NodeIDList(nodeIDs).Bytes())
+	default:
+		return Error.New("unsupported implementation")
+	}
+	return Error.Wrap(err)
+}
+
+// Get returns the entries of a node recorded in [since, before), the most recent first.
+func (history *nodeHistory) Get(ctx context.Context, nodeID storj.NodeID, since, before time.Time, limit int) (entries []nodehistory.Entry, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = withRows(history.db.QueryContext(ctx, history.db.Rebind(`
+		SELECT
+			node_id, recorded_at, kind, address, version, free_disk,
+			audit_score, unknown_audit_score, online_score,
+			vetted, suspended, exiting, disqualified, details
+		FROM node_history
+		WHERE node_id = ?
+			AND recorded_at >= ?
+			AND recorded_at < ?
+		ORDER BY recorded_at DESC, kind DESC
+		LIMIT ?
+	`), nodeID.Bytes(), since, before, limit))(func(rows tagsql.Rows) error {
+		for rows.Next() {
+			var entry nodehistory.Entry
+			err := rows.Scan(
+				&entry.NodeID, &entry.RecordedAt, &entry.Kind, &entry.Address, &entry.Version, &entry.FreeDisk,
+				&entry.AuditScore, &entry.UnknownAuditScore, &entry.OnlineScore,
+				&entry.Vetted, &entry.Suspended, &entry.Exiting, &entry.Disqualified, &entry.Details,
+			)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry)
+		}
+		return nil
+	})
+	return entries, Error.Wrap(err)
+}
+
+// DeleteBefore deletes the entries recorded before the given time.
+func (history *nodeHistory) DeleteBefore(ctx context.Context, before time.Time, batchSize int) (deleted int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if batchSize <= 0 {
+		batchSize = 1000
+	}
+
+	var query string
+	var args []any
+	switch history.db.impl {
+	case dbutil.Cockroach:
+		query = `
+			DELETE FROM node_history
+			WHERE recorded_at < ?
+			LIMIT ?`
+		args = []any{before, batchSize}
+	case dbutil.Postgres:
+		query = `
+			DELETE FROM node_history
+			WHERE ctid IN (
+				SELECT ctid
+				FROM node_history
+				WHERE recorded_at < ?
+				ORDER BY recorded_at
+				LIMIT ?
+			)`
+		args = []any{before, batchSize}
+	case dbutil.Spanner:
+		query = `
+			DELETE FROM node_history
+			WHERE node_id IN (
+					SELECT node_id
+					FROM node_history
+					WHERE recorded_at < ?
+					ORDER BY recorded_at
+					LIMIT ?
+				) AND recorded_at < ?`
+		args = []any{before, batchSize, before}
+	default:
+		return 0, Error.New("unsupported implementation")
+	}
+
+	query = history.db.Rebind(query)
+	for {
+		res, err := history.db.ExecContext(ctx, query, args...)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return deleted, nil
+			}
+			return deleted, Error.Wrap(err)
+		}
+
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return deleted, Error.Wrap(err)
+		}
+		if affected == 0 {
+			return deleted, nil
+		}
+		deleted += affected
+	}
+}
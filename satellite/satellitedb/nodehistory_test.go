@@ -0,0 +1,104 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/pb"
+	"storj.io/common/storj"
+	"storj.io/common/testcontext"
+	"storj.io/common/testrand"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodehistory"
+	"storj.io/storj/satellite/overlay"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestNodeHistory(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		cache := db.OverlayCache()
+		history := db.NodeHistory()
+
+		now := time.Now()
+
+		var nodeIDs []storj.NodeID
+		for i := 0; i < 3; i++ {
+			nodeID := testrand.NodeID()
+			nodeIDs = append(nodeIDs, nodeID)
+			require.NoError(t, cache.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				IsUp:       true,
+				NodeID:     nodeID,
+				Address:    &pb.NodeAddress{Address: fmt.Sprintf("1.2.3.%d:1234", i)},
+				LastNet:    fmt.Sprintf("1.2.3.%d", i),
+				LastIPPort: fmt.Sprintf("1.2.3.%d:1234", i),
+				Version:    &pb.NodeVersion{Version: "v1.2.3"},
+			}, now, overlay.NodeSelectionConfig{}))
+		}
+
+		// disqualification is recorded as a transition
+		_, err := cache.DisqualifyNode(ctx, nodeIDs[0], now, overlay.DisqualificationReasonAuditFailure)
+		require.NoError(t, err)
+
+		entries, err := history.Get(ctx, nodeIDs[0], now.Add(-time.Hour), now.Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+		require.Equal(t, nodeIDs[0], entries[0].NodeID)
+		require.Equal(t, nodehistory.Transition, entries[0].Kind)
+		require.Equal(t, "1.2.3.0:1234", entries[0].Address)
+		require.Equal(t, "v1.2.3", entries[0].Version)
+		require.True(t, entries[0].Disqualified)
+		require.Equal(t, "disqualified: audit failure", entries[0].Details)
+
+		// snapshots skip the disqualified nodes
+		snapshotAt := now.Add(time.Minute)
+		count, err := history.RecordSnapshots(ctx, snapshotAt, 1)
+		require.NoError(t, err)
+		require.Equal(t, 2, count)
+
+		for _, nodeID := range nodeIDs[1:] {
+			entries, err := history.Get(ctx, nodeID, now.Add(-time.Hour), now.Add(time.Hour), 10)
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+			require.Equal(t, nodehistory.Snapshot, entries[0].Kind)
+			require.WithinDuration(t, snapshotAt, entries[0].RecordedAt, time.Second)
+			require.False(t, entries[0].Disqualified)
+			require.False(t, entries[0].Vetted)
+			require.Equal(t, 1.0, entries[0].AuditScore)
+		}
+
+		// the entries are returned the most recent first
+		_, err = history.RecordSnapshots(ctx, now.Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		entries, err = history.Get(ctx, nodeIDs[1], now.Add(-time.Hour), now.Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 2)
+		require.True(t, entries[0].RecordedAt.After(entries[1].RecordedAt))
+
+		entries, err = history.Get(ctx, nodeIDs[1], now.Add(-time.Hour), now.Add(time.Hour), 1)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+
+		entries, err = history.Get(ctx, nodeIDs[1], now.Add(time.Hour), now.Add(2*time.Hour), 10)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		// retention
+		deleted, err := history.DeleteBefore(ctx, now.Add(90*time.Second), 1)
+		require.NoError(t, err)
+		require.EqualValues(t, 3, deleted)
+
+		entries, err = history.Get(ctx, nodeIDs[0], now.Add(-time.Hour), now.Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		entries, err = history.Get(ctx, nodeIDs[1], now.Add(-time.Hour), now.Add(time.Hour), 10)
+		require.NoError(t, err)
+		require.Len(t, entries, 1)
+	})
+}
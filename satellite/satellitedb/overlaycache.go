@@ -83,21 +83,24 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 				AND exit_initiated_at IS NULL
 				AND free_disk >= $1
 				AND last_contact_success > $2
+				AND (maintenance_end IS NULL OR maintenance_start > $3 OR maintenance_end <= $3)
 		`
 		args := []any{
 			// $1
 			selectionCfg.MinimumDiskSpace.Int64(),
 			// $2
 			time.Now().Add(-selectionCfg.OnlineWindow),
+			// $3
+			time.Now(),
 		}
 		if selectionCfg.MinimumVersion != "" {
 			version, err := version.NewSemVer(selectionCfg.MinimumVersion)
 			if err != nil {
 				return nil, nil, err
 			}
-			query += `AND (major > $3 OR (major = $4 AND (minor > $5 OR (minor = $6 AND patch >= $7)))) AND release`
+			query += `AND (major > $4 OR (major = $5 AND (minor > $6 OR (minor = $7 AND patch >= $8)))) AND release`
 			args = append(args,
-				// $3 - $7
+				// $4 - $8
 				version.Major, version.Major, version.Minor, version.Minor, version.Patch,
 			)
 		}
@@ -112,12 +115,16 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 				AND exit_initiated_at IS NULL
 				AND free_disk >= ?
 				AND last_contact_success > ?
+				AND (maintenance_end IS NULL OR maintenance_start > ? OR maintenance_end <= ?)
 		`
+		now := time.Now()
 		args := []any{
 			// $1
 			selectionCfg.MinimumDiskSpace.Int64(),
 			// $2
-			time.Now().Add(-selectionCfg.OnlineWindow),
+			now.Add(-selectionCfg.OnlineWindow),
+			// $3 - $4
+			now, now,
 		}
 		if selectionCfg.MinimumVersion != "" {
 			version, err := version.NewSemVer(selectionCfg.MinimumVersion)
@@ -126,7 +133,7 @@ func (cache *overlaycache) selectAllStorageNodesUpload(ctx context.Context, sele
 			}
 			query += `AND (major > ? OR (major = ? AND (minor > ? OR (minor = ? AND patch >= ?)))) AND release`
 			args = append(args,
-				// $3 - $7
+				// $5 - $9
 				version.Major, version.Major, version.Minor, version.Minor, version.Patch,
 			)
 		}
@@ -310,7 +317,7 @@ func (cache *overlaycache) getOnlineNodesForAuditRepair(ctx context.Context, nod
 	case dbutil.Cockroach, dbutil.Postgres:
 		rows, err = cache.db.Query(ctx, cache.db.Rebind(`
 			SELECT last_net, id, address, email, last_ip_port, noise_proto, noise_public_key, debounce_limit, features,
-				vetted_at, unknown_audit_suspended, offline_suspended
+				vetted_at, unknown_audit_suspended, offline_suspended, maintenance_start, maintenance_end
 			FROM nodes
 			WHERE id = any($1::bytea[])
 				AND disqualified IS NULL
@@ -320,7 +327,7 @@ func (cache *overlaycache) getOnlineNodesForAuditRepair(ctx context.Context, nod
 	case dbutil.Spanner:
 		rows, err = cache.db.Query(ctx, cache.db.Rebind(`
 			SELECT last_net, id, address, email, last_ip_port, noise_proto, noise_public_key, debounce_limit, features,
-			vetted_at, unknown_audit_suspended, offline_suspended
+			vetted_at, unknown_audit_suspended, offline_suspended, maintenance_start, maintenance_end
 			FROM nodes
 			WHERE id IN unnest(?)
 				AND disqualified IS NULL
@@ -342,10 +349,12 @@ func (cache *overlaycache) getOnlineNodesForAuditRepair(ctx context.Context, nod
 
 		var lastIPPort sql.NullString
 		var noise noiseScanner
-		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &node.Reputation.Email, &lastIPPort, &noise.Proto, &noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.Reputation.VettedAt, &node.Reputation.UnknownAuditSuspended, &node.Reputation.OfflineSuspended)
+		var maintenanceStart, maintenanceEnd *time.Time
+		err = rows.Scan(&node.LastNet, &node.ID, &node.Address.Address, &node.Reputation.Email, &lastIPPort, &noise.Proto, &noise.PublicKey, &node.Address.DebounceLimit, &node.Address.Features, &node.Reputation.VettedAt, &node.Reputation.UnknownAuditSuspended, &node.Reputation.OfflineSuspended, &maintenanceStart, &maintenanceEnd)
 		if err != nil {
 			return nil, err
 		}
+		node.Reputation.Maintenance = maintenanceWindow(maintenanceStart, maintenanceEnd)
 		if lastIPPort.Valid {
 			node.LastIPPort = lastIPPort.String
 		}
@@ -651,6 +660,7 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, onlineWind
 	defer mon.Task()(&ctx)(&err)
 
 	var nodes []*nodeselection.SelectedNode
+	now := time.Now()
 
 	switch cache.db.impl {
 	case dbutil.Cockroach, dbutil.Postgres:
@@ -661,12 +671,13 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, onlineWind
 				false AS disqualified,
 				exit_initiated_at IS NOT NULL AS exiting,
 				false AS exited,
-				vetted_at IS NOT NULL AS vetted
+				vetted_at IS NOT NULL AS vetted,
+				COALESCE(maintenance_start <= $2 AND maintenance_end > $2, false) AS planned_offline
 			FROM nodes
 				`+cache.db.impl.AsOfSystemInterval(asOfSystemInterval)+`
 			WHERE disqualified IS NULL
 				AND exit_finished_at IS NULL
-		`, time.Now().Add(-onlineWindow),
+		`, now.Add(-onlineWindow), now,
 		))(func(rows tagsql.Rows) error {
 			for rows.Next() {
 				node, err := scanSelectedNode(rows)
@@ -685,12 +696,13 @@ func (cache *overlaycache) GetParticipatingNodes(ctx context.Context, onlineWind
 				false AS disqualified,
 				exit_initiated_at IS NOT NULL AS exiting,
 				false AS exited,
-				vetted_at IS NOT NULL AS vetted
+				vetted_at IS NOT NULL AS vetted,
+				COALESCE(maintenance_start <= ? AND maintenance_end > ?, false) AS planned_offline
 			FROM nodes
 				`+cache.db.impl.AsOfSystemInterval(asOfSystemInterval)+`
 			WHERE disqualified IS NULL
 				AND exit_finished_at IS NULL
-		`, time.Now().Add(-onlineWindow),
+		`, now.Add(-onlineWindow), now, now,
 		))(func(rows tagsql.Rows) error {
 			for rows.Next() {
 				node, err := scanSelectedNode(rows)
@@ -748,9 +760,9 @@ func scanSelectedNode(rows tagsql.Rows) (nodeselection.SelectedNode, error) {
 	node.Address = &pb.NodeAddress{}
 	var nodeID nullNodeID
 	var address, email, wallet, lastNet, lastIPPort, countryCode sql.NullString
-	var online, suspended, disqualified, exiting, exited, vetted sql.NullBool
+	var online, suspended, disqualified, exiting, exited, vetted, plannedOffline sql.NullBool
 	err := rows.Scan(&nodeID, &address, &email, &wallet, &lastNet, &lastIPPort, &countryCode, &node.PieceCount, &node.FreeDisk,
-		&online, &suspended, &disqualified, &exiting, &exited, &vetted)
+		&online, &suspended, &disqualified, &exiting, &exited, &vetted, &plannedOffline)
 	if err != nil {
 		return nodeselection.SelectedNode{}, err
 	}
@@ -780,6 +792,7 @@ func scanSelectedNode(rows tagsql.Rows) (nodeselection.SelectedNode, error) {
 	node.Suspended = suspended.Bool
 	node.Exiting = exiting.Bool
 	node.Vetted = vetted.Bool
+	node.PlannedOffline = plannedOffline.Bool
 	return node, nil
 }
 
@@ -890,7 +903,16 @@ func (cache *overlaycache) UpdateReputation(ctx context.Context, id storj.NodeID
 	}
 
 	err = cache.db.UpdateNoReturn_Node_By_Id_And_Disqualified_Is_Null_And_ExitFinishedAt_Is_Null(ctx, dbx.Node_Id(id.Bytes()), updateFields)
-	return Error.Wrap(err)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	details := "reputation status changed"
+	if request.Disqualified != nil {
+		details = "disqualified: " + request.DisqualificationReason.String()
+	}
+	cache.recordNodeTransitions(ctx, []storj.NodeID{id}, details)
+	return nil
 }
 
 // UpdateNodeInfo updates the following fields for a given node ID:
@@ -949,6 +971,8 @@ func (cache *overlaycache) DisqualifyNode(ctx context.Context, nodeID storj.Node
 	if dbNode == nil {
 		return "", errs.New("unable to get node by ID: %v", nodeID)
 	}
+
+	cache.recordNodeTransitions(ctx, []storj.NodeID{nodeID}, "disqualified: "+reason.String())
 	return dbNode.Email, nil
 }
 
@@ -1265,6 +1289,19 @@ func (cache *overlaycache) UpdateExitStatus(ctx context.Context, request *overla
 		return nil, Error.Wrap(errs.New("unable to get node by ID: %v", nodeID))
 	}
 
+	var details string
+	switch {
+	case !request.ExitFinishedAt.IsZero() && request.ExitSuccess:
+		details = "graceful exit succeeded"
+	case !request.ExitFinishedAt.IsZero():
+		details = "graceful exit failed"
+	case !request.ExitInitiatedAt.IsZero():
+		details = "graceful exit initiated"
+	default:
+		details = "graceful exit status changed"
+	}
+	cache.recordNodeTransitions(ctx, []storj.NodeID{nodeID}, details)
+
 	return convertDBNode(ctx, dbNode)
 }
 
@@ -1359,10 +1396,19 @@ func convertDBNode(ctx context.Context, info *dbx.Node) (_ *overlay.NodeDossier,
 	if info.Contained != nil {
 		node.Contained = true
 	}
+	node.Maintenance = maintenanceWindow(info.MaintenanceStart, info.MaintenanceEnd)
 
 	return node, nil
 }
 
+// maintenanceWindow returns the maintenance window stored in the nodes table, or nil when there's none.
+func maintenanceWindow(start, end *time.Time) *overlay.MaintenanceWindow {
+	if start == nil || end == nil {
+		return nil
+	}
+	return &overlay.MaintenanceWindow{Start: *start, End: *end}
+}
+
 // encodeWalletFeatures encodes wallet features into comma separated list string.
 func encodeWalletFeatures(features []string) (string, error) {
 	var errGroup errs.Group
@@ -1400,6 +1446,7 @@ func getNodeStats(dbNode *dbx.Node) *overlay.NodeStats {
 			Disqualified:          dbNode.Disqualified,
 			UnknownAuditSuspended: dbNode.UnknownAuditSuspended,
 			OfflineSuspended:      dbNode.OfflineSuspended,
+			Maintenance:           maintenanceWindow(dbNode.MaintenanceStart, dbNode.MaintenanceEnd),
 		},
 	}
 	return nodeStats
@@ -1459,6 +1506,9 @@ func (cache *overlaycache) DQNodesLastSeenBefore(ctx context.Context, cutoff tim
 				AND last_contact_success != '0001-01-01 00:00:00+00'::timestamptz
 			RETURNING id, email, last_contact_success;
 		`), pgutil.NodeIDArray(nodeIDs), cutoff, overlay.DisqualificationReasonNodeOffline))(processRows))
+		if err == nil {
+			cache.recordDQTransitions(ctx, nodeEmails, overlay.DisqualificationReasonNodeOffline)
+		}
 		return nodeEmails, count, err
 	case dbutil.Spanner:
 		// TODO(spanner): it needs to use tx so that go-sql-spanner library can understand that it's not a
@@ -1476,12 +1526,38 @@ func (cache *overlaycache) DQNodesLastSeenBefore(ctx context.Context, cutoff tim
 				THEN RETURN id, email, last_contact_success;
 			`, int64(overlay.DisqualificationReasonNodeOffline), storj.NodeIDList(nodeIDs).Bytes(), cutoff))(processRows)
 		}))
+		if err == nil {
+			cache.recordDQTransitions(ctx, nodeEmails, overlay.DisqualificationReasonNodeOffline)
+		}
 		return nodeEmails, count, err
 	default:
 		return nil, 0, Error.New("unsupported implementation")
 	}
 }
 
+// recordDQTransitions records the node history of the disqualified nodes.
+func (cache *overlaycache) recordDQTransitions(ctx context.Context, nodeEmails map[storj.NodeID]string, reason overlay.DisqualificationReason) {
+	nodeIDs := make([]storj.NodeID, 0, len(nodeEmails))
+	for nodeID := range nodeEmails {
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	cache.recordNodeTransitions(ctx, nodeIDs, "disqualified: "+reason.String())
+}
+
+// recordNodeTransitions records the state of the nodes after a transition. The
+// history is only used for investigations, hence a failure doesn't fail the
+// transition itself.
+func (cache *overlaycache) recordNodeTransitions(ctx context.Context, nodeIDs []storj.NodeID, details string) {
+	history := &nodeHistory{db: cache.db}
+	err := history.RecordTransitions(ctx, nodeIDs, time.Now(), details)
+	if err != nil {
+		cache.db.log.Warn("failed to record node history",
+			zap.String("details", details),
+			zap.Int("nodes", len(nodeIDs)),
+			zap.Error(err))
+	}
+}
+
 func (cache *overlaycache) getNodesForDQLastSeenBefore(ctx context.Context, cutoff time.Time, limit int) (nodes []storj.NodeID, err error) {
 	defer mon.Task()(&ctx)(&err)
 	var rows tagsql.Rows
@@ -1906,6 +1982,29 @@ func (cache *overlaycache) SetNodeContained(ctx context.Context, nodeID storj.No
 	return Error.Wrap(err)
 }
 
+// SetNodeMaintenance sets the planned maintenance window of the node, or clears it when window is nil.
+func (cache *overlaycache) SetNodeMaintenance(ctx context.Context, nodeID storj.NodeID, window *overlay.MaintenanceWindow) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	updateFields := dbx.Node_Update_Fields{
+		MaintenanceStart: dbx.Node_MaintenanceStart_Null(),
+		MaintenanceEnd:   dbx.Node_MaintenanceEnd_Null(),
+	}
+	if window != nil {
+		updateFields.MaintenanceStart = dbx.Node_MaintenanceStart(window.Start.UTC())
+		updateFields.MaintenanceEnd = dbx.Node_MaintenanceEnd(window.End.UTC())
+	}
+
+	dbNode, err := cache.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return overlay.ErrNodeNotFound.New("%v", nodeID)
+	}
+	return nil
+}
+
 // SetAllContainedNodes updates the contained field for all nodes, as necessary.
 // containedNodes is expected to be a set of all nodes that should be contained.
 // All nodes which are in this set but do not already have a non-NULL contained
@@ -195,6 +195,68 @@ func TestSetNodeContained(t *testing.T) {
 	})
 }
 
+func TestSetNodeMaintenance(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		cache := db.OverlayCache()
+
+		now := time.Now()
+		selectionConfig := overlay.NodeSelectionConfig{OnlineWindow: time.Hour}
+
+		var nodeIDs []storj.NodeID
+		for i := 0; i < 2; i++ {
+			nodeID := testrand.NodeID()
+			nodeIDs = append(nodeIDs, nodeID)
+			require.NoError(t, cache.UpdateCheckIn(ctx, overlay.NodeCheckInInfo{
+				IsUp:       true,
+				NodeID:     nodeID,
+				Address:    &pb.NodeAddress{Address: fmt.Sprintf("1.2.3.%d", i)},
+				LastNet:    fmt.Sprintf("1.2.3.%d", i),
+				LastIPPort: fmt.Sprintf("1.2.3.%d:1234", i),
+				Version:    &pb.NodeVersion{Version: "v0.0.0"},
+			}, now, selectionConfig))
+		}
+		inMaintenance, other := nodeIDs[0], nodeIDs[1]
+
+		err := cache.SetNodeMaintenance(ctx, testrand.NodeID(), &overlay.MaintenanceWindow{Start: now, End: now.Add(time.Hour)})
+		require.True(t, overlay.ErrNodeNotFound.Has(err))
+
+		window := overlay.MaintenanceWindow{Start: now.Add(-time.Minute), End: now.Add(time.Hour)}
+		require.NoError(t, cache.SetNodeMaintenance(ctx, inMaintenance, &window))
+
+		dossier, err := cache.Get(ctx, inMaintenance)
+		require.NoError(t, err)
+		require.NotNil(t, dossier.Maintenance)
+		require.WithinDuration(t, window.Start, dossier.Maintenance.Start, time.Second)
+		require.WithinDuration(t, window.End, dossier.Maintenance.End, time.Second)
+
+		// nodes in a maintenance window are not selected for upload
+		reputable, newNodes, err := cache.SelectAllStorageNodesUpload(ctx, selectionConfig)
+		require.NoError(t, err)
+		var selected []storj.NodeID
+		for _, node := range append(reputable, newNodes...) {
+			selected = append(selected, node.ID)
+		}
+		require.ElementsMatch(t, []storj.NodeID{other}, selected)
+
+		participating, err := cache.GetParticipatingNodes(ctx, time.Hour, 0)
+		require.NoError(t, err)
+		require.Len(t, participating, 2)
+		for _, node := range participating {
+			require.Equal(t, node.ID == inMaintenance, node.PlannedOffline)
+		}
+
+		require.NoError(t, cache.SetNodeMaintenance(ctx, inMaintenance, nil))
+
+		dossier, err = cache.Get(ctx, inMaintenance)
+		require.NoError(t, err)
+		require.Nil(t, dossier.Maintenance)
+
+		reputable, newNodes, err = cache.SelectAllStorageNodesUpload(ctx, selectionConfig)
+		require.NoError(t, err)
+		require.Len(t, append(reputable, newNodes...), 2)
+	})
+}
+
 func TestUpdateCheckInDirectUpdate(t *testing.T) {
 	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
 		cache := db.OverlayCache()
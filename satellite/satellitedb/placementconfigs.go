@@ -0,0 +1,60 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/shared/dbutil"
+)
+
+var _ nodeselection.PlacementConfigDB = (*placementConfigs)(nil)
+
+type placementConfigs struct {
+	db *satelliteDB
+}
+
+// Store stores the configuration with its version, as the latest one. Storing
+// an earlier version again makes it the latest.
+func (configs *placementConfigs) Store(ctx context.Context, version string, config []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	switch configs.db.impl {
+	case dbutil.Cockroach, dbutil.Postgres:
+		_, err = configs.db.ExecContext(ctx, `
+			INSERT INTO placement_configurations (version, config, created_at)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (version) DO UPDATE SET created_at = EXCLUDED.created_at
+		`, version, config, time.Now())
+	case dbutil.Spanner:
+		_, err = configs.db.ExecContext(ctx, `
+			INSERT OR UPDATE INTO placement_configurations (version, config, created_at)
+			VALUES (?, ?, ?)
+		`, version, config, time.Now())
+	default:
+		return errs.New("unsupported database dialect: %s", configs.db.impl)
+	}
+	return Error.Wrap(err)
+}
+
+// Latest returns the most recently stored configuration.
+func (configs *placementConfigs) Latest(ctx context.Context) (config []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = configs.db.QueryRowContext(ctx, `
+		SELECT config FROM placement_configurations
+		ORDER BY created_at DESC
+		LIMIT 1
+	`).Scan(&config)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nodeselection.ErrPlacementConfigNotFound.New("")
+	}
+	return config, Error.Wrap(err)
+}
@@ -0,0 +1,41 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"storj.io/common/testcontext"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/nodeselection"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestPlacementConfigs(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		configs := db.PlacementConfigs()
+
+		_, err := configs.Latest(ctx)
+		require.True(t, nodeselection.ErrPlacementConfigNotFound.Has(err))
+
+		first := []byte("placements:\n  - id: 0\n")
+		second := []byte("placements:\n  - id: 0\n  - id: 1\n")
+
+		require.NoError(t, configs.Store(ctx, nodeselection.PlacementVersion(first), first))
+		require.NoError(t, configs.Store(ctx, nodeselection.PlacementVersion(second), second))
+
+		latest, err := configs.Latest(ctx)
+		require.NoError(t, err)
+		require.Equal(t, second, latest)
+
+		// storing an earlier version again makes it the latest.
+		require.NoError(t, configs.Store(ctx, nodeselection.PlacementVersion(first), first))
+
+		latest, err = configs.Latest(ctx)
+		require.NoError(t, err)
+		require.Equal(t, first, latest)
+	})
+}
@@ -31,7 +31,7 @@ type repairQueue struct {
 
 // Stat returns stat of the current queue state.
 func (r *repairQueue) Stat(ctx context.Context) ([]queue.Stat, error) {
-	query := `
+	query := r.db.Rebind(`
         select placement,
             count(1),
             max(inserted_at)  as max_inserted_at,
@@ -39,11 +39,12 @@ func (r *repairQueue) Stat(ctx context.Context) ([]queue.Stat, error) {
             max(attempted_at) as max_attempted_at,
             min(attempted_at) as min_attempted_at,
             max(segment_health) as max_health,
-            min(segment_health) as min_health
+            min(segment_health) as min_health,
+            count(case when deadline < ? then 1 end) as past_deadline
         from repair_queue
-        group by placement, attempted_at is null`
+        group by placement, attempted_at is null`)
 
-	rows, err := r.db.QueryContext(ctx, query)
+	rows, err := r.db.QueryContext(ctx, query, time.Now())
 	if err != nil {
 		return nil, err
 	}
@@ -61,6 +62,7 @@ func (r *repairQueue) Stat(ctx context.Context) ([]queue.Stat, error) {
 			&stat.MinAttemptedAt,
 			&stat.MaxSegmentHealth,
 			&stat.MinSegmentHealth,
+			&stat.PastDeadline,
 		)
 		if err != nil {
 			return res, err
@@ -85,14 +87,14 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health, placement
+				stream_id, position, segment_health, placement, deadline
 			)
 			VALUES (
-				$1, $2, $3, $4
+				$1, $2, $3, $4, $5
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp, placement=$4
+			SET segment_health=$3, updated_at=current_timestamp, placement=$4, deadline=` + earlierDeadline("repair_queue.deadline", "$5::timestamptz") + `
 			RETURNING (xmax != 0) AS alreadyInserted
 		`
 	case dbutil.Cockroach:
@@ -104,20 +106,20 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 			)
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health, placement
+				stream_id, position, segment_health, placement, deadline
 			)
 			VALUES (
-				$1, $2, $3, $4
+				$1, $2, $3, $4, $5
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=$3, updated_at=current_timestamp, placement=$4
+			SET segment_health=$3, updated_at=current_timestamp, placement=$4, deadline=` + earlierDeadline("repair_queue.deadline", "$5::timestamptz") + `
 			RETURNING (SELECT alreadyInserted FROM inserted)
 		`
 	case dbutil.Spanner:
-		query = `UPDATE repair_queue SET segment_health = ?, updated_at = current_timestamp(), placement=? WHERE stream_id=? AND position=?`
+		query = `UPDATE repair_queue SET segment_health = ?, updated_at = current_timestamp(), placement=?, deadline=` + earlierDeadline("deadline", "?") + ` WHERE stream_id=? AND position=?`
 		err = r.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
-			result, txErr := tx.Tx.ExecContext(ctx, query, seg.SegmentHealth, seg.Placement, seg.StreamID, seg.Position)
+			result, txErr := tx.Tx.ExecContext(ctx, query, seg.SegmentHealth, seg.Placement, seg.Deadline, seg.Deadline, seg.StreamID, seg.Position)
 			if txErr != nil {
 				return Error.Wrap(txErr)
 			}
@@ -127,8 +129,8 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 				alreadyInserted = rows > 0
 			}
 			if !alreadyInserted {
-				query = `INSERT OR IGNORE INTO repair_queue (stream_id, position, segment_health, placement) VALUES (?, ?, ?, ?)`
-				if _, txErr = tx.Tx.ExecContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth, seg.Placement); txErr != nil {
+				query = `INSERT OR IGNORE INTO repair_queue (stream_id, position, segment_health, placement, deadline) VALUES (?, ?, ?, ?, ?)`
+				if _, txErr = tx.Tx.ExecContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth, seg.Placement, seg.Deadline); txErr != nil {
 					return Error.Wrap(err)
 				}
 			}
@@ -139,7 +141,7 @@ func (r *repairQueue) Insert(ctx context.Context, seg *queue.InjuredSegment) (al
 		return false, Error.New("unsupported database: %v", r.db.impl)
 	}
 
-	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth, seg.Placement)
+	rows, err := r.db.QueryContext(ctx, query, seg.StreamID, seg.Position.Encode(), seg.SegmentHealth, seg.Placement, seg.Deadline)
 	if err != nil {
 		return false, err
 	}
@@ -179,17 +181,19 @@ func (r *repairQueue) InsertBatch(
 		query = `
 			INSERT INTO repair_queue
 			(
-				stream_id, position, segment_health, placement
+				stream_id, position, segment_health, placement, deadline
 			)
 			VALUES (
 				UNNEST($1::BYTEA[]),
 				UNNEST($2::INT8[]),
 				UNNEST($3::double precision[]),
-				UNNEST($4::INT2[])
+				UNNEST($4::INT2[]),
+				UNNEST($5::timestamptz[])
 			)
 			ON CONFLICT (stream_id, position)
 			DO UPDATE
-			SET segment_health=EXCLUDED.segment_health, updated_at=current_timestamp, placement=EXCLUDED.placement
+			SET segment_health=EXCLUDED.segment_health, updated_at=current_timestamp, placement=EXCLUDED.placement,
+				deadline=` + earlierDeadline("repair_queue.deadline", "EXCLUDED.deadline") + `
 			RETURNING NOT(xmax != 0) AS newlyInserted
 		`
 	case dbutil.Cockroach:
@@ -200,20 +204,22 @@ func (r *repairQueue) InsertBatch(
 					UNNEST($1::BYTEA[]) AS stream_id,
 					UNNEST($2::INT8[]) AS position,
 					UNNEST($3::double precision[]) AS segment_health,
-					UNNEST($4::INT2[]) AS placement
+					UNNEST($4::INT2[]) AS placement,
+					UNNEST($5::timestamptz[]) AS deadline
 			),
 			do_insert AS (
 				INSERT INTO repair_queue (
-					stream_id, position, segment_health, placement
+					stream_id, position, segment_health, placement, deadline
 				)
-				SELECT stream_id, position, segment_health, placement
+				SELECT stream_id, position, segment_health, placement, deadline
 				FROM to_insert
 				ON CONFLICT (stream_id, position)
 				DO UPDATE
 				SET
 					segment_health=EXCLUDED.segment_health,
 					updated_at=current_timestamp,
-					placement=EXCLUDED.placement
+					placement=EXCLUDED.placement,
+					deadline=` + earlierDeadline("repair_queue.deadline", "EXCLUDED.deadline") + `
 				RETURNING false
 			)
 			SELECT
@@ -227,7 +233,7 @@ func (r *repairQueue) InsertBatch(
 	case dbutil.Spanner:
 		err := r.db.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
 			for _, s := range segments {
-				res, err := tx.ExecContext(ctx, "UPDATE repair_queue SET segment_health = ?, placement = ?, updated_at = current_timestamp() where stream_id = ? AND position = ?", s.SegmentHealth, s.Placement, s.StreamID, s.Position.Encode())
+				res, err := tx.ExecContext(ctx, "UPDATE repair_queue SET segment_health = ?, placement = ?, deadline = "+earlierDeadline("deadline", "?")+", updated_at = current_timestamp() where stream_id = ? AND position = ?", s.SegmentHealth, s.Placement, s.Deadline, s.Deadline, s.StreamID, s.Position.Encode())
 				if err != nil {
 					return errs.Wrap(err)
 				}
@@ -238,7 +244,7 @@ func (r *repairQueue) InsertBatch(
 				}
 
 				if affected == 0 {
-					_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO repair_queue (stream_id, position, segment_health, placement, updated_at) VALUES (?, ?, ?, ?, current_timestamp())", s.StreamID, s.Position.Encode(), s.SegmentHealth, s.Placement)
+					_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO repair_queue (stream_id, position, segment_health, placement, deadline, updated_at) VALUES (?, ?, ?, ?, ?, current_timestamp())", s.StreamID, s.Position.Encode(), s.SegmentHealth, s.Placement, s.Deadline)
 					if err != nil {
 						return errs.Wrap(err)
 					}
@@ -259,6 +265,7 @@ func (r *repairQueue) InsertBatch(
 		Positions      []int64
 		SegmentHealths []float64
 		placements     []int16
+		Deadlines      []*time.Time
 	}
 
 	for _, segment := range segments {
@@ -266,6 +273,7 @@ func (r *repairQueue) InsertBatch(
 		insertData.Positions = append(insertData.Positions, int64(segment.Position.Encode()))
 		insertData.SegmentHealths = append(insertData.SegmentHealths, segment.SegmentHealth)
 		insertData.placements = append(insertData.placements, int16(segment.Placement))
+		insertData.Deadlines = append(insertData.Deadlines, segment.Deadline)
 	}
 
 	rows, err := r.db.QueryContext(
@@ -274,6 +282,7 @@ func (r *repairQueue) InsertBatch(
 		pgutil.Int8Array(insertData.Positions),
 		pgutil.Float8Array(insertData.SegmentHealths),
 		pgutil.Int2Array(insertData.placements),
+		pgutil.NullTimestampTZArray(insertData.Deadlines),
 	)
 
 	if err != nil {
@@ -302,21 +311,19 @@ func (r *repairQueue) InsertBatch(
 
 func (r *repairQueue) Select(ctx context.Context, n int, includedPlacements []storj.PlacementConstraint, excludedPlacements []storj.PlacementConstraint) (segments []queue.InjuredSegment, err error) {
 	defer mon.Task()(&ctx)(&err)
-	restriction := ""
+	return r.selectSegments(ctx, n, placementRestriction(includedPlacements, excludedPlacements), false)
+}
 
-	placementsToString := func(placements []storj.PlacementConstraint) string {
-		var ps []string
-		for _, p := range placements {
-			ps = append(ps, fmt.Sprintf("%d", p))
-		}
-		return strings.Join(ps, ",")
-	}
-	if len(includedPlacements) > 0 {
-		restriction += fmt.Sprintf(" AND placement IN (%s)", placementsToString(includedPlacements))
-	}
+// selectSegments marks up to n segments matching the restriction as attempted and returns them.
+// With pastDeadlineFirst, the segments past their deadline are selected before the others, which
+// isn't backed by an index, so it's only used for selecting the segments of a single placement.
+func (r *repairQueue) selectSegments(ctx context.Context, n int, restriction string, pastDeadlineFirst bool) (segments []queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	if len(excludedPlacements) > 0 {
-		restriction += fmt.Sprintf(" AND placement NOT IN (%s)", placementsToString(excludedPlacements))
+	order, spannerOrder := "", ""
+	if pastDeadlineFirst {
+		order = "COALESCE(deadline < now(), false) DESC, "
+		spannerOrder = "COALESCE(deadline < CURRENT_TIMESTAMP(), FALSE) DESC, "
 	}
 
 	scanSegments := func(rows tagsql.Rows) error {
@@ -324,7 +331,7 @@ func (r *repairQueue) Select(ctx context.Context, n int, includedPlacements []st
 			for rows.Next() {
 				var segment queue.InjuredSegment
 				err := rows.Scan(&segment.StreamID, &segment.Position, &segment.AttemptedAt,
-					&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &segment.Placement)
+					&segment.UpdatedAt, &segment.InsertedAt, &segment.SegmentHealth, &segment.Placement, &segment.Deadline)
 				if err != nil {
 					return errs.Wrap(err)
 				}
@@ -341,9 +348,9 @@ func (r *repairQueue) Select(ctx context.Context, n int, includedPlacements []st
 			UPDATE repair_queue SET attempted_at = now() WHERE (stream_id, position) IN (
 				SELECT stream_id, position FROM repair_queue
 				WHERE (attempted_at IS NULL OR attempted_at < now() - interval '6 hours') `+restriction+`
-				ORDER BY segment_health ASC, attempted_at NULLS FIRST FOR UPDATE SKIP LOCKED
+				ORDER BY `+order+`segment_health ASC, attempted_at NULLS FIRST FOR UPDATE SKIP LOCKED
 				LIMIT ?
-			) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement
+			) RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement, deadline
 
 		`), n)
 		if err != nil {
@@ -354,9 +361,9 @@ func (r *repairQueue) Select(ctx context.Context, n int, includedPlacements []st
 		rows, err = r.db.QueryContext(ctx, r.db.Rebind(`
 			UPDATE repair_queue SET attempted_at = now()
 			WHERE (attempted_at IS NULL OR attempted_at < now() - interval '6 hours') `+restriction+`
-			ORDER BY segment_health ASC, attempted_at NULLS FIRST
+			ORDER BY `+order+`segment_health ASC, attempted_at NULLS FIRST
 			LIMIT ?
-			RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement
+			RETURNING stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement, deadline
 
 		`), n)
 		if err != nil {
@@ -373,11 +380,11 @@ func (r *repairQueue) Select(ctx context.Context, n int, includedPlacements []st
 					SELECT stream_id, position
 					FROM repair_queue
 					WHERE (attempted_at IS NULL OR attempted_at < TIMESTAMP_SUB(CURRENT_TIMESTAMP(), INTERVAL 6 HOUR)) `+restriction+`
-					ORDER BY segment_health ASC, attempted_at ASC
+					ORDER BY `+spannerOrder+`segment_health ASC, attempted_at ASC
 					LIMIT ?
 				) AS target_row
 			)
-			THEN RETURN stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement`, n)
+			THEN RETURN stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement, deadline`, n)
 		if err != nil {
 			return nil, errs.Wrap(err)
 		}
@@ -396,6 +403,95 @@ func (r *repairQueue) Select(ctx context.Context, n int, includedPlacements []st
 	return segments, nil
 }
 
+// SelectFair gets injured segments, sharing the limit between the placements of the loads according to their weights.
+func (r *repairQueue) SelectFair(ctx context.Context, n int, weights queue.PlacementWeights, loads []queue.PlacementLoad) (segments []queue.InjuredSegment, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	shares := queue.FairShares(n, weights, loads)
+	for _, load := range loads {
+		share := shares[load.Placement]
+		if share == 0 {
+			continue
+		}
+
+		selected, err := r.selectSegments(ctx, share, placementRestriction([]storj.PlacementConstraint{load.Placement}, nil), true)
+		if err != nil {
+			if queue.ErrEmpty.Has(err) {
+				// segments were selected by another repairer in the meantime.
+				continue
+			}
+			return segments, err
+		}
+		segments = append(segments, selected...)
+	}
+
+	if len(segments) == 0 {
+		return nil, queue.ErrEmpty.New("")
+	}
+
+	return segments, nil
+}
+
+// PlacementLoads returns how many segments of every placement are being repaired and waiting for repair.
+func (r *repairQueue) PlacementLoads(ctx context.Context, includedPlacements []storj.PlacementConstraint, excludedPlacements []storj.PlacementConstraint) (loads []queue.PlacementLoad, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	// Select doesn't return segments which were attempted in the last 6 hours.
+	attemptedBefore := now.Add(-6 * time.Hour)
+
+	rows, err := r.db.QueryContext(ctx, r.db.Rebind(`
+		SELECT placement,
+			COUNT(CASE WHEN attempted_at >= ? THEN 1 END) AS in_flight,
+			COUNT(CASE WHEN attempted_at IS NULL OR attempted_at < ? THEN 1 END) AS waiting,
+			COUNT(CASE WHEN (attempted_at IS NULL OR attempted_at < ?) AND deadline < ? THEN 1 END) AS past_deadline
+		FROM repair_queue
+		WHERE placement IS NOT NULL `+placementRestriction(includedPlacements, excludedPlacements)+`
+		GROUP BY placement
+		ORDER BY placement
+	`), attemptedBefore, attemptedBefore, attemptedBefore, now)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var load queue.PlacementLoad
+		if err := rows.Scan(&load.Placement, &load.InFlight, &load.Waiting, &load.PastDeadline); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		loads = append(loads, load)
+	}
+	return loads, Error.Wrap(rows.Err())
+}
+
+// placementRestriction returns the condition for selecting only the segments of the included placements,
+// which are not excluded.
+func placementRestriction(includedPlacements []storj.PlacementConstraint, excludedPlacements []storj.PlacementConstraint) string {
+	placementsToString := func(placements []storj.PlacementConstraint) string {
+		var ps []string
+		for _, p := range placements {
+			ps = append(ps, fmt.Sprintf("%d", p))
+		}
+		return strings.Join(ps, ",")
+	}
+
+	restriction := ""
+	if len(includedPlacements) > 0 {
+		restriction += fmt.Sprintf(" AND placement IN (%s)", placementsToString(includedPlacements))
+	}
+	if len(excludedPlacements) > 0 {
+		restriction += fmt.Sprintf(" AND placement NOT IN (%s)", placementsToString(excludedPlacements))
+	}
+	return restriction
+}
+
+// earlierDeadline returns an expression which keeps the earlier one of the current and the new deadline,
+// so that re-inserting a segment doesn't postpone its repair.
+func earlierDeadline(current, updated string) string {
+	return `CASE WHEN ` + current + ` IS NULL OR ` + updated + ` < ` + current + ` THEN ` + updated + ` ELSE ` + current + ` END`
+}
+
 func (r *repairQueue) Delete(ctx context.Context, seg queue.InjuredSegment) (err error) {
 	defer mon.Task()(&ctx)(&err)
 	_, err = r.db.ExecContext(ctx, r.db.Rebind(`DELETE FROM repair_queue WHERE stream_id = ? AND position = ?`), seg.StreamID, seg.Position.Encode())
@@ -415,7 +511,7 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 	}
 	// TODO: strictly enforce order-by or change tests
 	rows, err := r.db.QueryContext(ctx,
-		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement
+		r.db.Rebind(`SELECT stream_id, position, attempted_at, updated_at, inserted_at, segment_health, placement, deadline
 					FROM repair_queue LIMIT ?`), limit,
 	)
 	if err != nil {
@@ -426,7 +522,7 @@ func (r *repairQueue) SelectN(ctx context.Context, limit int) (segs []queue.Inju
 	for rows.Next() {
 		var seg queue.InjuredSegment
 		err = rows.Scan(&seg.StreamID, &seg.Position, &seg.AttemptedAt,
-			&seg.UpdatedAt, &seg.InsertedAt, &seg.SegmentHealth, &seg.Placement)
+			&seg.UpdatedAt, &seg.InsertedAt, &seg.SegmentHealth, &seg.Placement, &seg.Deadline)
 		if err != nil {
 			return segs, Error.Wrap(err)
 		}
@@ -459,70 +459,21 @@ func (reputations *reputations) populateUpdateNodeStats(dbNode *dbx.Reputation,
 	// if a node fails enough audits, it gets disqualified
 	// if a node gets enough "unknown" audits, it gets put into suspension
 	// if a node gets enough successful audits, and is in suspension, it gets removed from suspension
-	auditAlpha := dbNode.AuditReputationAlpha
-	auditBeta := dbNode.AuditReputationBeta
-	unknownAuditAlpha := dbNode.UnknownAuditReputationAlpha
-	unknownAuditBeta := dbNode.UnknownAuditReputationBeta
 	totalAuditCount := dbNode.TotalAuditCount
 	vettedAt := dbNode.VettedAt
 
 	logger := reputations.db.log.With(zap.Stringer("Node ID", zapNodeIDBytes(dbNode.Id)))
 
-	// Here we rely on the observation that, conceptually, if we have
-	// collected some list of successes failures while auditing node N
-	// during some short time period, it might reasonably have happened that
-	// the events occurred in a different order.
-	//
-	// That is, if a node passed audit 1, then failed audit 2, then passed
-	// audit 3, it is fair to treat it as if it passed two audits and then
-	// failed one. This is because we expect that the order in which the
-	// events occurred is not very relevant. If a node failed an audit for
-	// piece P at time T, then it likely would also have failed an audit
-	// for the same piece at time T±ε, so we can grade it as though that
-	// had happened.
-	//
-	// There are conditions under which the order of events makes the
-	// difference in whether a node is disqualified or not. To be as fair
-	// as possible, we will not disqualify in those conditions. If a node
-	// remains un-disqualified under any ordering of events, we should not
-	// disqualify it. To that end, we will always apply failures _before_
-	// applying successes. That ordering will always yield the highest
-	// possible result alpha and the lowest possible result beta, assuming
-	// weight > 0 and 0 < λ < 1 (the proof is left as an exercise for the
-	// reader).
-
-	// for audit failure, only update normal alpha/beta
-	auditBeta, auditAlpha = reputation.UpdateReputationMultiple(
-		updates.FailureResults,
-		auditBeta,
-		auditAlpha,
-		config.AuditLambda,
-		config.AuditWeight,
-	)
-	// for audit unknown, only update unknown alpha/beta
-	unknownAuditBeta, unknownAuditAlpha = reputation.UpdateReputationMultiple(
-		updates.UnknownResults,
-		unknownAuditBeta,
-		unknownAuditAlpha,
-		config.UnknownAuditLambda,
-		config.AuditWeight,
-	)
-
-	// for a successful audit, increase reputation for normal *and* unknown audits
-	auditAlpha, auditBeta = reputation.UpdateReputationMultiple(
-		updates.PositiveResults,
-		auditAlpha,
-		auditBeta,
-		config.AuditLambda,
-		config.AuditWeight,
-	)
-	unknownAuditAlpha, unknownAuditBeta = reputation.UpdateReputationMultiple(
-		updates.PositiveResults,
-		unknownAuditAlpha,
-		unknownAuditBeta,
-		config.UnknownAuditLambda,
-		config.AuditWeight,
-	)
+	// failures are applied before successes, to be as fair as possible to
+	// the node (see reputation.BetaModel).
+	rep := config.Model.Get().Apply(reputation.Reputation{
+		AuditAlpha:        dbNode.AuditReputationAlpha,
+		AuditBeta:         dbNode.AuditReputationBeta,
+		UnknownAuditAlpha: dbNode.UnknownAuditReputationAlpha,
+		UnknownAuditBeta:  dbNode.UnknownAuditReputationBeta,
+	}, updates, config)
+	auditAlpha, auditBeta := rep.AuditAlpha, rep.AuditBeta
+	unknownAuditAlpha, unknownAuditBeta := rep.UnknownAuditAlpha, rep.UnknownAuditBeta
 
 	// offline results affect only the total count.
 	updatedTotalAuditCount := totalAuditCount + int64(updates.OfflineResults+updates.UnknownResults+updates.FailureResults+updates.PositiveResults)
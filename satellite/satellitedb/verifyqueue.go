@@ -12,7 +12,6 @@ import (
 
 	"storj.io/common/uuid"
 	"storj.io/storj/satellite/audit"
-	"storj.io/storj/satellite/satellitedb/dbx"
 	"storj.io/storj/shared/dbutil"
 	"storj.io/storj/shared/dbutil/pgutil"
 )
@@ -22,6 +21,9 @@ const (
 	// verification audits. At least this long should elapse between
 	// attempts.
 	VerifyRetryInterval = 4 * time.Hour
+
+	// verifyPriority is the priority of the segments pushed with PushPriority.
+	verifyPriority = 1
 )
 
 // verifyQueue implements storj.io/storj/satellite/audit.VerifyQueue.
@@ -34,6 +36,21 @@ var _ audit.VerifyQueue = (*verifyQueue)(nil)
 func (vq *verifyQueue) Push(ctx context.Context, segments []audit.Segment, maxBatchSize int) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	return vq.push(ctx, segments, maxBatchSize, 0)
+}
+
+// PushPriority adds segments to the queue, which are returned by Next before
+// all segments pushed with Push, regardless of when they were pushed.
+func (vq *verifyQueue) PushPriority(ctx context.Context, segments []audit.Segment, maxBatchSize int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return vq.push(ctx, segments, maxBatchSize, verifyPriority)
+}
+
+// push inserts the segments into the queue with the given priority.
+func (vq *verifyQueue) push(ctx context.Context, segments []audit.Segment, maxBatchSize int, priority int) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	positionSlice := make([]int64, maxBatchSize)
 	expirationSlice := make([]*time.Time, maxBatchSize)
 
@@ -51,12 +68,13 @@ func (vq *verifyQueue) Push(ctx context.Context, segments []audit.Segment, maxBa
 
 		insertFunc = func(streamIDSlice []uuid.UUID, positionSlice []int64, expirationSlice []*time.Time, encryptedSizeSlice []int32) (sql.Result, error) {
 			res, err := vq.db.DB.ExecContext(ctx, `
-					INSERT INTO verification_audits (stream_id, position, expires_at, encrypted_size)
-					SELECT unnest($1::bytea[]), unnest($2::int8[]), unnest($3::timestamptz[]), unnest($4::int4[])`,
+					INSERT INTO verification_audits (stream_id, position, expires_at, encrypted_size, priority)
+					SELECT unnest($1::bytea[]), unnest($2::int8[]), unnest($3::timestamptz[]), unnest($4::int4[]), $5`,
 				pgutil.UUIDArray(streamIDSlice),
 				pgutil.Int8Array(positionSlice),
 				pgutil.NullTimestampTZArray(expirationSlice),
 				pgutil.Int4Array(encryptedSizeSlice),
+				priority,
 			)
 			return res, err
 		}
@@ -111,8 +129,9 @@ func (vq *verifyQueue) Push(ctx context.Context, segments []audit.Segment, maxBa
 			}
 
 			res, err := vq.db.DB.ExecContext(ctx, `
-				INSERT INTO verification_audits (stream_id, position, expires_at, encrypted_size)
-				(SELECT StreamID, Position, Expiration, EncryptedSize FROM UNNEST(?))`, audits,
+				INSERT INTO verification_audits (stream_id, position, expires_at, encrypted_size, priority)
+				(SELECT StreamID, Position, Expiration, EncryptedSize, ? FROM UNNEST(?))`,
+				priority, audits,
 			)
 			return res, err
 		}
@@ -147,76 +166,49 @@ func (vq *verifyQueue) Push(ctx context.Context, segments []audit.Segment, maxBa
 	}
 }
 
+// Next removes the next segment from the queue and returns it. The segments
+// with a priority are returned first.
 func (vq *verifyQueue) Next(ctx context.Context) (seg audit.Segment, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var getQuery string
 	switch vq.db.impl {
 	case dbutil.Postgres:
-		getQuery = `
-			WITH next_row AS (
-				SELECT inserted_at, stream_id, position
-				FROM verification_audits
-				ORDER BY inserted_at, stream_id, position
-				FOR UPDATE SKIP LOCKED
-				LIMIT 1
-			)
+		err = vq.db.DB.QueryRowContext(ctx, `
+			WITH next_row AS (`+nextVerifyRow("FOR UPDATE SKIP LOCKED")+`)
 			DELETE FROM verification_audits v
 				USING next_row
 			WHERE v.inserted_at = next_row.inserted_at
 				AND v.stream_id = next_row.stream_id
 				AND v.position = next_row.position
-			RETURNING v.stream_id, v.position, v.expires_at, v.encrypted_size
-		`
-		err = vq.db.DB.QueryRowContext(ctx, getQuery).Scan(&seg.StreamID, &seg.Position, &seg.ExpiresAt, &seg.EncryptedSize)
+			RETURNING v.stream_id, v.position, v.expires_at, v.encrypted_size, v.priority
+		`).Scan(&seg.StreamID, &seg.Position, &seg.ExpiresAt, &seg.EncryptedSize, &seg.Priority)
 
 	case dbutil.Cockroach:
 		// Note: because Cockroach does not support SKIP LOCKED, this implementation
 		// is likely much less performant under any amount of contention.
-		getQuery = `
-			WITH next_row AS (
-				SELECT inserted_at, stream_id, position
-				FROM verification_audits
-				ORDER BY inserted_at, stream_id, position
-				FOR UPDATE
-				LIMIT 1
-			)
+		err = vq.db.DB.QueryRowContext(ctx, `
+			WITH next_row AS (`+nextVerifyRow("FOR UPDATE")+`)
 			DELETE FROM verification_audits v
 			WHERE v.inserted_at = (SELECT inserted_at FROM next_row)
 				AND v.stream_id = (SELECT stream_id FROM next_row)
 				AND v.position = (SELECT position FROM next_row)
-			RETURNING v.stream_id, v.position, v.expires_at, v.encrypted_size
-		`
-		err = vq.db.DB.QueryRowContext(ctx, getQuery).Scan(&seg.StreamID, &seg.Position, &seg.ExpiresAt, &seg.EncryptedSize)
+			RETURNING v.stream_id, v.position, v.expires_at, v.encrypted_size, v.priority
+		`).Scan(&seg.StreamID, &seg.Position, &seg.ExpiresAt, &seg.EncryptedSize, &seg.Priority)
 
 	case dbutil.Spanner:
-		err = vq.db.DB.WithTx(ctx, func(ctx context.Context, tx *dbx.Tx) error {
-			// Note - Spanner does not support a with clause when deleting rows.
-			// For which two queries are used to accomplish this
-			getQuery = `SELECT inserted_at, stream_id, position, expires_at, encrypted_size
-			FROM verification_audits
-			ORDER BY inserted_at, stream_id, position
-			LIMIT 1`
-
-			row := tx.Tx.QueryRow(ctx, getQuery)
-
-			var insertedAt time.Time
-
-			err = row.Scan(&insertedAt, &seg.StreamID, &seg.Position, &seg.ExpiresAt, &seg.EncryptedSize)
-
-			if err == nil {
-				query := `DELETE FROM verification_audits
-				WHERE inserted_at = ?
-				 AND stream_id = ?
-				 AND position = ?`
-				_, err = tx.Tx.ExecContext(ctx, query, insertedAt, seg.StreamID, seg.Position)
-			}
-			return err
-		})
+		// Note - Spanner does not support a with clause when deleting rows.
+		err = vq.db.DB.QueryRowContext(ctx, `
+			DELETE FROM verification_audits
+			WHERE (inserted_at, stream_id, position) IN (
+				SELECT (inserted_at, stream_id, position)
+				FROM (`+nextVerifyRow("")+`) AS next_row
+			)
+			THEN RETURN stream_id, position, expires_at, encrypted_size, priority
+		`).Scan(&seg.StreamID, &seg.Position, &seg.ExpiresAt, &seg.EncryptedSize, &seg.Priority)
+
 	default:
-		err = errors.New("unsupported database implementation")
+		return audit.Segment{}, Error.New("unsupported database implementation")
 	}
-
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return audit.Segment{}, audit.ErrEmptyQueue.Wrap(err)
@@ -227,3 +219,35 @@ func (vq *verifyQueue) Next(ctx context.Context) (seg audit.Segment, err error)
 	mon.Counter("audit_verify_queue_segments_deleted").Dec(1)
 	return seg, nil
 }
+
+// nextVerifyRow returns the query selecting the key of the next segment of
+// the queue: the oldest segment with the highest priority, or the oldest
+// segment when no segment has a priority. Both candidates are selected with
+// an index, so checking the segments with a priority doesn't scan the queue.
+func nextVerifyRow(lock string) string {
+	return `
+		SELECT inserted_at, stream_id, position
+		FROM (
+			SELECT 0 AS candidate, inserted_at, stream_id, position
+			FROM (
+				SELECT inserted_at, stream_id, position
+				FROM verification_audits
+				WHERE priority > 0
+				ORDER BY priority DESC, inserted_at, stream_id, position
+				` + lock + `
+				LIMIT 1
+			) AS prioritized
+			UNION ALL
+			SELECT 1 AS candidate, inserted_at, stream_id, position
+			FROM (
+				SELECT inserted_at, stream_id, position
+				FROM verification_audits
+				ORDER BY inserted_at, stream_id, position
+				` + lock + `
+				LIMIT 1
+			) AS oldest
+		) AS candidates
+		ORDER BY candidate
+		LIMIT 1
+	`
+}
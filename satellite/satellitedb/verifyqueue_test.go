@@ -114,3 +114,35 @@ func TestMultipleBatches(t *testing.T) {
 		}
 	})
 }
+
+func TestVerifyQueuePriority(t *testing.T) {
+	satellitedbtest.Run(t, func(ctx *testcontext.Context, t *testing.T, db satellite.DB) {
+		verifyQueue := db.VerifyQueue()
+
+		segments := make([]audit.Segment, 4)
+		for i := range segments {
+			segments[i].StreamID = testrand.UUID()
+			segments[i].Position = metabase.SegmentPositionFromEncoded(rand.Uint64())
+			segments[i].EncryptedSize = rand.Int31()
+		}
+
+		require.NoError(t, verifyQueue.Push(ctx, segments[0:2], 10))
+		require.NoError(t, verifyQueue.PushPriority(ctx, segments[2:4], 10))
+
+		// the segments pushed with priority are returned first.
+		sort.Sort(audit.ByStreamIDAndPosition(segments[0:2]))
+		sort.Sort(audit.ByStreamIDAndPosition(segments[2:4]))
+		expected := append(append([]audit.Segment{}, segments[2:4]...), segments[0:2]...)
+
+		for i, segment := range expected {
+			popped, err := verifyQueue.Next(ctx)
+			require.NoError(t, err)
+			require.Equal(t, segment.StreamID, popped.StreamID)
+			require.Equal(t, segment.Position, popped.Position)
+			require.Equal(t, i < 2, popped.Priority > 0)
+		}
+
+		_, err := verifyQueue.Next(ctx)
+		require.True(t, audit.ErrEmptyQueue.Has(err))
+	})
+}
@@ -19,6 +19,8 @@ import (
 
 const (
 	version1 = 1
+	// version2 additionally contains the piece ID prefix range of the filter.
+	version2 = 2
 )
 
 // rangeOffsets contains offsets for selecting subranges
@@ -31,11 +33,18 @@ func GenerateSeed() byte {
 }
 
 // Filter is a bloom filter implementation.
+//
+// A filter can be limited to the piece IDs whose first byte is in an inclusive
+// prefix range. The piece IDs outside of the range are not tracked by the
+// filter, so they are always reported as possibly contained.
 type Filter struct {
 	seed      byte
 	hashCount byte
 	table     []byte
 
+	prefixFirst byte
+	prefixLast  byte
+
 	offset      byte
 	rangeOffset byte
 	tableSize   fastdiv.Uint64
@@ -50,6 +59,8 @@ func NewExplicit(seed, hashCount byte, sizeInBytes int) *Filter {
 		hashCount: hashCount,
 		table:     make([]byte, sizeInBytes),
 
+		prefixLast: math.MaxUint8,
+
 		offset:      offset,
 		rangeOffset: rangeOffset,
 		tableSize:   fastdiv.NewUint64(uint64(sizeInBytes)),
@@ -82,6 +93,28 @@ func (filter *Filter) SeedAndParameters() (seed, hashCount byte, size int) {
 	return filter.seed, filter.hashCount, len(filter.table)
 }
 
+// SetPrefixRange limits the filter to the piece IDs whose first byte is
+// between first and last, inclusive.
+func (filter *Filter) SetPrefixRange(first, last byte) {
+	filter.prefixFirst, filter.prefixLast = first, last
+}
+
+// PrefixRange returns the inclusive range of the first byte of the piece IDs
+// tracked by the filter.
+func (filter *Filter) PrefixRange() (first, last byte) {
+	return filter.prefixFirst, filter.prefixLast
+}
+
+// InPrefixRange returns true if the piece ID is in the prefix range of the filter.
+func (filter *Filter) InPrefixRange(pieceID storj.PieceID) bool {
+	return filter.prefixFirst <= pieceID[0] && pieceID[0] <= filter.prefixLast
+}
+
+// fullRange returns true if the filter tracks all piece IDs.
+func (filter *Filter) fullRange() bool {
+	return filter.prefixFirst == 0 && filter.prefixLast == math.MaxUint8
+}
+
 // Add adds an element to the bloom filter.
 func (filter *Filter) Add(pieceID storj.PieceID) {
 	var id [len(pieceID) * 2]byte
@@ -97,8 +130,13 @@ func (filter *Filter) Add(pieceID storj.PieceID) {
 	}
 }
 
-// Contains return true if pieceID may be in the set.
+// Contains return true if pieceID may be in the set. Piece IDs outside of the
+// prefix range of the filter are always reported as contained.
 func (filter *Filter) Contains(pieceID storj.PieceID) bool {
+	if !filter.InPrefixRange(pieceID) {
+		return true
+	}
+
 	var id [len(pieceID) * 2]byte
 	copy(id[:], pieceID[:])
 	copy(id[len(pieceID):], pieceID[:])
@@ -132,6 +170,9 @@ func (filter *Filter) AddFilter(operand *Filter) error {
 		return errs.New("cannot merge: mismatched hash count: expected %d but got %d", filter.hashCount, operand.hashCount)
 	case len(filter.table) != len(operand.table):
 		return errs.New("cannot merge: mismatched table size: expected %d but got %d", len(filter.table), len(operand.table))
+	case filter.prefixFirst != operand.prefixFirst || filter.prefixLast != operand.prefixLast:
+		return errs.New("cannot merge: mismatched prefix range: expected %d-%d but got %d-%d",
+			filter.prefixFirst, filter.prefixLast, operand.prefixFirst, operand.prefixLast)
 	}
 	for i := 0; i < len(filter.table); i++ {
 		filter.table[i] |= operand.table[i]
@@ -146,18 +187,31 @@ func NewFromBytes(data []byte) (*Filter, error) {
 	if len(data) < 3 {
 		return nil, errs.New("not enough data")
 	}
-	if data[0] != version1 {
-		return nil, errs.New("unsupported version %d", data[0])
-	}
 
 	filter := &Filter{}
 	filter.seed = data[1]
 	filter.hashCount = data[2]
-	filter.table = data[3:]
+
+	switch data[0] {
+	case version1:
+		filter.prefixFirst, filter.prefixLast = 0, math.MaxUint8
+		filter.table = data[3:]
+	case version2:
+		if len(data) < 5 {
+			return nil, errs.New("not enough data")
+		}
+		filter.prefixFirst, filter.prefixLast = data[3], data[4]
+		filter.table = data[5:]
+	default:
+		return nil, errs.New("unsupported version %d", data[0])
+	}
 
 	if filter.hashCount == 0 {
 		return nil, errs.New("invalid hash count %d", filter.hashCount)
 	}
+	if filter.prefixFirst > filter.prefixLast {
+		return nil, errs.New("invalid prefix range %d-%d", filter.prefixFirst, filter.prefixLast)
+	}
 
 	filter.offset, filter.rangeOffset = initialConditions(filter.seed)
 	filter.tableSize = fastdiv.NewUint64(uint64(len(filter.table)))
@@ -175,19 +229,38 @@ func (filter *Filter) FillRate() float64 {
 }
 
 // Bytes encodes the filter into a sequence of bytes that can be transferred on network.
+//
+// Filters of all piece IDs are encoded with version 1, so older storage nodes
+// can decode them. Filters with a prefix range are encoded with version 2, which
+// older nodes reject, so they must only be sent to nodes supporting it.
 func (filter *Filter) Bytes() []byte {
-	bytes := make([]byte, 1+1+1+len(filter.table))
-	bytes[0] = version1
+	if filter.fullRange() {
+		bytes := make([]byte, 1+1+1+len(filter.table))
+		bytes[0] = version1
+		bytes[1] = filter.seed
+		bytes[2] = filter.hashCount
+		copy(bytes[3:], filter.table)
+		return bytes
+	}
+
+	bytes := make([]byte, 1+1+1+2+len(filter.table))
+	bytes[0] = version2
 	bytes[1] = filter.seed
 	bytes[2] = filter.hashCount
-	copy(bytes[3:], filter.table)
+	bytes[3] = filter.prefixFirst
+	bytes[4] = filter.prefixLast
+	copy(bytes[5:], filter.table)
 	return bytes
 }
 
 // Size returns the size of Bytes call.
 func (filter *Filter) Size() int64 {
-	// the first three bytes represent the version, seed, and hash count
-	return int64(1 + 1 + 1 + len(filter.table))
+	if filter.fullRange() {
+		// the first three bytes represent the version, seed, and hash count
+		return int64(1 + 1 + 1 + len(filter.table))
+	}
+	// version 2 additionally contains the first and the last prefix
+	return int64(1 + 1 + 1 + 2 + len(filter.table))
 }
 
 // OptimalParameters returns the optimal parameters for the given expected
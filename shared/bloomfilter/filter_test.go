@@ -55,6 +55,8 @@ func TestBytes_Failing(t *testing.T) {
 		{1},
 		{1, 0},
 		{255, 10, 10, 10},
+		{2, 10, 10, 0},
+		{2, 10, 10, 20, 10, 0},
 	}
 	for _, bytes := range failing {
 		_, err := bloomfilter.NewFromBytes(bytes)
@@ -62,6 +64,50 @@ func TestBytes_Failing(t *testing.T) {
 	}
 }
 
+func TestPrefixRange(t *testing.T) {
+	pieceIDs := generateTestIDs(10000)
+
+	filter := bloomfilter.NewOptimal(int64(len(pieceIDs)), 0.1)
+	filter.SetPrefixRange(64, 127)
+
+	first, last := filter.PrefixRange()
+	require.Equal(t, byte(64), first)
+	require.Equal(t, byte(127), last)
+
+	for _, pieceID := range pieceIDs {
+		if filter.InPrefixRange(pieceID) {
+			filter.Add(pieceID)
+		}
+	}
+
+	outside := 0
+	for _, pieceID := range generateTestIDs(10000) {
+		if !filter.InPrefixRange(pieceID) {
+			// pieces outside of the range are never reported as garbage.
+			require.True(t, filter.Contains(pieceID))
+			outside++
+		}
+	}
+	require.NotZero(t, outside)
+
+	bytes := filter.Bytes()
+	require.EqualValues(t, 2, bytes[0])
+	require.EqualValues(t, len(bytes), filter.Size())
+
+	unmarshaled, err := bloomfilter.NewFromBytes(bytes)
+	require.NoError(t, err)
+	require.Equal(t, filter, unmarshaled)
+
+	for _, pieceID := range pieceIDs {
+		require.True(t, unmarshaled.Contains(pieceID))
+	}
+
+	full := bloomfilter.NewOptimal(100, 0.1)
+	full.SetPrefixRange(0, 255)
+	require.EqualValues(t, 1, full.Bytes()[0])
+	require.EqualValues(t, len(full.Bytes()), full.Size())
+}
+
 // generateTestIDs generates n piece ids.
 func generateTestIDs(n int) []storj.PieceID {
 	ids := make([]storj.PieceID, n)
@@ -196,6 +242,13 @@ func TestAddFilter_Bad(t *testing.T) {
 		err := filter1.AddFilter(filter2)
 		require.EqualError(t, err, "cannot merge: mismatched table size: expected 300 but got 400")
 	})
+	t.Run("mismatched prefix range", func(t *testing.T) {
+		filter1 := bloomfilter.NewExplicit(100, 4, 300)
+		filter2 := bloomfilter.NewExplicit(100, 4, 300)
+		filter2.SetPrefixRange(0, 127)
+		err := filter1.AddFilter(filter2)
+		require.EqualError(t, err, "cannot merge: mismatched prefix range: expected 0-255 but got 0-127")
+	})
 }
 
 type stats struct {
@@ -21,6 +21,7 @@ import (
 	"storj.io/common/rpc"
 	"storj.io/common/storj"
 	"storj.io/common/version"
+	"storj.io/storj/private/pieceauditpb"
 	"storj.io/storj/private/revocation"
 	"storj.io/storj/private/server"
 	"storj.io/storj/private/version/checker"
@@ -419,6 +420,9 @@ func Module(ball *mud.Ball) {
 		if err := pb.DRPCRegisterReplaySafePiecestore(srv.ReplaySafeDRPC(), piecestoreEndpoint); err != nil {
 			return nil, err
 		}
+		if err := pieceauditpb.DRPCRegisterPieceAudit(srv.DRPC(), piecestoreEndpoint); err != nil {
+			return nil, err
+		}
 		return &EndpointRegistration{}, nil
 	})
 	mud.Tag[*EndpointRegistration, modular.Service](ball, modular.Service{})
@@ -0,0 +1,105 @@
+// Copyright (C) 2025 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package piecestore
+
+import (
+	"context"
+	"io"
+	"io/fs"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/common/pb"
+	"storj.io/common/rpc/rpcstatus"
+	"storj.io/drpc"
+	"storj.io/storj/private/pieceauditpb"
+)
+
+// maxProveRanges is the maximum number of ranges challenged by a single request.
+const maxProveRanges = 1024
+
+// ProveRanges answers a random range audit of the satellite. It reads the
+// ranges of the piece chosen by the seed of the satellite and returns their
+// XOR, which the satellite checks against the proofs of the other pieces.
+func (endpoint *Endpoint) ProveRanges(ctx context.Context, req *pieceauditpb.ProveRangesRequest) (_ *pieceauditpb.ProveRangesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if endpoint.proveRangesDisabled.Load() {
+		// this is the error of the RPC server for unknown methods.
+		return nil, drpc.ProtocolError.New("unknown rpc: %q", "/pieceaudit.PieceAudit/ProveRanges")
+	}
+
+	limit := &pb.OrderLimit{}
+	if err := pb.Unmarshal(req.Limit, limit); err != nil {
+		return nil, rpcstatus.NamedWrap("invalid-order-limit", rpcstatus.InvalidArgument, err)
+	}
+	if limit.Action != pb.PieceAction_GET_AUDIT {
+		return nil, rpcstatus.NamedErrorf("wrong-action", rpcstatus.InvalidArgument,
+			"expected audit action got %v", limit.Action)
+	}
+	switch {
+	case req.RangeSize <= 0 || int64(req.RangeSize) > limit.Limit:
+		return nil, rpcstatus.NamedErrorf("invalid-range-size", rpcstatus.InvalidArgument,
+			"range size %d is not within the order limit %d", req.RangeSize, limit.Limit)
+	case req.RangeCount <= 0 || req.RangeCount > maxProveRanges:
+		return nil, rpcstatus.NamedErrorf("invalid-range-count", rpcstatus.InvalidArgument,
+			"range count %d is not between 1 and %d", req.RangeCount, maxProveRanges)
+	case req.NumRanges <= 0:
+		return nil, rpcstatus.NamedErrorf("invalid-num-ranges", rpcstatus.InvalidArgument,
+			"number of ranges %d is not positive", req.NumRanges)
+	}
+
+	if err := endpoint.verifyOrderLimit(ctx, limit); err != nil {
+		return nil, err
+	}
+
+	log := endpoint.log.With(
+		zap.Stringer("Piece ID", limit.PieceId),
+		zap.Stringer("Satellite ID", limit.SatelliteId),
+		zap.Int64("Seed", req.Seed),
+		zap.Int32("Range Count", req.RangeCount))
+
+	pieceReader, err := endpoint.pieceBackend.Reader(ctx, limit.SatelliteId, limit.PieceId)
+	if err != nil {
+		if errs.Is(err, fs.ErrNotExist) {
+			return nil, rpcstatus.NamedWrap("file-not-found", rpcstatus.NotFound, err)
+		}
+		return nil, rpcstatus.NamedWrap("open-failed", rpcstatus.Internal, err)
+	}
+	defer func() {
+		if err := pieceReader.Close(); err != nil {
+			log.Error("failed to close piece reader", zap.Error(err))
+		}
+	}()
+
+	if int64(req.NumRanges)*int64(req.RangeSize) > pieceReader.Size() {
+		return nil, rpcstatus.NamedErrorf("file-size-exceeded", rpcstatus.InvalidArgument,
+			"challenged more data than available, requesting=%v available=%v",
+			int64(req.NumRanges)*int64(req.RangeSize), pieceReader.Size())
+	}
+
+	proof := make([]byte, req.RangeSize)
+	buf := make([]byte, req.RangeSize)
+	for _, index := range pieceauditpb.Ranges(req.Seed, req.NumRanges, int(req.RangeCount)) {
+		if _, err := pieceReader.Seek(int64(index)*int64(req.RangeSize), io.SeekStart); err != nil {
+			return nil, rpcstatus.NamedWrap("seek-failed", rpcstatus.Internal, err)
+		}
+		if _, err := io.ReadFull(pieceReader, buf); err != nil {
+			return nil, rpcstatus.NamedWrap("read-failed", rpcstatus.Internal, err)
+		}
+		pieceauditpb.AddToProof(proof, buf)
+	}
+
+	log.Debug("proved ranges")
+	mon.Meter("prove_ranges_success").Mark(1)
+
+	return &pieceauditpb.ProveRangesResponse{Proof: proof}, nil
+}
+
+// TestingDisableProveRanges makes ProveRanges fail like on older nodes, which
+// don't implement it.
+func (endpoint *Endpoint) TestingDisableProveRanges(disabled bool) {
+	endpoint.proveRangesDisabled.Store(disabled)
+}
@@ -115,6 +115,10 @@ type Endpoint struct {
 	pieceBackend PieceBackend
 
 	liveRequests int32
+
+	// proveRangesDisabled makes the node behave like older nodes, which
+	// don't know ProveRanges.
+	proveRangesDisabled atomic.Bool
 }
 
 // QueueRetain is an interface for retaining pieces in the queue and checking status.
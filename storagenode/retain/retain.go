@@ -403,9 +403,14 @@ func (s *Service) retainPieces(ctx context.Context, req Request) (err error) {
 	mon.IntVal("garbage_collection_filter_size").Observe(filter.Size())
 	mon.IntVal("garbage_collection_started").Observe(startedAt.Unix())
 
+	// pieces outside of the prefix range of the filter are always retained.
+	prefixFirst, prefixLast := filter.PrefixRange()
+
 	s.log.Info("Prepared to run a Retain request.",
 		zap.Time("Created Before", createdBefore),
 		zap.Int64("Filter Size", filter.Size()),
+		zap.Uint8("Prefix First", prefixFirst),
+		zap.Uint8("Prefix Last", prefixLast),
 		zap.Stringer("Satellite ID", satelliteID))
 
 	piecesToDeleteCount := 0
@@ -6,7 +6,7 @@ import {
     BucketCursor,
     BucketMetadata,
     BucketPage,
-    BucketsApi, PlacementDetails,
+    BucketsApi, PlacementDetails, PlacementRegion,
 } from '@/types/buckets';
 import { HttpClient } from '@/utils/httpClient';
 import { APIError } from '@/utils/error';
@@ -201,6 +201,11 @@ export class BucketsHttpApi implements BucketsApi {
             detail.name,
             detail.title,
             detail.description,
+            detail.regions?.map(region => new PlacementRegion(
+                region.name,
+                region.description,
+                region.countries || [],
+            )) || [],
         )) || [];
     }
 }
@@ -13,6 +13,7 @@ export class APIKeyInfo {
     name: string;
     createdAt: Time;
     version: number;
+    rateLimits?: OperationRateLimits | null;
 }
 
 export class APIKeyPage {
@@ -51,6 +52,12 @@ export class CreateAPIKeyResponse {
     keyInfo: APIKeyInfo | null;
 }
 
+export class OperationRateLimits {
+    list?: RateLimitPolicy | null;
+    read?: RateLimitPolicy | null;
+    write?: RateLimitPolicy | null;
+}
+
 export class Project {
     id: UUID;
     publicId: UUID;
@@ -83,6 +90,11 @@ export class Project {
     defaultVersioning: number;
 }
 
+export class RateLimitPolicy {
+    rate: number;
+    burst: number;
+}
+
 export class ResponseUser {
     id: UUID;
     fullName: string;
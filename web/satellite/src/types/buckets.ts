@@ -125,5 +125,14 @@ export class PlacementDetails {
         public name: string = '',
         public title: string = '',
         public description: string = '',
+        public regions: PlacementRegion[] = [],
+    ) { }
+}
+
+export class PlacementRegion {
+    public constructor(
+        public name: string = '',
+        public description: string = '',
+        public countries: string[] = [],
     ) { }
 }
\ No newline at end of file